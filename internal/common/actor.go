@@ -0,0 +1,16 @@
+package common
+
+import "context"
+
+type actorKey struct{}
+
+// WithActor returns a copy of the context with the ID of the user making the changes
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// Actor returns the ID of the user making the changes, or an empty string when it is not known
+func Actor(ctx context.Context) string {
+	actor, _ := ctx.Value(actorKey{}).(string)
+	return actor
+}
@@ -0,0 +1,164 @@
+package common
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/shopspring/decimal"
+)
+
+// Money is an exact decimal amount.
+// It is stored as NUMERIC and serialized as a JSON number without losing precision.
+type Money struct {
+	value decimal.Decimal
+}
+
+var ZeroMoney = Money{decimal.Zero}
+
+func NewMoney(value float64) Money {
+	return Money{decimal.NewFromFloat(value)}
+}
+
+func NewMoneyFromInt(value int64) Money {
+	return Money{decimal.NewFromInt(value)}
+}
+
+func NewMoneyFromDecimal(value decimal.Decimal) Money {
+	return Money{value}
+}
+
+func NewMoneyFromString(value string) (Money, error) {
+	d, err := decimal.NewFromString(value)
+	if err != nil {
+		return ZeroMoney, fmt.Errorf("invalid money value %q: %w", value, err)
+	}
+	return Money{d}, nil
+}
+
+func SumMoney(values ...Money) Money {
+	total := ZeroMoney
+	for _, v := range values {
+		total = total.Add(v)
+	}
+	return total
+}
+
+// AllocateCents rounds every value to cents using the largest remainder method.
+// The total is the exact sum of the values rounded to cents, and the rounded values always add up to it:
+// each value is floored to cents and the residual cents go to the values with the largest remainders,
+// earlier values first on ties.
+func AllocateCents(values []Money) (Money, []Money) {
+	total := SumMoney(values...).Round()
+
+	allocated := make([]Money, len(values))
+	remainders := make([]decimal.Decimal, len(values))
+	floored := ZeroMoney
+	for i, v := range values {
+		allocated[i] = Money{v.value.RoundFloor(2)}
+		remainders[i] = v.value.Sub(allocated[i].value)
+		floored = floored.Add(allocated[i])
+	}
+
+	order := make([]int, len(values))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return remainders[order[a]].GreaterThan(remainders[order[b]])
+	})
+
+	cent := decimal.New(1, -2)
+	residual := int(total.value.Sub(floored.value).Div(cent).IntPart())
+	for i := 0; i < residual && i < len(order); i++ {
+		allocated[order[i]] = Money{allocated[order[i]].value.Add(cent)}
+	}
+
+	return total, allocated
+}
+
+func (m Money) Decimal() decimal.Decimal {
+	return m.value
+}
+
+func (m Money) Add(other Money) Money {
+	return Money{m.value.Add(other.value)}
+}
+
+func (m Money) Sub(other Money) Money {
+	return Money{m.value.Sub(other.value)}
+}
+
+func (m Money) Mul(factor decimal.Decimal) Money {
+	return Money{m.value.Mul(factor)}
+}
+
+// Round rounds the amount to cents
+func (m Money) Round() Money {
+	return Money{m.value.Round(2)}
+}
+
+func (m Money) Cmp(other Money) int {
+	return m.value.Cmp(other.value)
+}
+
+func (m Money) Equal(other Money) bool {
+	return m.value.Equal(other.value)
+}
+
+func (m Money) IsZero() bool {
+	return m.value.IsZero()
+}
+
+func (m Money) IsPositive() bool {
+	return m.value.IsPositive()
+}
+
+func (m Money) IsNegative() bool {
+	return m.value.IsNegative()
+}
+
+func (m Money) IsTwoDecimals() bool {
+	return m.value.Equal(m.value.Round(2))
+}
+
+func (m Money) Float64() float64 {
+	return m.value.InexactFloat64()
+}
+
+func (m Money) String() string {
+	return m.value.StringFixed(2)
+}
+
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(m.value.String()), nil
+}
+
+func (m *Money) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*m = ZeroMoney
+		return nil
+	}
+	return m.value.UnmarshalJSON(data)
+}
+
+func (m Money) Value() (driver.Value, error) {
+	return m.value.String(), nil
+}
+
+func (m *Money) Scan(src any) error {
+	if src == nil {
+		*m = ZeroMoney
+		return nil
+	}
+	return m.value.Scan(src)
+}
+
+// moneyTypeFunc lets the validator check Money fields with numeric tags such as gt or twodecimals
+func moneyTypeFunc(field reflect.Value) any {
+	if m, ok := field.Interface().(Money); ok {
+		return m.Float64()
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package common_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoneyArithmetic(t *testing.T) {
+	t.Run("should add cents without losing precision", func(t *testing.T) {
+		total := common.ZeroMoney
+		for i := 0; i < 10; i++ {
+			total = total.Add(common.NewMoney(0.10))
+		}
+		assert.True(t, total.Equal(common.NewMoney(1.00)))
+		assert.Equal(t, "1.00", total.String())
+	})
+
+	t.Run("should round to cents", func(t *testing.T) {
+		assert.Equal(t, "1.24", common.NewMoney(1.235).Round().String())
+		assert.Equal(t, "-1.24", common.NewMoney(-1.235).Round().String())
+	})
+
+	t.Run("should check two decimals", func(t *testing.T) {
+		assert.True(t, common.NewMoney(1.23).IsTwoDecimals())
+		assert.False(t, common.NewMoney(1.234).IsTwoDecimals())
+	})
+}
+
+func TestMoneyJSON(t *testing.T) {
+	t.Run("should keep the exact value on a round trip", func(t *testing.T) {
+		var payload struct {
+			Amount common.Money `json:"amount"`
+		}
+		err := json.Unmarshal([]byte(`{"amount": 1234567890123.45}`), &payload)
+		assert.NoError(t, err)
+		assert.Equal(t, "1234567890123.45", payload.Amount.String())
+
+		data, err := json.Marshal(payload)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"amount": 1234567890123.45}`, string(data))
+	})
+
+	t.Run("should validate money fields with numeric tags", func(t *testing.T) {
+		type input struct {
+			Amount common.Money `validate:"required,gt=0,twodecimals"`
+		}
+		assert.NoError(t, common.Validate.Struct(input{Amount: common.NewMoney(10.50)}))
+		assert.Error(t, common.Validate.Struct(input{Amount: common.NewMoney(10.505)}))
+		assert.Error(t, common.Validate.Struct(input{Amount: common.ZeroMoney}))
+	})
+}
+
+func TestAllocateCents(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    []float64
+		total    string
+		expected []string
+	}{
+		{
+			name:     "already rounded",
+			input:    []float64{10.00, 20.50},
+			total:    "30.50",
+			expected: []string{"10.00", "20.50"},
+		},
+		{
+			name:     "residual goes to the largest remainder",
+			input:    []float64{36.663, 36.663, 36.674},
+			total:    "110.00",
+			expected: []string{"36.66", "36.66", "36.68"},
+		},
+		{
+			name:     "ties go to the earlier values",
+			input:    []float64{33.3333, 33.3333, 33.3334},
+			total:    "100.00",
+			expected: []string{"33.33", "33.33", "33.34"},
+		},
+		{
+			name:     "several residual cents",
+			input:    []float64{0.005, 0.005, 0.005, 0.005},
+			total:    "0.02",
+			expected: []string{"0.01", "0.01", "0.00", "0.00"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			values := make([]common.Money, len(tc.input))
+			for i, v := range tc.input {
+				values[i] = common.NewMoney(v)
+			}
+
+			total, allocated := common.AllocateCents(values)
+
+			assert.Equal(t, tc.total, total.String())
+			assert.True(t, total.Equal(common.SumMoney(allocated...)))
+			for i, v := range allocated {
+				assert.Equal(t, tc.expected[i], v.String())
+			}
+		})
+	}
+}
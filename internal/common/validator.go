@@ -17,6 +17,7 @@ var (
 
 func init() {
 	Validate.RegisterValidation("twodecimals", TwoDecimalsValidator)
+	Validate.RegisterCustomTypeFunc(moneyTypeFunc, Money{})
 	en := en.New()
 	unt := ut.New(en, en)
 	transl, _ = unt.GetTranslator("en")
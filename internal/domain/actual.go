@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/google/uuid"
+)
+
+// Actual is the amount spent on a cost of a portfolio in a month,
+// in the same currency as the portfolio budgets
+type Actual struct {
+	ActualID    string       `validate:"required,uuid4"`
+	PortfolioID string       `validate:"required,uuid4"`
+	CostID      string       `validate:"required,uuid4"`
+	SpendDate   time.Time    `validate:"required"`
+	Amount      common.Money `validate:"gte=0,twodecimals"`
+	Comment     string       `validate:"max=255"`
+	CreatedAt   time.Time    `validate:"-"`
+	UpdatedAt   time.Time    `validate:"-"`
+}
+
+type RestoreActualProps Actual
+
+type NewActualProps struct {
+	PortfolioID string
+	CostID      string
+	Year        int
+	Month       time.Month
+	Amount      common.Money
+	Comment     string
+}
+
+var ErrActualCostNotInPortfolio = errors.New("the cost has no budget in the portfolio")
+
+func NewActual(props NewActualProps) *Actual {
+	return &Actual{
+		ActualID:    uuid.NewString(),
+		PortfolioID: props.PortfolioID,
+		CostID:      props.CostID,
+		SpendDate:   time.Date(props.Year, props.Month, 1, 0, 0, 0, 0, time.UTC),
+		Amount:      props.Amount,
+		Comment:     props.Comment,
+	}
+}
+
+func RestoreActual(props RestoreActualProps) *Actual {
+	return &Actual{
+		ActualID:    props.ActualID,
+		PortfolioID: props.PortfolioID,
+		CostID:      props.CostID,
+		SpendDate:   props.SpendDate,
+		Amount:      props.Amount,
+		Comment:     props.Comment,
+		CreatedAt:   props.CreatedAt,
+		UpdatedAt:   props.UpdatedAt,
+	}
+}
+
+func (a *Actual) ChangeAmount(amount common.Money) {
+	a.Amount = amount
+}
+
+func (a *Actual) ChangeComment(comment string) {
+	a.Comment = comment
+}
+
+// IsFor tells whether the actual is the spend of the cost in the month
+func (a *Actual) IsFor(costID string, year int, month time.Month) bool {
+	return a.CostID == costID && a.SpendDate.Year() == year && a.SpendDate.Month() == month
+}
+
+func (a *Actual) Validate() error {
+	err := common.Validate.Struct(a)
+	if err != nil {
+		return common.NewDomainValidationError(fmt.Errorf("actual domain validation failed: %w", err))
+	}
+	return nil
+}
+
+// ValidateBudget checks that the cost of the actual has a budget in the portfolio
+func (a *Actual) ValidateBudget(budgets []*Budget) error {
+	if slices.ContainsFunc(budgets, func(b *Budget) bool { return b.CostID == a.CostID }) {
+		return nil
+	}
+	return common.NewDomainValidationError(fmt.Errorf("%w: %s", ErrActualCostNotInPortfolio, a.CostID))
+}
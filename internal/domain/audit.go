@@ -0,0 +1,139 @@
+package domain
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/google/uuid"
+)
+
+type AuditAction string
+
+func (a AuditAction) String() string {
+	return string(a)
+}
+
+const (
+	CreateAction AuditAction = "create"
+	UpdateAction AuditAction = "update"
+	DeleteAction AuditAction = "delete"
+)
+
+type AuditEntity string
+
+func (e AuditEntity) String() string {
+	return string(e)
+}
+
+const (
+	UserEntity       AuditEntity = "user"
+	PlanEntity       AuditEntity = "plan"
+	BaselineEntity   AuditEntity = "baseline"
+	CostEntity       AuditEntity = "cost"
+	EffortEntity     AuditEntity = "effort"
+	CompetenceEntity AuditEntity = "competence"
+	PortfolioEntity  AuditEntity = "portfolio"
+)
+
+// AuditEntry records who created, updated or deleted an entity and what it looked like before and after.
+// Before is empty for a create and After is empty for a delete.
+// For an update both only have the fields that changed.
+type AuditEntry struct {
+	AuditID   string          `validate:"required,uuid4"`
+	Actor     string          `validate:"omitempty,uuid4"`
+	Action    AuditAction     `validate:"required,oneof=create update delete"`
+	Entity    AuditEntity     `validate:"required,oneof=user plan baseline cost effort competence portfolio"`
+	EntityID  string          `validate:"required"`
+	Before    json.RawMessage `validate:"-"`
+	After     json.RawMessage `validate:"-"`
+	CreatedAt time.Time       `validate:"-"`
+}
+
+// NewAuditEntryProps takes the entity before and after the change as any value that marshals to a JSON object,
+// or nil when there is no before or after
+type NewAuditEntryProps struct {
+	Actor    string
+	Action   AuditAction
+	Entity   AuditEntity
+	EntityID string
+	Before   any
+	After    any
+}
+
+func NewAuditEntry(props NewAuditEntryProps) (*AuditEntry, error) {
+	before, err := auditSnapshot(props.Before)
+	if err != nil {
+		return nil, err
+	}
+	after, err := auditSnapshot(props.After)
+	if err != nil {
+		return nil, err
+	}
+
+	if before != nil && after != nil {
+		before, after, err = auditDiff(before, after)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &AuditEntry{
+		AuditID:  uuid.NewString(),
+		Actor:    props.Actor,
+		Action:   props.Action,
+		Entity:   props.Entity,
+		EntityID: props.EntityID,
+		Before:   before,
+		After:    after,
+	}, nil
+}
+
+func (a *AuditEntry) Validate() error {
+	err := common.Validate.Struct(a)
+	if err != nil {
+		return common.NewDomainValidationError(fmt.Errorf("audit entry domain validation failed: %w", err))
+	}
+	return nil
+}
+
+func auditSnapshot(v any) (json.RawMessage, error) {
+	if v == nil {
+		return nil, nil
+	}
+	snapshot, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("cannot marshal audit snapshot: %w", err)
+	}
+	return snapshot, nil
+}
+
+// auditDiff keeps only the fields of the before and after objects that are different
+func auditDiff(before, after json.RawMessage) (json.RawMessage, json.RawMessage, error) {
+	var beforeFields, afterFields map[string]json.RawMessage
+	if err := json.Unmarshal(before, &beforeFields); err != nil {
+		return nil, nil, fmt.Errorf("cannot diff audit snapshot: %w", err)
+	}
+	if err := json.Unmarshal(after, &afterFields); err != nil {
+		return nil, nil, fmt.Errorf("cannot diff audit snapshot: %w", err)
+	}
+
+	for field, value := range beforeFields {
+		if other, ok := afterFields[field]; ok && bytes.Equal(value, other) {
+			delete(beforeFields, field)
+			delete(afterFields, field)
+		}
+	}
+
+	before, err := json.Marshal(beforeFields)
+	if err != nil {
+		return nil, nil, err
+	}
+	after, err = json.Marshal(afterFields)
+	if err != nil {
+		return nil, nil, err
+	}
+	return before, after, nil
+}
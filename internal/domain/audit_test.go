@@ -0,0 +1,83 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/celsopires1999/estimation/internal/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitAudit(t *testing.T) {
+	type snapshot struct {
+		Code      string  `json:"code"`
+		Inflation float64 `json:"inflation"`
+	}
+
+	t.Run("should record the entity after it is created", func(t *testing.T) {
+		actor := uuid.NewString()
+		entityID := uuid.NewString()
+
+		entry, err := domain.NewAuditEntry(domain.NewAuditEntryProps{
+			Actor:    actor,
+			Action:   domain.CreateAction,
+			Entity:   domain.PlanEntity,
+			EntityID: entityID,
+			After:    snapshot{Code: "BP 2025", Inflation: 4.5},
+		})
+
+		assert.NoError(t, err)
+		assert.NoError(t, entry.Validate())
+		assert.Equal(t, actor, entry.Actor)
+		assert.Equal(t, entityID, entry.EntityID)
+		assert.Nil(t, entry.Before)
+		assert.JSONEq(t, `{"code":"BP 2025","inflation":4.5}`, string(entry.After))
+	})
+
+	t.Run("should only keep the fields that changed in an update", func(t *testing.T) {
+		entry, err := domain.NewAuditEntry(domain.NewAuditEntryProps{
+			Action:   domain.UpdateAction,
+			Entity:   domain.PlanEntity,
+			EntityID: uuid.NewString(),
+			Before:   snapshot{Code: "BP 2025", Inflation: 4.5},
+			After:    snapshot{Code: "BP 2025", Inflation: 5},
+		})
+
+		assert.NoError(t, err)
+		assert.NoError(t, entry.Validate())
+		assert.Empty(t, entry.Actor)
+		assert.JSONEq(t, `{"inflation":4.5}`, string(entry.Before))
+		assert.JSONEq(t, `{"inflation":5}`, string(entry.After))
+	})
+
+	t.Run("should record the entity before it is deleted", func(t *testing.T) {
+		entry, err := domain.NewAuditEntry(domain.NewAuditEntryProps{
+			Action:   domain.DeleteAction,
+			Entity:   domain.CostEntity,
+			EntityID: uuid.NewString(),
+			Before:   snapshot{Code: "BP 2025", Inflation: 4.5},
+		})
+
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"code":"BP 2025","inflation":4.5}`, string(entry.Before))
+		assert.Nil(t, entry.After)
+	})
+
+	t.Run("should not accept an invalid actor", func(t *testing.T) {
+		entry, err := domain.NewAuditEntry(domain.NewAuditEntryProps{
+			Actor:    "someone",
+			Action:   domain.DeleteAction,
+			Entity:   domain.UserEntity,
+			EntityID: uuid.NewString(),
+		})
+		assert.NoError(t, err)
+
+		err = entry.Validate()
+
+		var errDomainValidation *common.DomainValidationError
+		assert.True(t, errors.As(err, &errDomainValidation))
+		assert.ErrorContains(t, err, "Actor")
+	})
+}
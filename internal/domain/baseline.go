@@ -1,7 +1,10 @@
 package domain
 
 import (
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/celsopires1999/estimation/internal/common"
@@ -9,21 +12,24 @@ import (
 )
 
 type Baseline struct {
-	BaselineID  string    `validate:"required,uuid4"`
-	Code        string    `validate:"required,max=20"`
-	Review      int32     `validate:"gt=0"`
-	Title       string    `validate:"required"`
-	Description string    `validate:"-"`
-	StartDate   time.Time `validate:"required"`
-	Duration    int32     `validate:"gt=0,lte=60"`
-	ManagerID   string    `validate:"required"`
-	EstimatorID string    `validate:"required"`
-	CreatedAt   time.Time `validate:"-"`
-	UpdatedAt   time.Time `validate:"-"`
+	BaselineID  string         `validate:"required,uuid4"`
+	Code        string         `validate:"required,max=20"`
+	Review      int32          `validate:"gt=0"`
+	Title       string         `validate:"required"`
+	Description string         `validate:"-"`
+	StartDate   time.Time      `validate:"required"`
+	Duration    int32          `validate:"gt=0,lte=60"`
+	ManagerID   string         `validate:"required"`
+	EstimatorID string         `validate:"required"`
+	Status      BaselineStatus `validate:"required,oneof=draft submitted approved rejected superseded"`
+	CreatedAt   time.Time      `validate:"-"`
+	UpdatedAt   time.Time      `validate:"-"`
 }
 
 type RestoreBaselineProps Baseline
 
+var ErrAllocationOutsideBaseline = errors.New("allocations are outside the baseline period")
+
 func NewBaseline(
 	code string,
 	review int32,
@@ -44,6 +50,7 @@ func NewBaseline(
 		Duration:    duration,
 		ManagerID:   managerID,
 		EstimatorID: estimatorID,
+		Status:      DraftBaseline,
 	}
 }
 
@@ -58,12 +65,27 @@ func RestoreBaseline(props RestoreBaselineProps) *Baseline {
 		Duration:    props.Duration,
 		ManagerID:   props.ManagerID,
 		EstimatorID: props.EstimatorID,
+		Status:      props.Status,
 		CreatedAt:   props.CreatedAt,
 		UpdatedAt:   props.UpdatedAt,
 	}
 
 }
 
+// NewReview returns a copy of the baseline as its next review, in draft
+func (b *Baseline) NewReview() *Baseline {
+	return NewBaseline(
+		b.Code,
+		b.Review+1,
+		b.Title,
+		b.Description,
+		b.StartDate,
+		b.Duration,
+		b.ManagerID,
+		b.EstimatorID,
+	)
+}
+
 func (b *Baseline) ChangeCode(code *string) {
 	if code == nil {
 		return
@@ -138,3 +160,50 @@ func (b *Baseline) Validate() error {
 	}
 	return nil
 }
+
+// StartMonth returns the first day of the first month of the baseline
+func (b *Baseline) StartMonth() time.Time {
+	return time.Date(b.StartDate.Year(), b.StartDate.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// EndMonth returns the first day of the last month of the baseline
+func (b *Baseline) EndMonth() time.Time {
+	return b.StartMonth().AddDate(0, int(b.Duration)-1, 0)
+}
+
+// ValidateCostAllocations checks that the cost is allocated within the months of the baseline
+func (b *Baseline) ValidateCostAllocations(cost *Cost) error {
+	dates := make([]time.Time, len(cost.CostAllocations))
+	for i, a := range cost.CostAllocations {
+		dates[i] = a.AllocationDate
+	}
+	return b.validateAllocationDates(fmt.Sprintf("cost '%s'", cost.Description), dates)
+}
+
+// ValidateEffortAllocations checks that the effort is allocated within the months of the baseline
+func (b *Baseline) ValidateEffortAllocations(effort *Effort) error {
+	dates := make([]time.Time, len(effort.EffortAllocations))
+	for i, a := range effort.EffortAllocations {
+		dates[i] = a.AllocationDate
+	}
+	return b.validateAllocationDates(fmt.Sprintf("effort of competence %s", effort.CompetenceID), dates)
+}
+
+func (b *Baseline) validateAllocationDates(subject string, dates []time.Time) error {
+	start, end := b.StartMonth(), b.EndMonth()
+
+	months := make([]string, 0)
+	for _, date := range dates {
+		if date.Before(start) || date.After(end) {
+			month := date.Format("2006-01")
+			if !slices.Contains(months, month) {
+				months = append(months, month)
+			}
+		}
+	}
+
+	if len(months) > 0 {
+		return common.NewDomainValidationError(fmt.Errorf("%s %w %s to %s: %s", subject, ErrAllocationOutsideBaseline, start.Format("2006-01"), end.Format("2006-01"), strings.Join(months, ", ")))
+	}
+	return nil
+}
@@ -0,0 +1,245 @@
+package domain
+
+import (
+	"cmp"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/celsopires1999/estimation/internal/common"
+)
+
+type DiffStatus string
+
+func (s DiffStatus) String() string {
+	return string(s)
+}
+
+const (
+	DiffAdded     DiffStatus = "added"
+	DiffRemoved   DiffStatus = "removed"
+	DiffChanged   DiffStatus = "changed"
+	DiffUnchanged DiffStatus = "unchanged"
+)
+
+// BaselineDiff has the costs and efforts that changed from a baseline to another.
+// Costs are matched by type and description, and efforts by competence.
+type BaselineDiff struct {
+	Costs   []CostDiff
+	Efforts []EffortDiff
+}
+
+type CostDiff struct {
+	Status      DiffStatus
+	CostType    CostType
+	Description string
+	Changes     []FieldChange
+	From        common.Money
+	To          common.Money
+	Months      []CostMonthDiff
+}
+
+type EffortDiff struct {
+	Status       DiffStatus
+	CompetenceID string
+	Changes      []FieldChange
+	From         int
+	To           int
+	Months       []EffortMonthDiff
+}
+
+// FieldChange is a value of a cost or effort other than its allocations that changed
+type FieldChange struct {
+	Field string
+	From  string
+	To    string
+}
+
+type CostMonthDiff struct {
+	Year  int
+	Month time.Month
+	From  common.Money
+	To    common.Money
+}
+
+type EffortMonthDiff struct {
+	Year  int
+	Month time.Month
+	From  int
+	To    int
+}
+
+type costKey struct {
+	costType    CostType
+	description string
+}
+
+// DiffBaselines compares the costs and efforts of a baseline (to) with the ones of an earlier baseline (from)
+func DiffBaselines(fromCosts, toCosts []*Cost, fromEfforts, toEfforts []*Effort) *BaselineDiff {
+	diff := &BaselineDiff{
+		Costs:   make([]CostDiff, 0),
+		Efforts: make([]EffortDiff, 0),
+	}
+
+	costs := make(map[costKey][2]*Cost)
+	for _, c := range fromCosts {
+		key := costKey{c.CostType, c.Description}
+		pair := costs[key]
+		pair[0] = c
+		costs[key] = pair
+	}
+	for _, c := range toCosts {
+		key := costKey{c.CostType, c.Description}
+		pair := costs[key]
+		pair[1] = c
+		costs[key] = pair
+	}
+	for key, pair := range costs {
+		if d, ok := diffCost(key, pair[0], pair[1]); ok {
+			diff.Costs = append(diff.Costs, d)
+		}
+	}
+	slices.SortFunc(diff.Costs, func(a, b CostDiff) int {
+		return cmp.Or(cmp.Compare(a.CostType, b.CostType), cmp.Compare(a.Description, b.Description))
+	})
+
+	efforts := make(map[string][2]*Effort)
+	for _, e := range fromEfforts {
+		pair := efforts[e.CompetenceID]
+		pair[0] = e
+		efforts[e.CompetenceID] = pair
+	}
+	for _, e := range toEfforts {
+		pair := efforts[e.CompetenceID]
+		pair[1] = e
+		efforts[e.CompetenceID] = pair
+	}
+	for competenceID, pair := range efforts {
+		if d, ok := diffEffort(competenceID, pair[0], pair[1]); ok {
+			diff.Efforts = append(diff.Efforts, d)
+		}
+	}
+	slices.SortFunc(diff.Efforts, func(a, b EffortDiff) int {
+		return cmp.Compare(a.CompetenceID, b.CompetenceID)
+	})
+
+	return diff
+}
+
+func diffCost(key costKey, from, to *Cost) (CostDiff, bool) {
+	d := CostDiff{CostType: key.costType, Description: key.description}
+
+	fromMonths := make(map[time.Time]common.Money)
+	toMonths := make(map[time.Time]common.Money)
+
+	switch {
+	case from == nil:
+		d.Status = DiffAdded
+	case to == nil:
+		d.Status = DiffRemoved
+	default:
+		d.Status = DiffChanged
+		d.Changes = fieldChanges(
+			FieldChange{"currency", from.Currency.String(), to.Currency.String()},
+			FieldChange{"tax", strconv.FormatFloat(from.Tax, 'f', 2, 64), strconv.FormatFloat(to.Tax, 'f', 2, 64)},
+			FieldChange{"tax_profile_id", from.TaxProfileID, to.TaxProfileID},
+			FieldChange{"apply_inflation", strconv.FormatBool(from.ApplyInflation), strconv.FormatBool(to.ApplyInflation)},
+		)
+	}
+
+	if from != nil {
+		d.From = from.Amount
+		for _, a := range from.CostAllocations {
+			fromMonths[monthOf(a.AllocationDate)] = fromMonths[monthOf(a.AllocationDate)].Add(a.Amount)
+		}
+	}
+	if to != nil {
+		d.To = to.Amount
+		for _, a := range to.CostAllocations {
+			toMonths[monthOf(a.AllocationDate)] = toMonths[monthOf(a.AllocationDate)].Add(a.Amount)
+		}
+	}
+
+	for _, date := range months(fromMonths, toMonths) {
+		if !fromMonths[date].Equal(toMonths[date]) {
+			d.Months = append(d.Months, CostMonthDiff{date.Year(), date.Month(), fromMonths[date], toMonths[date]})
+		}
+	}
+
+	if d.Status == DiffChanged && len(d.Changes) == 0 && len(d.Months) == 0 {
+		return d, false
+	}
+	return d, true
+}
+
+func diffEffort(competenceID string, from, to *Effort) (EffortDiff, bool) {
+	d := EffortDiff{CompetenceID: competenceID}
+
+	fromMonths := make(map[time.Time]int)
+	toMonths := make(map[time.Time]int)
+
+	switch {
+	case from == nil:
+		d.Status = DiffAdded
+	case to == nil:
+		d.Status = DiffRemoved
+	default:
+		d.Status = DiffChanged
+		d.Changes = fieldChanges(FieldChange{"comment", from.Comment, to.Comment})
+	}
+
+	if from != nil {
+		d.From = from.Hours
+		for _, a := range from.EffortAllocations {
+			fromMonths[monthOf(a.AllocationDate)] += a.Hours
+		}
+	}
+	if to != nil {
+		d.To = to.Hours
+		for _, a := range to.EffortAllocations {
+			toMonths[monthOf(a.AllocationDate)] += a.Hours
+		}
+	}
+
+	for _, date := range months(fromMonths, toMonths) {
+		if fromMonths[date] != toMonths[date] {
+			d.Months = append(d.Months, EffortMonthDiff{date.Year(), date.Month(), fromMonths[date], toMonths[date]})
+		}
+	}
+
+	if d.Status == DiffChanged && len(d.Changes) == 0 && len(d.Months) == 0 {
+		return d, false
+	}
+	return d, true
+}
+
+func fieldChanges(changes ...FieldChange) []FieldChange {
+	result := make([]FieldChange, 0)
+	for _, c := range changes {
+		if c.From != c.To {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+// months returns the sorted months of both allocations
+func months[V any](from, to map[time.Time]V) []time.Time {
+	dates := make([]time.Time, 0, len(from)+len(to))
+	for date := range from {
+		dates = append(dates, date)
+	}
+	for date := range to {
+		if _, ok := from[date]; !ok {
+			dates = append(dates, date)
+		}
+	}
+	slices.SortFunc(dates, func(a, b time.Time) int {
+		return a.Compare(b)
+	})
+	return dates
+}
+
+func monthOf(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
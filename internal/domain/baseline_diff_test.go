@@ -0,0 +1,116 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/celsopires1999/estimation/internal/domain"
+	"github.com/celsopires1999/estimation/internal/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitBaselineDiff(t *testing.T) {
+	newCost := func(description string, currency domain.Currency, allocations ...domain.CostAllocationProps) *domain.Cost {
+		amount := common.ZeroMoney
+		for _, a := range allocations {
+			amount = amount.Add(a.Amount)
+		}
+		return testutils.NewCostFakeBuilder().
+			WithCostType(domain.OneTimeCost).
+			WithDescription(description).
+			WithCurrency(currency).
+			WithTax(10).
+			WithApplyInflation(true).
+			WithAmount(amount).
+			WithCostAllocationProps(allocations).
+			Build()
+	}
+
+	t.Run("should report added, removed and changed costs", func(t *testing.T) {
+		from := []*domain.Cost{
+			newCost("license", domain.BRL, domain.CostAllocationProps{Year: 2020, Month: time.January, Amount: common.NewMoney(100.00)}),
+			newCost("hardware", domain.BRL, domain.CostAllocationProps{Year: 2020, Month: time.March, Amount: common.NewMoney(50.00)}),
+			newCost("training", domain.BRL, domain.CostAllocationProps{Year: 2020, Month: time.May, Amount: common.NewMoney(30.00)}),
+		}
+		to := []*domain.Cost{
+			newCost("license", domain.USD,
+				domain.CostAllocationProps{Year: 2020, Month: time.January, Amount: common.NewMoney(80.00)},
+				domain.CostAllocationProps{Year: 2020, Month: time.February, Amount: common.NewMoney(40.00)},
+			),
+			newCost("training", domain.BRL, domain.CostAllocationProps{Year: 2020, Month: time.May, Amount: common.NewMoney(30.00)}),
+			newCost("cloud", domain.BRL, domain.CostAllocationProps{Year: 2020, Month: time.June, Amount: common.NewMoney(70.00)}),
+		}
+
+		diff := domain.DiffBaselines(from, to, nil, nil)
+
+		assert.Len(t, diff.Costs, 3)
+		assert.Empty(t, diff.Efforts)
+
+		cloud := diff.Costs[0]
+		assert.Equal(t, "cloud", cloud.Description)
+		assert.Equal(t, domain.DiffAdded, cloud.Status)
+		assert.True(t, common.NewMoney(70.00).Equal(cloud.To))
+		assert.Len(t, cloud.Months, 1)
+
+		hardware := diff.Costs[1]
+		assert.Equal(t, "hardware", hardware.Description)
+		assert.Equal(t, domain.DiffRemoved, hardware.Status)
+		assert.True(t, common.NewMoney(50.00).Equal(hardware.From))
+		assert.True(t, hardware.To.Equal(common.ZeroMoney))
+
+		license := diff.Costs[2]
+		assert.Equal(t, "license", license.Description)
+		assert.Equal(t, domain.DiffChanged, license.Status)
+		assert.Equal(t, []domain.FieldChange{{Field: "currency", From: "BRL", To: "USD"}}, license.Changes)
+		assert.Len(t, license.Months, 2)
+		assert.Equal(t, time.January, license.Months[0].Month)
+		assert.True(t, common.NewMoney(100.00).Equal(license.Months[0].From))
+		assert.True(t, common.NewMoney(80.00).Equal(license.Months[0].To))
+		assert.Equal(t, time.February, license.Months[1].Month)
+		assert.True(t, license.Months[1].From.Equal(common.ZeroMoney))
+		assert.True(t, common.NewMoney(40.00).Equal(license.Months[1].To))
+	})
+
+	t.Run("should report the hours of changed efforts", func(t *testing.T) {
+		competenceID := "0a2b2a6c-5d5e-4d21-9d6d-9e5e1e6b5b2a"
+		from := testutils.NewEffortFakeBuilder().
+			WithCompetenceID(competenceID).
+			WithComment("same").
+			WithHours(100).
+			WithEffortAllocationsProps([]domain.EffortAllocationProps{
+				{Year: 2020, Month: time.January, Hours: 60},
+				{Year: 2020, Month: time.February, Hours: 40},
+			}).
+			Build()
+		to := testutils.NewEffortFakeBuilder().
+			WithCompetenceID(competenceID).
+			WithComment("same").
+			WithHours(120).
+			WithEffortAllocationsProps([]domain.EffortAllocationProps{
+				{Year: 2020, Month: time.January, Hours: 60},
+				{Year: 2020, Month: time.February, Hours: 60},
+			}).
+			Build()
+
+		diff := domain.DiffBaselines(nil, nil, []*domain.Effort{from}, []*domain.Effort{to})
+
+		assert.Len(t, diff.Efforts, 1)
+		effort := diff.Efforts[0]
+		assert.Equal(t, domain.DiffChanged, effort.Status)
+		assert.Empty(t, effort.Changes)
+		assert.Equal(t, 100, effort.From)
+		assert.Equal(t, 120, effort.To)
+		assert.Equal(t, []domain.EffortMonthDiff{{Year: 2020, Month: time.February, From: 40, To: 60}}, effort.Months)
+	})
+
+	t.Run("should omit unchanged items", func(t *testing.T) {
+		cost := newCost("license", domain.BRL, domain.CostAllocationProps{Year: 2020, Month: time.January, Amount: common.NewMoney(100.00)})
+		effort := testutils.NewEffortFakeBuilder().Build()
+
+		diff := domain.DiffBaselines([]*domain.Cost{cost}, []*domain.Cost{cost}, []*domain.Effort{effort}, []*domain.Effort{effort})
+
+		assert.Empty(t, diff.Costs)
+		assert.Empty(t, diff.Efforts)
+	})
+}
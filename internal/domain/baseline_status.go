@@ -0,0 +1,129 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/google/uuid"
+)
+
+// BaselineStatus is the stage of a baseline in its approval workflow
+type BaselineStatus string
+
+func (s BaselineStatus) String() string {
+	return string(s)
+}
+
+const (
+	DraftBaseline      BaselineStatus = "draft"
+	SubmittedBaseline  BaselineStatus = "submitted"
+	ApprovedBaseline   BaselineStatus = "approved"
+	RejectedBaseline   BaselineStatus = "rejected"
+	SupersededBaseline BaselineStatus = "superseded"
+)
+
+// baselineTransitions tells which type of user may move a baseline from one status to another
+var baselineTransitions = map[BaselineStatus]map[BaselineStatus]UserType{
+	DraftBaseline:     {SubmittedBaseline: Estimator},
+	SubmittedBaseline: {ApprovedBaseline: Manager, RejectedBaseline: Manager},
+	RejectedBaseline:  {DraftBaseline: Estimator},
+	ApprovedBaseline:  {SupersededBaseline: Manager},
+}
+
+var (
+	ErrBaselineTransition         = errors.New("baseline status transition not allowed")
+	ErrBaselineTransitionUserType = errors.New("user type not allowed for the baseline status transition")
+	ErrBaselineTransitionUser     = errors.New("user is not the manager or estimator of the baseline")
+	ErrBaselineTransitionComment  = errors.New("a comment is required to move a baseline to another status")
+	ErrBaselineNotEditable        = errors.New("baseline can only be changed in draft")
+	ErrBaselineNotApproved        = errors.New("baseline is not approved")
+)
+
+// BaselineTransition records who moved a baseline from one status to another, when and why
+type BaselineTransition struct {
+	TransitionID string         `validate:"required,uuid4"`
+	BaselineID   string         `validate:"required,uuid4"`
+	FromStatus   BaselineStatus `validate:"required"`
+	ToStatus     BaselineStatus `validate:"required"`
+	UserID       string         `validate:"required,uuid4"`
+	Comment      string         `validate:"required,max=255"`
+	CreatedAt    time.Time      `validate:"-"`
+}
+
+// Transition moves the baseline to the status on behalf of the user and returns the record of the change.
+// The estimator of the baseline submits a draft and reopens a rejected baseline,
+// its manager approves, rejects or supersedes it. Every transition needs a comment.
+func (b *Baseline) Transition(to BaselineStatus, user *User, comment string) (*BaselineTransition, error) {
+	owner := b.EstimatorID
+	userType, ok := baselineTransitions[b.Status][to]
+	if userType == Manager {
+		owner = b.ManagerID
+	}
+	if ok && user.UserType == userType && user.UserID != owner {
+		return nil, common.NewDomainValidationError(fmt.Errorf("%w: %s cannot move baseline %s from %s to %s", ErrBaselineTransitionUser, user.UserID, b.BaselineID, b.Status, to))
+	}
+
+	return b.move(to, user, comment)
+}
+
+// Supersede moves an approved baseline to superseded when a later review of it is approved.
+// The manager approving the later review may not be the manager of this one.
+func (b *Baseline) Supersede(user *User, comment string) (*BaselineTransition, error) {
+	return b.move(SupersededBaseline, user, comment)
+}
+
+func (b *Baseline) move(to BaselineStatus, user *User, comment string) (*BaselineTransition, error) {
+	userType, ok := baselineTransitions[b.Status][to]
+	if !ok {
+		return nil, common.NewDomainValidationError(fmt.Errorf("%w: from %s to %s", ErrBaselineTransition, b.Status, to))
+	}
+	if user.UserType != userType {
+		return nil, common.NewDomainValidationError(fmt.Errorf("%w: %s cannot move a baseline from %s to %s", ErrBaselineTransitionUserType, user.UserType, b.Status, to))
+	}
+	if strings.TrimSpace(comment) == "" {
+		return nil, common.NewDomainValidationError(fmt.Errorf("%w: from %s to %s", ErrBaselineTransitionComment, b.Status, to))
+	}
+
+	transition := &BaselineTransition{
+		TransitionID: uuid.NewString(),
+		BaselineID:   b.BaselineID,
+		FromStatus:   b.Status,
+		ToStatus:     to,
+		UserID:       user.UserID,
+		Comment:      comment,
+	}
+	if err := transition.Validate(); err != nil {
+		return nil, err
+	}
+
+	b.Status = to
+
+	return transition, nil
+}
+
+// ValidateEditable checks that the baseline, its costs and its efforts can still be changed
+func (b *Baseline) ValidateEditable() error {
+	if b.Status != DraftBaseline {
+		return common.NewConflictError(fmt.Errorf("%w: baseline %s is %s", ErrBaselineNotEditable, b.BaselineID, b.Status))
+	}
+	return nil
+}
+
+// ValidateApproved checks that the baseline can be added to a portfolio
+func (b *Baseline) ValidateApproved() error {
+	if b.Status != ApprovedBaseline {
+		return common.NewDomainValidationError(fmt.Errorf("%w: baseline %s is %s", ErrBaselineNotApproved, b.BaselineID, b.Status))
+	}
+	return nil
+}
+
+func (t *BaselineTransition) Validate() error {
+	err := common.Validate.Struct(t)
+	if err != nil {
+		return common.NewDomainValidationError(fmt.Errorf("baseline transition domain validation failed: %w", err))
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/celsopires1999/estimation/internal/domain"
+	"github.com/celsopires1999/estimation/internal/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitBaselineStatus(t *testing.T) {
+	manager := testutils.NewUserFakeBuilder().WithManager().Build()
+	estimator := testutils.NewUserFakeBuilder().WithEstimator().Build()
+	newBaseline := func() *testutils.BaselineFakeBuilder {
+		return testutils.NewBaselineFakeBuilder().WithManagerID(manager.UserID).WithEstimatorID(estimator.UserID)
+	}
+
+	t.Run("should start a new baseline in draft", func(t *testing.T) {
+		baseline := newBaseline().Build()
+
+		review := baseline.NewReview()
+
+		assert.Equal(t, domain.DraftBaseline, review.Status)
+		assert.NoError(t, review.ValidateEditable())
+	})
+
+	t.Run("should be submitted by an estimator and approved by a manager", func(t *testing.T) {
+		baseline := newBaseline().Build()
+
+		submitted, err := baseline.Transition(domain.SubmittedBaseline, estimator, "ready for review")
+		assert.NoError(t, err)
+		assert.Equal(t, domain.DraftBaseline, submitted.FromStatus)
+		assert.Equal(t, domain.SubmittedBaseline, submitted.ToStatus)
+		assert.Equal(t, estimator.UserID, submitted.UserID)
+		assert.Equal(t, "ready for review", submitted.Comment)
+
+		approved, err := baseline.Transition(domain.ApprovedBaseline, manager, "approved for the plan")
+		assert.NoError(t, err)
+		assert.Equal(t, domain.SubmittedBaseline, approved.FromStatus)
+		assert.Equal(t, domain.ApprovedBaseline, baseline.Status)
+		assert.NoError(t, baseline.ValidateApproved())
+	})
+
+	t.Run("should not allow the wrong user type to move the baseline", func(t *testing.T) {
+		baseline := newBaseline().Build()
+
+		_, err := baseline.Transition(domain.SubmittedBaseline, manager, "ready for review")
+
+		var errDomainValidation *common.DomainValidationError
+		assert.True(t, errors.As(err, &errDomainValidation))
+		assert.ErrorContains(t, err, domain.ErrBaselineTransitionUserType.Error())
+		assert.Equal(t, domain.DraftBaseline, baseline.Status)
+	})
+
+	t.Run("should only be submitted by the estimator of the baseline", func(t *testing.T) {
+		baseline := newBaseline().Build()
+		foreign := testutils.NewUserFakeBuilder().WithEstimator().Build()
+
+		_, err := baseline.Transition(domain.SubmittedBaseline, foreign, "ready for review")
+
+		var errDomainValidation *common.DomainValidationError
+		assert.True(t, errors.As(err, &errDomainValidation))
+		assert.ErrorContains(t, err, domain.ErrBaselineTransitionUser.Error())
+		assert.Equal(t, domain.DraftBaseline, baseline.Status)
+	})
+
+	t.Run("should only be approved or rejected by the manager of the baseline", func(t *testing.T) {
+		foreign := testutils.NewUserFakeBuilder().WithManager().Build()
+
+		for _, to := range []domain.BaselineStatus{domain.ApprovedBaseline, domain.RejectedBaseline} {
+			baseline := newBaseline().WithStatus(domain.SubmittedBaseline).Build()
+
+			_, err := baseline.Transition(to, foreign, "reviewed")
+
+			var errDomainValidation *common.DomainValidationError
+			assert.True(t, errors.As(err, &errDomainValidation))
+			assert.ErrorContains(t, err, domain.ErrBaselineTransitionUser.Error())
+			assert.Equal(t, domain.SubmittedBaseline, baseline.Status)
+		}
+	})
+
+	t.Run("should be superseded by the manager approving a later review", func(t *testing.T) {
+		baseline := newBaseline().WithStatus(domain.ApprovedBaseline).Build()
+		other := testutils.NewUserFakeBuilder().WithManager().Build()
+
+		transition, err := baseline.Supersede(other, "superseded by review 2")
+
+		assert.NoError(t, err)
+		assert.Equal(t, other.UserID, transition.UserID)
+		assert.Equal(t, domain.SupersededBaseline, baseline.Status)
+	})
+
+	t.Run("should not skip a status", func(t *testing.T) {
+		baseline := newBaseline().Build()
+
+		_, err := baseline.Transition(domain.ApprovedBaseline, manager, "approved for the plan")
+
+		var errDomainValidation *common.DomainValidationError
+		assert.True(t, errors.As(err, &errDomainValidation))
+		assert.ErrorContains(t, err, domain.ErrBaselineTransition.Error())
+	})
+
+	t.Run("should reject and reopen a rejected baseline", func(t *testing.T) {
+		baseline := newBaseline().WithStatus(domain.SubmittedBaseline).Build()
+
+		_, err := baseline.Transition(domain.RejectedBaseline, manager, "costs are missing")
+		assert.NoError(t, err)
+
+		_, err = baseline.Transition(domain.DraftBaseline, estimator, "costs added")
+		assert.NoError(t, err)
+		assert.NoError(t, baseline.ValidateEditable())
+	})
+
+	t.Run("should require a comment for every transition", func(t *testing.T) {
+		transitions := []struct {
+			from domain.BaselineStatus
+			to   domain.BaselineStatus
+			user *domain.User
+		}{
+			{domain.DraftBaseline, domain.SubmittedBaseline, estimator},
+			{domain.SubmittedBaseline, domain.ApprovedBaseline, manager},
+			{domain.SubmittedBaseline, domain.RejectedBaseline, manager},
+			{domain.RejectedBaseline, domain.DraftBaseline, estimator},
+			{domain.ApprovedBaseline, domain.SupersededBaseline, manager},
+		}
+
+		for _, tt := range transitions {
+			baseline := newBaseline().WithStatus(tt.from).Build()
+
+			_, err := baseline.Transition(tt.to, tt.user, " ")
+
+			var errDomainValidation *common.DomainValidationError
+			assert.True(t, errors.As(err, &errDomainValidation))
+			assert.ErrorContains(t, err, domain.ErrBaselineTransitionComment.Error())
+			assert.Equal(t, tt.from, baseline.Status)
+		}
+	})
+
+	t.Run("should block changes after the baseline is submitted", func(t *testing.T) {
+		baseline := newBaseline().WithStatus(domain.SubmittedBaseline).Build()
+
+		err := baseline.ValidateEditable()
+
+		var errConflict *common.ConflictError
+		assert.True(t, errors.As(err, &errConflict))
+		assert.ErrorContains(t, err, domain.ErrBaselineNotEditable.Error())
+	})
+
+	t.Run("should only add approved baselines to a portfolio", func(t *testing.T) {
+		baseline := newBaseline().WithStatus(domain.SupersededBaseline).Build()
+
+		err := baseline.ValidateApproved()
+
+		var errDomainValidation *common.DomainValidationError
+		assert.True(t, errors.As(err, &errDomainValidation))
+		assert.ErrorContains(t, err, domain.ErrBaselineNotApproved.Error())
+	})
+}
@@ -3,6 +3,7 @@ package domain_test
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/celsopires1999/estimation/internal/common"
 	"github.com/celsopires1999/estimation/internal/domain"
@@ -60,4 +61,94 @@ func TestUnitBaseline(t *testing.T) {
 		var errDomainValidation *common.DomainValidationError
 		assert.True(t, errors.As(err, &errDomainValidation))
 	})
+
+	t.Run("should accept allocations within the baseline period", func(t *testing.T) {
+		baseline := testutils.NewBaselineFakeBuilder().
+			WithStartDate(time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)).
+			WithDuration(10).
+			Build()
+		cost := testutils.NewCostFakeBuilder().
+			WithBaselineID(baseline.BaselineID).
+			WithCostAllocationProps([]domain.CostAllocationProps{
+				{Year: 2024, Month: time.March, Amount: common.NewMoney(60.00)},
+				{Year: 2024, Month: time.December, Amount: common.NewMoney(40.00)},
+			}).
+			Build()
+
+		assert.Equal(t, time.Date(2024, time.December, 1, 0, 0, 0, 0, time.UTC), baseline.EndMonth())
+		assert.NoError(t, baseline.ValidateCostAllocations(cost))
+	})
+
+	t.Run("should list the allocation months outside the baseline period", func(t *testing.T) {
+		baseline := testutils.NewBaselineFakeBuilder().
+			WithStartDate(time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)).
+			WithDuration(10).
+			Build()
+		effort := testutils.NewEffortFakeBuilder().
+			WithBaselineID(baseline.BaselineID).
+			WithHours(30).
+			WithEffortAllocationsProps([]domain.EffortAllocationProps{
+				{Year: 2024, Month: time.February, Hours: 10},
+				{Year: 2024, Month: time.June, Hours: 10},
+				{Year: 2025, Month: time.January, Hours: 10},
+			}).
+			Build()
+
+		err := baseline.ValidateEffortAllocations(effort)
+
+		var errDomainValidation *common.DomainValidationError
+		assert.True(t, errors.As(err, &errDomainValidation))
+		assert.ErrorContains(t, err, domain.ErrAllocationOutsideBaseline.Error())
+		assert.ErrorContains(t, err, "2024-03 to 2024-12: 2024-02, 2025-01")
+	})
+
+	t.Run("should copy a baseline as its next review", func(t *testing.T) {
+		baseline := testutils.NewBaselineFakeBuilder().
+			WithStartDate(time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)).
+			WithDuration(12).
+			Build()
+		cost := testutils.NewCostFakeBuilder().
+			WithBaselineID(baseline.BaselineID).
+			WithTax(0).
+			WithRecurrence(&domain.Recurrence{
+				Frequency:  domain.QuarterlyRecurrence,
+				Amount:     common.NewMoney(300.00),
+				StartYear:  2024,
+				StartMonth: time.March,
+				EndYear:    2024,
+				EndMonth:   time.December,
+			}).
+			Build()
+		effort := testutils.NewEffortFakeBuilder().
+			WithBaselineID(baseline.BaselineID).
+			WithHours(10).
+			WithEffortAllocationsProps([]domain.EffortAllocationProps{
+				{Year: 2024, Month: time.November, Hours: 10},
+			}).
+			Build()
+
+		review := baseline.NewReview()
+		startYear, startMonth := 2025, 1
+		review.ChangeStartDate(&startYear, &startMonth)
+		shiftMonths := domain.ShiftMonthsTo(baseline, review.StartDate.Year(), review.StartDate.Month())
+		costCopy := cost.Copy(review.BaselineID, shiftMonths)
+		effortCopy := effort.Copy(review.BaselineID, shiftMonths)
+
+		assert.NotEqual(t, baseline.BaselineID, review.BaselineID)
+		assert.Equal(t, baseline.Code, review.Code)
+		assert.Equal(t, baseline.Review+1, review.Review)
+		assert.Equal(t, 10, shiftMonths)
+
+		assert.NotEqual(t, cost.CostID, costCopy.CostID)
+		assert.Equal(t, review.BaselineID, costCopy.BaselineID)
+		assert.NoError(t, costCopy.Validate())
+		assert.Equal(t, 2025, costCopy.Recurrence.StartYear)
+		assert.Equal(t, time.October, costCopy.Recurrence.EndMonth)
+		assert.Equal(t, time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC), costCopy.CostAllocations[0].AllocationDate)
+		assert.NoError(t, review.ValidateCostAllocations(costCopy))
+
+		assert.Equal(t, review.BaselineID, effortCopy.BaselineID)
+		assert.Equal(t, time.Date(2025, time.September, 1, 0, 0, 0, 0, time.UTC), effortCopy.EffortAllocations[0].AllocationDate)
+		assert.NoError(t, review.ValidateEffortAllocations(effortCopy))
+	})
 }
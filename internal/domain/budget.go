@@ -12,23 +12,35 @@ type Budget struct {
 	BudgetID          string
 	PortfolioID       string
 	CostID            string
-	Amount            float64
+	Amount            common.Money
+	Taxes             BudgetTaxes
 	BudgetAllocations []BudgetAllocation
 	CreatedAt         time.Time
 	UpdatedAt         time.Time
 }
 
+type BudgetTaxes []BudgetTax
+
+// BudgetTax is the amount of one tax component included in the budget amount
+type BudgetTax struct {
+	Name     string       `json:"name"`
+	Rate     float64      `json:"rate"`
+	Compound bool         `json:"compound"`
+	Amount   common.Money `json:"amount"`
+}
+
 type RestoreBudgetProps Budget
 type NewBudgetAllocationProps struct {
 	Year   int
 	Month  time.Month
-	Amount float64
+	Amount common.Money
 }
 
 type NewBudgetProps struct {
 	PortfolioID       string
 	CostID            string
-	Amount            float64
+	Amount            common.Money
+	Taxes             BudgetTaxes
 	BudgetAllocations []NewBudgetAllocationProps
 }
 
@@ -39,6 +51,7 @@ func NewBudget(props NewBudgetProps) *Budget {
 		PortfolioID:       props.PortfolioID,
 		CostID:            props.CostID,
 		Amount:            props.Amount,
+		Taxes:             props.Taxes,
 		BudgetAllocations: budgetAllocations,
 	}
 }
@@ -59,6 +72,7 @@ func RestoreBudget(props RestoreBudgetProps) *Budget {
 		PortfolioID:       props.PortfolioID,
 		CostID:            props.CostID,
 		Amount:            props.Amount,
+		Taxes:             props.Taxes,
 		BudgetAllocations: props.BudgetAllocations,
 		CreatedAt:         props.CreatedAt,
 		UpdatedAt:         props.UpdatedAt,
@@ -66,17 +80,29 @@ func RestoreBudget(props RestoreBudgetProps) *Budget {
 }
 
 func (b *Budget) Validate() error {
-	if b.Amount <= 0 {
-		return common.NewDomainValidationError(fmt.Errorf("invalid budget amount %.2f", b.Amount))
+	if !b.Amount.IsPositive() {
+		return common.NewDomainValidationError(fmt.Errorf("invalid budget amount %s", b.Amount))
 	}
 
-	total := 0.
+	if !b.Amount.IsTwoDecimals() {
+		return common.NewDomainValidationError(fmt.Errorf("budget amount %s is not rounded to cents", b.Amount.Decimal()))
+	}
+
+	total := common.ZeroMoney
 	for _, v := range b.BudgetAllocations {
-		total += v.Amount
+		if !v.Amount.IsTwoDecimals() {
+			return common.NewDomainValidationError(fmt.Errorf("budget allocation amount %s is not rounded to cents", v.Amount.Decimal()))
+		}
+		total = total.Add(v.Amount)
+	}
+	if !total.Equal(b.Amount) {
+		return common.NewDomainValidationError(fmt.Errorf("budget allocation total %s is not equal to budget amount %s", total, b.Amount))
 	}
-	total = common.RoundToTwoDecimals(total)
-	if total != b.Amount {
-		return common.NewDomainValidationError(fmt.Errorf("budget allocation total %.2f is not equal to budget amount %.2f", total, b.Amount))
+
+	for _, tax := range b.Taxes {
+		if !tax.Amount.IsTwoDecimals() {
+			return common.NewDomainValidationError(fmt.Errorf("budget tax %s amount %s is not rounded to cents", tax.Name, tax.Amount.Decimal()))
+		}
 	}
 
 	return nil
@@ -88,10 +114,10 @@ func (b *Budget) GetBudgetAllocation() []BudgetAllocation {
 
 type BudgetAllocation struct {
 	AllocationDate time.Time
-	Amount         float64
+	Amount         common.Money
 }
 
-func NewBudgetAllocation(year int, month time.Month, amount float64) BudgetAllocation {
+func NewBudgetAllocation(year int, month time.Month, amount common.Money) BudgetAllocation {
 	date := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
 	return BudgetAllocation{
 		AllocationDate: date,
@@ -0,0 +1,135 @@
+package domain
+
+import (
+	"slices"
+	"time"
+
+	"github.com/celsopires1999/estimation/internal/common"
+)
+
+// MonthActual compares the budget of a month with its actual spend.
+// The cumulative amounts add up the months until this one.
+type MonthActual struct {
+	Year             int
+	Month            time.Month
+	Budget           common.Money
+	Actual           common.Money
+	CumulativeBudget common.Money
+	CumulativeActual common.Money
+	Remaining        common.Money
+}
+
+// Variance is the part of the budget that was not spent, negative when the spend is over the budget
+func (m MonthActual) Variance() common.Money {
+	return m.Budget.Sub(m.Actual)
+}
+
+// BudgetActual compares a budget, or all the budgets of a portfolio, with its actual spend
+type BudgetActual struct {
+	CostID string
+	Budget common.Money
+	Actual common.Money
+	Months []MonthActual
+}
+
+// Remaining is the budget still available after the actual spend
+func (b BudgetActual) Remaining() common.Money {
+	return b.Budget.Sub(b.Actual)
+}
+
+// Burn is the share of the budget spent, in percent
+func (b BudgetActual) Burn() float64 {
+	if b.Budget.IsZero() {
+		return 0
+	}
+	burn, _ := b.Actual.Decimal().Div(b.Budget.Decimal()).Shift(2).Round(2).Float64()
+	return burn
+}
+
+type PortfolioActual struct {
+	BudgetActual
+	Costs []BudgetActual
+}
+
+// CompareBudgetsWithActuals returns the budget against the actual spend by month, for each cost of the portfolio
+// and for the whole portfolio. Actuals of costs without a budget count as spend over a zero budget.
+func CompareBudgetsWithActuals(budgets []*Budget, actuals []*Actual) *PortfolioActual {
+	type costMonth struct {
+		costID string
+		month  time.Time
+	}
+	planned := make(map[costMonth]common.Money)
+	spent := make(map[costMonth]common.Money)
+	costIDs := make([]string, 0, len(budgets))
+
+	for _, b := range budgets {
+		costIDs = append(costIDs, b.CostID)
+		for _, a := range b.BudgetAllocations {
+			key := costMonth{b.CostID, monthOf(a.AllocationDate)}
+			planned[key] = planned[key].Add(a.Amount)
+		}
+	}
+	for _, a := range actuals {
+		if !slices.Contains(costIDs, a.CostID) {
+			costIDs = append(costIDs, a.CostID)
+		}
+		key := costMonth{a.CostID, monthOf(a.SpendDate)}
+		spent[key] = spent[key].Add(a.Amount)
+	}
+	slices.Sort(costIDs)
+
+	portfolio := &PortfolioActual{Costs: make([]BudgetActual, 0, len(costIDs))}
+	portfolioPlanned := make(map[time.Time]common.Money)
+	portfolioSpent := make(map[time.Time]common.Money)
+
+	for _, costID := range costIDs {
+		costPlanned := make(map[time.Time]common.Money)
+		costSpent := make(map[time.Time]common.Money)
+		for key, amount := range planned {
+			if key.costID == costID {
+				costPlanned[key.month] = amount
+				portfolioPlanned[key.month] = portfolioPlanned[key.month].Add(amount)
+			}
+		}
+		for key, amount := range spent {
+			if key.costID == costID {
+				costSpent[key.month] = amount
+				portfolioSpent[key.month] = portfolioSpent[key.month].Add(amount)
+			}
+		}
+		cost := compareMonths(costPlanned, costSpent)
+		cost.CostID = costID
+		portfolio.Costs = append(portfolio.Costs, cost)
+	}
+
+	portfolio.BudgetActual = compareMonths(portfolioPlanned, portfolioSpent)
+
+	return portfolio
+}
+
+// compareMonths accumulates the budget and the actual spend month by month
+func compareMonths(planned, spent map[time.Time]common.Money) BudgetActual {
+	result := BudgetActual{Months: make([]MonthActual, 0)}
+	for _, amount := range planned {
+		result.Budget = result.Budget.Add(amount)
+	}
+	for _, amount := range spent {
+		result.Actual = result.Actual.Add(amount)
+	}
+
+	var cumulativeBudget, cumulativeActual common.Money
+	for _, date := range months(planned, spent) {
+		cumulativeBudget = cumulativeBudget.Add(planned[date])
+		cumulativeActual = cumulativeActual.Add(spent[date])
+		result.Months = append(result.Months, MonthActual{
+			Year:             date.Year(),
+			Month:            date.Month(),
+			Budget:           planned[date],
+			Actual:           spent[date],
+			CumulativeBudget: cumulativeBudget,
+			CumulativeActual: cumulativeActual,
+			Remaining:        result.Budget.Sub(cumulativeActual),
+		})
+	}
+	return result
+}
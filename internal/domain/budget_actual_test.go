@@ -0,0 +1,97 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/celsopires1999/estimation/internal/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitBudgetActual(t *testing.T) {
+	portfolioID := uuid.NewString()
+	costA := "0a000000-0000-4000-8000-000000000000"
+	costB := "0b000000-0000-4000-8000-000000000000"
+	costC := "0c000000-0000-4000-8000-000000000000"
+
+	budgets := []*domain.Budget{
+		domain.NewBudget(domain.NewBudgetProps{
+			PortfolioID: portfolioID,
+			CostID:      costA,
+			Amount:      common.NewMoney(200.00),
+			BudgetAllocations: []domain.NewBudgetAllocationProps{
+				{Year: 2025, Month: time.January, Amount: common.NewMoney(100.00)},
+				{Year: 2025, Month: time.February, Amount: common.NewMoney(100.00)},
+			},
+		}),
+		domain.NewBudget(domain.NewBudgetProps{
+			PortfolioID: portfolioID,
+			CostID:      costB,
+			Amount:      common.NewMoney(50.00),
+			BudgetAllocations: []domain.NewBudgetAllocationProps{
+				{Year: 2025, Month: time.January, Amount: common.NewMoney(50.00)},
+			},
+		}),
+	}
+
+	newActual := func(costID string, month time.Month, amount float64) *domain.Actual {
+		return domain.NewActual(domain.NewActualProps{
+			PortfolioID: portfolioID,
+			CostID:      costID,
+			Year:        2025,
+			Month:       month,
+			Amount:      common.NewMoney(amount),
+		})
+	}
+
+	t.Run("should compare the budgets with the actual spend by month", func(t *testing.T) {
+		actuals := []*domain.Actual{
+			newActual(costA, time.January, 80.00),
+			newActual(costA, time.February, 130.00),
+			newActual(costC, time.March, 10.00),
+		}
+
+		comparison := domain.CompareBudgetsWithActuals(budgets, actuals)
+
+		assert.Equal(t, "250.00", comparison.Budget.String())
+		assert.Equal(t, "220.00", comparison.Actual.String())
+		assert.Equal(t, "30.00", comparison.Remaining().String())
+		assert.Equal(t, 88.0, comparison.Burn())
+
+		assert.Len(t, comparison.Months, 3)
+		february := comparison.Months[1]
+		assert.Equal(t, time.February, february.Month)
+		assert.Equal(t, "-30.00", february.Variance().String())
+		assert.Equal(t, "250.00", february.CumulativeBudget.String())
+		assert.Equal(t, "210.00", february.CumulativeActual.String())
+		assert.Equal(t, "40.00", february.Remaining.String())
+		assert.Equal(t, "30.00", comparison.Months[2].Remaining.String())
+
+		assert.Len(t, comparison.Costs, 3)
+		assert.Equal(t, costA, comparison.Costs[0].CostID)
+		assert.Equal(t, "-10.00", comparison.Costs[0].Remaining().String())
+		assert.Equal(t, 105.0, comparison.Costs[0].Burn())
+		assert.Equal(t, "50.00", comparison.Costs[1].Remaining().String())
+		assert.Equal(t, 0.0, comparison.Costs[1].Burn())
+		assert.Equal(t, costC, comparison.Costs[2].CostID)
+		assert.Equal(t, "-10.00", comparison.Costs[2].Remaining().String())
+	})
+
+	t.Run("should only accept actuals of costs with a budget", func(t *testing.T) {
+		assert.NoError(t, newActual(costB, time.January, 10.00).ValidateBudget(budgets))
+
+		err := newActual(costC, time.January, 10.00).ValidateBudget(budgets)
+		var errDomainValidation *common.DomainValidationError
+		assert.True(t, errors.As(err, &errDomainValidation))
+		assert.ErrorContains(t, err, domain.ErrActualCostNotInPortfolio.Error())
+	})
+
+	t.Run("should reject negative and fractional cents", func(t *testing.T) {
+		assert.NoError(t, newActual(costA, time.January, 0).Validate())
+		assert.Error(t, newActual(costA, time.January, -1.00).Validate())
+		assert.Error(t, newActual(costA, time.January, 1.001).Validate())
+	})
+}
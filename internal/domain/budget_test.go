@@ -0,0 +1,77 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/celsopires1999/estimation/internal/domain"
+	"github.com/celsopires1999/estimation/internal/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitBudget(t *testing.T) {
+	t.Run("should spread the rounding residual so allocations add up to the budget", func(t *testing.T) {
+		plan := testutils.NewPlanFakeBuilder().Build()
+		baseline := testutils.NewBaselineFakeBuilder().
+			WithStartDate(time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)).
+			WithDuration(12).
+			Build()
+		cost := testutils.NewCostFakeBuilder().
+			WithBaselineID(baseline.BaselineID).
+			WithCurrency(domain.BRL).
+			WithApplyInflation(false).
+			WithTax(10.00).
+			WithAmount(common.NewMoney(100.00)).
+			WithCostAllocationProps([]domain.CostAllocationProps{
+				{Year: 2025, Month: time.January, Amount: common.NewMoney(33.33)},
+				{Year: 2025, Month: time.February, Amount: common.NewMoney(33.33)},
+				{Year: 2025, Month: time.March, Amount: common.NewMoney(33.34)},
+			}).
+			Build()
+
+		service := domain.NewPortfolioService(plan.PlanID, baseline, []*domain.Cost{cost}, nil, plan.GetInflation(), plan.GetExchange(), domain.NewRateTable(nil), domain.NewTaxTable(nil), 0)
+		_, budgets, _, err := service.GeneratePortfolio()
+
+		assert.NoError(t, err)
+		assert.Len(t, budgets, 1)
+		assert.Equal(t, "110.00", budgets[0].Amount.String())
+		assert.Equal(t, "36.66", budgets[0].BudgetAllocations[0].Amount.String())
+		assert.Equal(t, "36.66", budgets[0].BudgetAllocations[1].Amount.String())
+		assert.Equal(t, "36.68", budgets[0].BudgetAllocations[2].Amount.String())
+	})
+
+	t.Run("should fail when allocations do not add up to the budget", func(t *testing.T) {
+		budget := domain.NewBudget(domain.NewBudgetProps{
+			Amount: common.NewMoney(110.00),
+			BudgetAllocations: []domain.NewBudgetAllocationProps{
+				{Year: 2025, Month: time.January, Amount: common.NewMoney(36.66)},
+				{Year: 2025, Month: time.February, Amount: common.NewMoney(36.66)},
+				{Year: 2025, Month: time.March, Amount: common.NewMoney(36.67)},
+			},
+		})
+
+		err := budget.Validate()
+
+		var errDomainValidation *common.DomainValidationError
+		assert.True(t, errors.As(err, &errDomainValidation))
+		assert.EqualError(t, err, "budget allocation total 109.99 is not equal to budget amount 110.00")
+	})
+
+	t.Run("should fail when an allocation is not rounded to cents", func(t *testing.T) {
+		budget := domain.NewBudget(domain.NewBudgetProps{
+			Amount: common.NewMoney(110.00),
+			BudgetAllocations: []domain.NewBudgetAllocationProps{
+				{Year: 2025, Month: time.January, Amount: common.NewMoney(54.995)},
+				{Year: 2025, Month: time.February, Amount: common.NewMoney(55.005)},
+			},
+		})
+
+		err := budget.Validate()
+
+		var errDomainValidation *common.DomainValidationError
+		assert.True(t, errors.As(err, &errDomainValidation))
+		assert.EqualError(t, err, "budget allocation amount 54.995 is not rounded to cents")
+	})
+}
@@ -0,0 +1,95 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/google/uuid"
+)
+
+// Capacity is the number of hours available for a competence in a month of a plan
+type Capacity struct {
+	CapacityID   string     `validate:"required,uuid4"`
+	PlanID       string     `validate:"required,uuid4"`
+	CompetenceID string     `validate:"required,uuid4"`
+	Year         int        `validate:"required"`
+	Month        time.Month `validate:"required,gte=1,lte=12"`
+	Hours        int        `validate:"gte=0"`
+	CreatedAt    time.Time  `validate:"-"`
+	UpdatedAt    time.Time  `validate:"-"`
+}
+
+type RestoreCapacityProps Capacity
+
+type NewCapacityProps struct {
+	PlanID       string
+	CompetenceID string
+	Year         int
+	Month        time.Month
+	Hours        int
+}
+
+var ErrCapacityYearNotInPlan = errors.New("capacity year is not in the plan assumptions")
+
+func NewCapacity(props NewCapacityProps) *Capacity {
+	return &Capacity{
+		CapacityID:   uuid.NewString(),
+		PlanID:       props.PlanID,
+		CompetenceID: props.CompetenceID,
+		Year:         props.Year,
+		Month:        props.Month,
+		Hours:        props.Hours,
+	}
+}
+
+func RestoreCapacity(props RestoreCapacityProps) *Capacity {
+	return &Capacity{
+		CapacityID:   props.CapacityID,
+		PlanID:       props.PlanID,
+		CompetenceID: props.CompetenceID,
+		Year:         props.Year,
+		Month:        props.Month,
+		Hours:        props.Hours,
+		CreatedAt:    props.CreatedAt,
+		UpdatedAt:    props.UpdatedAt,
+	}
+}
+
+func (c *Capacity) ChangeYear(year *int) {
+	if year == nil {
+		return
+	}
+	c.Year = *year
+}
+
+func (c *Capacity) ChangeMonth(month *int) {
+	if month == nil {
+		return
+	}
+	c.Month = time.Month(*month)
+}
+
+func (c *Capacity) ChangeHours(hours *int) {
+	if hours == nil {
+		return
+	}
+	c.Hours = *hours
+}
+
+func (c *Capacity) Validate() error {
+	err := common.Validate.Struct(c)
+	if err != nil {
+		return common.NewDomainValidationError(fmt.Errorf("capacity domain validation failed: %w", err))
+	}
+	return nil
+}
+
+// ValidateYear checks that the plan has assumptions for the capacity year
+func (c *Capacity) ValidateYear(plan *Plan) error {
+	if plan.HasYear(c.Year) {
+		return nil
+	}
+	return common.NewDomainValidationError(fmt.Errorf("%w: %d", ErrCapacityYearNotInPlan, c.Year))
+}
@@ -0,0 +1,69 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/celsopires1999/estimation/internal/domain"
+	"github.com/celsopires1999/estimation/internal/testutils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitCapacity(t *testing.T) {
+	plan := testutils.NewPlanFakeBuilder().Build()
+
+	newCapacity := func(year int, month time.Month, hours int) *domain.Capacity {
+		return domain.NewCapacity(domain.NewCapacityProps{
+			PlanID:       plan.PlanID,
+			CompetenceID: uuid.NewString(),
+			Year:         year,
+			Month:        month,
+			Hours:        hours,
+		})
+	}
+
+	t.Run("should accept a month of the plan", func(t *testing.T) {
+		capacity := newCapacity(2025, time.March, 160)
+
+		assert.NoError(t, capacity.Validate())
+		assert.NoError(t, capacity.ValidateYear(plan))
+	})
+
+	t.Run("should accept a month without capacity", func(t *testing.T) {
+		assert.NoError(t, newCapacity(2025, time.March, 0).Validate())
+	})
+
+	t.Run("should reject invalid months and hours", func(t *testing.T) {
+		var errDomainValidation *common.DomainValidationError
+
+		err := newCapacity(2025, 13, 160).Validate()
+		assert.True(t, errors.As(err, &errDomainValidation))
+
+		err = newCapacity(2025, time.March, -1).Validate()
+		assert.True(t, errors.As(err, &errDomainValidation))
+	})
+
+	t.Run("should reject a year that is not in the plan", func(t *testing.T) {
+		err := newCapacity(2030, time.March, 160).ValidateYear(plan)
+
+		var errDomainValidation *common.DomainValidationError
+		assert.True(t, errors.As(err, &errDomainValidation))
+		assert.ErrorContains(t, err, domain.ErrCapacityYearNotInPlan.Error())
+	})
+
+	t.Run("should change the month and hours", func(t *testing.T) {
+		capacity := newCapacity(2025, time.March, 160)
+		month, hours := 4, 120
+
+		capacity.ChangeYear(nil)
+		capacity.ChangeMonth(&month)
+		capacity.ChangeHours(&hours)
+
+		assert.Equal(t, 2025, capacity.Year)
+		assert.Equal(t, time.April, capacity.Month)
+		assert.Equal(t, 120, capacity.Hours)
+	})
+}
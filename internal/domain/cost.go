@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"errors"
 	"fmt"
 	"slices"
 	"time"
@@ -21,16 +22,20 @@ const (
 	Investment  CostType = "investment"
 )
 
+var ErrCostTaxWithProfile = errors.New("cost must have either a tax or a tax profile")
+
 type Cost struct {
 	CostID          string           `validate:"required,uuid"`
 	BaselineID      string           `validate:"required,uuid"`
 	CostType        CostType         `validate:"required"`
 	Description     string           `validate:"required"`
 	Comment         string           `validate:"-"`
-	Amount          float64          `validate:"required"`
+	Amount          common.Money     `validate:"required"`
 	Currency        Currency         `validate:"required"`
 	Tax             float64          `validate:"gte=0"`
+	TaxProfileID    string           `validate:"omitempty,uuid4"`
 	ApplyInflation  bool             `validate:"-"`
+	Recurrence      *Recurrence      `validate:"omitempty"`
 	CostAllocations []CostAllocation `validate:"required"`
 	CreatedAt       time.Time        `validate:"-"`
 	UpdatedAt       time.Time        `validate:"-"`
@@ -40,7 +45,7 @@ type RestoreCostProps Cost
 type CostAllocationProps struct {
 	Year   int
 	Month  time.Month
-	Amount float64
+	Amount common.Money
 }
 
 type NewCostProps struct {
@@ -48,10 +53,12 @@ type NewCostProps struct {
 	CostType        CostType
 	Description     string
 	Comment         string
-	Amount          float64
+	Amount          common.Money
 	Currency        Currency
 	Tax             float64
+	TaxProfileID    string
 	ApplyInflation  bool
+	Recurrence      *Recurrence
 	CostAllocations []CostAllocationProps
 }
 
@@ -66,11 +73,41 @@ func NewCost(props NewCostProps) *Cost {
 		Amount:          props.Amount,
 		Currency:        props.Currency,
 		Tax:             props.Tax,
+		TaxProfileID:    props.TaxProfileID,
 		ApplyInflation:  props.ApplyInflation,
+		Recurrence:      props.Recurrence,
 		CostAllocations: costAllocations,
 	}
 }
 
+// Copy returns a new cost with the same values in the baseline, with the allocations shifted by the months
+func (c *Cost) Copy(baselineID string, shiftMonths int) *Cost {
+	allocations := make([]CostAllocationProps, len(c.CostAllocations))
+	for i, a := range c.CostAllocations {
+		date := a.AllocationDate.AddDate(0, shiftMonths, 0)
+		allocations[i] = CostAllocationProps{Year: date.Year(), Month: date.Month(), Amount: a.Amount}
+	}
+
+	var recurrence *Recurrence
+	if c.Recurrence != nil {
+		recurrence = c.Recurrence.shift(shiftMonths)
+	}
+
+	return NewCost(NewCostProps{
+		BaselineID:      baselineID,
+		CostType:        c.CostType,
+		Description:     c.Description,
+		Comment:         c.Comment,
+		Amount:          c.Amount,
+		Currency:        c.Currency,
+		Tax:             c.Tax,
+		TaxProfileID:    c.TaxProfileID,
+		ApplyInflation:  c.ApplyInflation,
+		Recurrence:      recurrence,
+		CostAllocations: allocations,
+	})
+}
+
 func RestoreCost(props RestoreCostProps) *Cost {
 	return &Cost{
 		CostID:          props.CostID,
@@ -81,7 +118,9 @@ func RestoreCost(props RestoreCostProps) *Cost {
 		Amount:          props.Amount,
 		Currency:        props.Currency,
 		Tax:             props.Tax,
+		TaxProfileID:    props.TaxProfileID,
 		ApplyInflation:  props.ApplyInflation,
+		Recurrence:      props.Recurrence,
 		CostAllocations: props.CostAllocations,
 		CreatedAt:       props.CreatedAt,
 		UpdatedAt:       props.UpdatedAt,
@@ -94,30 +133,41 @@ func (c *Cost) Validate() error {
 		return common.NewDomainValidationError(fmt.Errorf("cost domain validation failed: %w", err))
 	}
 
-	if c.Amount <= 0 {
-		return common.NewDomainValidationError(fmt.Errorf("invalid cost amount %.2f", c.Amount))
+	if !c.Amount.IsPositive() {
+		return common.NewDomainValidationError(fmt.Errorf("invalid cost amount %s", c.Amount))
 	}
 
-	total := 0.
+	total := common.ZeroMoney
 	for _, v := range c.CostAllocations {
-		total += v.Amount
+		total = total.Add(v.Amount)
 	}
-	if total != c.Amount {
-		return common.NewDomainValidationError(fmt.Errorf("cost allocation total %.2f is not equal to cost amount %.2f", total, c.Amount))
+	if !total.Equal(c.Amount) {
+		return common.NewDomainValidationError(fmt.Errorf("cost allocation total %s is not equal to cost amount %s", total, c.Amount))
 	}
 
 	if c.Tax < 0 {
 		return common.NewDomainValidationError(fmt.Errorf("invalid tax %.2f", c.Tax))
 	}
+
+	if c.TaxProfileID != "" && c.Tax != 0 {
+		return common.NewDomainValidationError(ErrCostTaxWithProfile)
+	}
+
+	if c.Recurrence != nil {
+		if c.CostType != RunningCost {
+			return common.NewDomainValidationError(ErrRecurrenceNotRunning)
+		}
+		return c.Recurrence.Validate()
+	}
 	return nil
 }
 
 type CostAllocation struct {
 	AllocationDate time.Time
-	Amount         float64
+	Amount         common.Money
 }
 
-func newCostAllocation(year int, month time.Month, amount float64) CostAllocation {
+func newCostAllocation(year int, month time.Month, amount common.Money) CostAllocation {
 	date := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
 	return CostAllocation{
 		AllocationDate: date,
@@ -146,7 +196,7 @@ func (c *Cost) ChangeComment(comment *string) {
 	c.Comment = *comment
 }
 
-func (c *Cost) ChangeAmount(amount *float64) {
+func (c *Cost) ChangeAmount(amount *common.Money) {
 	if amount == nil {
 		return
 	}
@@ -167,6 +217,14 @@ func (c *Cost) ChangeTax(tax *float64) {
 	c.Tax = *tax
 }
 
+// ChangeTaxProfileID sets the tax profile of the cost, and an empty id removes it
+func (c *Cost) ChangeTaxProfileID(taxProfileID *string) {
+	if taxProfileID == nil {
+		return
+	}
+	c.TaxProfileID = *taxProfileID
+}
+
 func (c *Cost) ChangeApplyInflation(applyInflation *bool) {
 	if applyInflation == nil {
 		return
@@ -174,9 +232,21 @@ func (c *Cost) ChangeApplyInflation(applyInflation *bool) {
 	c.ApplyInflation = *applyInflation
 }
 
+// ChangeCostAllocations replaces the allocations, and explicit allocations replace the recurrence
 func (c *Cost) ChangeCostAllocations(costAllocationProps []CostAllocationProps) {
 	costAllocations := createCostAllocations(costAllocationProps)
 	c.CostAllocations = costAllocations
+	c.Recurrence = nil
+}
+
+// ChangeRecurrence sets the recurrence and regenerates the amount and the allocations from it
+func (c *Cost) ChangeRecurrence(recurrence *Recurrence) {
+	if recurrence == nil {
+		return
+	}
+	c.Recurrence = recurrence
+	c.Amount = recurrence.Total()
+	c.CostAllocations = createCostAllocations(recurrence.Expand())
 }
 
 func createCostAllocations(params []CostAllocationProps) []CostAllocation {
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/celsopires1999/estimation/internal/common"
 	"github.com/celsopires1999/estimation/internal/domain"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -16,13 +17,13 @@ func TestUnitCost(t *testing.T) {
 			CostType:       domain.OneTimeCost,
 			Description:    "Mão de obra do PMO",
 			Comment:        "estimativa do Ferraz",
-			Amount:         100.00,
+			Amount:         common.NewMoney(100.00),
 			Tax:            0.00,
 			Currency:       domain.EUR,
 			ApplyInflation: true,
 			CostAllocations: []domain.CostAllocationProps{
-				{Year: 2020, Month: time.January, Amount: 60.},
-				{Year: 2020, Month: time.August, Amount: 40.},
+				{Year: 2020, Month: time.January, Amount: common.NewMoney(60.)},
+				{Year: 2020, Month: time.August, Amount: common.NewMoney(40.)},
 			},
 		}
 
@@ -59,13 +60,13 @@ func arrangeInValidCost() *domain.Cost {
 		CostType:       domain.OneTimeCost,
 		Description:    "Mão de obra do PMO",
 		Comment:        "estimativa do Ferraz",
-		Amount:         100.00,
+		Amount:         common.NewMoney(100.00),
 		Currency:       domain.EUR,
 		Tax:            45.00,
 		ApplyInflation: true,
 		CostAllocations: []domain.CostAllocationProps{
-			{Year: 2020, Month: time.January, Amount: 60.00},
-			{Year: 2020, Month: time.August, Amount: 50.00},
+			{Year: 2020, Month: time.January, Amount: common.NewMoney(60.00)},
+			{Year: 2020, Month: time.August, Amount: common.NewMoney(50.00)},
 		},
 	}
 
@@ -1,5 +1,14 @@
 package domain
 
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/google/uuid"
+)
+
 type Currency string
 
 const (
@@ -13,12 +22,15 @@ func (c Currency) String() string {
 }
 
 func (c Currency) IsValid() bool {
-	switch c {
-	case BRL, USD, EUR:
-		return true
-	default:
+	if len(c) != 3 {
 		return false
 	}
+	for _, r := range c {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return true
 }
 
 func (c Currency) IsBRL() bool {
@@ -32,3 +44,49 @@ func (c Currency) IsUSD() bool {
 func (c Currency) IsEUR() bool {
 	return c == EUR
 }
+
+// CurrencyEntry is a currency registered in the catalogue
+type CurrencyEntry struct {
+	CurrencyID string    `validate:"required,uuid4"`
+	Code       Currency  `validate:"required,len=3,alpha,uppercase"`
+	Name       string    `validate:"required,max=50"`
+	CreatedAt  time.Time `validate:"-"`
+	UpdatedAt  time.Time `validate:"-"`
+}
+
+type RestoreCurrencyEntryProps CurrencyEntry
+
+var ErrBaseCurrencyDeletion = errors.New("base currency BRL cannot be deleted")
+
+func NewCurrencyEntry(code Currency, name string) *CurrencyEntry {
+	return &CurrencyEntry{
+		CurrencyID: uuid.NewString(),
+		Code:       code,
+		Name:       name,
+	}
+}
+
+func RestoreCurrencyEntry(props RestoreCurrencyEntryProps) *CurrencyEntry {
+	return &CurrencyEntry{
+		CurrencyID: props.CurrencyID,
+		Code:       props.Code,
+		Name:       props.Name,
+		CreatedAt:  props.CreatedAt,
+		UpdatedAt:  props.UpdatedAt,
+	}
+}
+
+func (c *CurrencyEntry) ChangeName(name *string) {
+	if name == nil {
+		return
+	}
+	c.Name = *name
+}
+
+func (c *CurrencyEntry) Validate() error {
+	err := common.Validate.Struct(c)
+	if err != nil {
+		return common.NewDomainValidationError(fmt.Errorf("currency domain validation failed: %w", err))
+	}
+	return nil
+}
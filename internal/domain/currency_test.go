@@ -0,0 +1,71 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/celsopires1999/estimation/internal/domain"
+	"github.com/celsopires1999/estimation/internal/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitCurrency(t *testing.T) {
+	t.Run("should create a currency with valid values", func(t *testing.T) {
+		currency := domain.NewCurrencyEntry("GBP", "Pound Sterling")
+
+		assert.NoError(t, currency.Validate())
+		assert.Equal(t, domain.Currency("GBP"), currency.Code)
+		assert.Equal(t, "Pound Sterling", currency.Name)
+	})
+
+	t.Run("should fail to create a currency with invalid code", func(t *testing.T) {
+		for _, code := range []domain.Currency{"", "gbp", "GB", "GBPX", "G1P"} {
+			currency := domain.NewCurrencyEntry(code, "Pound Sterling")
+
+			err := currency.Validate()
+			var errDomainValidation *common.DomainValidationError
+			assert.True(t, errors.As(err, &errDomainValidation), code)
+		}
+	})
+
+	t.Run("should accept any registered currency in plan assumptions", func(t *testing.T) {
+		plan := testutils.NewPlanFakeBuilder().Build()
+		for i := range plan.Assumptions {
+			plan.Assumptions[i].Currencies = append(plan.Assumptions[i].Currencies, domain.CurrencyAssumption{
+				Currency: "GBP",
+				Exchange: 7.15,
+			})
+		}
+
+		assert.NoError(t, plan.Validate())
+		assert.ElementsMatch(t, []domain.Currency{domain.USD, domain.EUR, "GBP"}, plan.GetCurrencies())
+	})
+
+	t.Run("should fail when a currency is repeated in the same year", func(t *testing.T) {
+		plan := testutils.NewPlanFakeBuilder().Build()
+		plan.Assumptions[0].Currencies = append(plan.Assumptions[0].Currencies, domain.CurrencyAssumption{
+			Currency: domain.USD,
+			Exchange: 5.00,
+		})
+
+		err := plan.Validate()
+		var errDomainValidation *common.DomainValidationError
+		assert.True(t, errors.As(err, &errDomainValidation))
+	})
+
+	t.Run("should convert only currencies with exchange rates", func(t *testing.T) {
+		plan := testutils.NewPlanFakeBuilder().Build()
+		exchange := plan.GetExchange()
+
+		amount, err := exchange.ConvertToBRL(common.NewMoney(100.00), domain.BRL, 2025, time.January)
+		assert.NoError(t, err)
+		assert.Equal(t, "100.00", amount.String())
+
+		_, err = exchange.ConvertToBRL(common.NewMoney(100.00), "GBP", 2025, time.January)
+		var errDomainValidation *common.DomainValidationError
+		assert.True(t, errors.As(err, &errDomainValidation))
+		assert.ErrorContains(t, err, "GBP 2025")
+	})
+}
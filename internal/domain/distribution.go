@@ -0,0 +1,216 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/shopspring/decimal"
+)
+
+type DistributionStrategy string
+
+func (s DistributionStrategy) String() string {
+	return string(s)
+}
+
+const (
+	EvenDistribution        DistributionStrategy = "even"
+	FrontLoadedDistribution DistributionStrategy = "front_loaded"
+	BackLoadedDistribution  DistributionStrategy = "back_loaded"
+	SCurveDistribution      DistributionStrategy = "s_curve"
+	RampUpDistribution      DistributionStrategy = "ramp_up"
+	RampDownDistribution    DistributionStrategy = "ramp_down"
+	CustomDistribution      DistributionStrategy = "custom"
+)
+
+var (
+	ErrDistributionPeriod        = errors.New("distribution start month is after end month")
+	ErrDistributionWeights       = errors.New("distribution weights must have one value per month")
+	ErrDistributionWeightsTotal  = errors.New("distribution weights must add up to more than zero")
+	ErrDistributionUnusedWeights = errors.New("distribution weights are only allowed for the custom strategy")
+)
+
+// Distribution spreads a total over the months from start to end, both included
+type Distribution struct {
+	Strategy DistributionStrategy `validate:"required,oneof=even front_loaded back_loaded s_curve ramp_up ramp_down custom"`
+	Start    time.Time            `validate:"required"`
+	End      time.Time            `validate:"required"`
+	Weights  []float64            `validate:"omitempty,dive,gte=0"`
+}
+
+type NewDistributionProps struct {
+	Strategy   DistributionStrategy
+	StartYear  int
+	StartMonth time.Month
+	EndYear    int
+	EndMonth   time.Month
+	Weights    []float64
+}
+
+func NewDistribution(props NewDistributionProps) *Distribution {
+	return &Distribution{
+		Strategy: props.Strategy,
+		Start:    time.Date(props.StartYear, props.StartMonth, 1, 0, 0, 0, 0, time.UTC),
+		End:      time.Date(props.EndYear, props.EndMonth, 1, 0, 0, 0, 0, time.UTC),
+		Weights:  props.Weights,
+	}
+}
+
+func (d *Distribution) Validate() error {
+	err := common.Validate.Struct(d)
+	if err != nil {
+		return common.NewDomainValidationError(fmt.Errorf("distribution domain validation failed: %w", err))
+	}
+
+	if d.Start.After(d.End) {
+		return common.NewDomainValidationError(ErrDistributionPeriod)
+	}
+
+	if d.Strategy != CustomDistribution {
+		if len(d.Weights) > 0 {
+			return common.NewDomainValidationError(ErrDistributionUnusedWeights)
+		}
+		return nil
+	}
+
+	if len(d.Weights) != d.Months() {
+		return common.NewDomainValidationError(fmt.Errorf("%w: expected %d, got %d", ErrDistributionWeights, d.Months(), len(d.Weights)))
+	}
+
+	total := 0.
+	for _, w := range d.Weights {
+		total += w
+	}
+	if total <= 0 {
+		return common.NewDomainValidationError(ErrDistributionWeightsTotal)
+	}
+
+	return nil
+}
+
+// Months returns the number of months in the distribution period
+func (d *Distribution) Months() int {
+	return monthIndex(d.End) - monthIndex(d.Start) + 1
+}
+
+// GetWeights returns the relative weight of each month.
+// Front-loaded and ramp-down weights decrease linearly, back-loaded and ramp-up weights increase linearly,
+// and the S-curve uses a bell shape so that the accumulated total follows an S.
+func (d *Distribution) GetWeights() []float64 {
+	n := d.Months()
+	weights := make([]float64, n)
+
+	for i := range weights {
+		switch d.Strategy {
+		case FrontLoadedDistribution, RampDownDistribution:
+			weights[i] = float64(n - i)
+		case BackLoadedDistribution, RampUpDistribution:
+			weights[i] = float64(i + 1)
+		case SCurveDistribution:
+			weights[i] = math.Sin(math.Pi * (float64(i) + 0.5) / float64(n))
+		case CustomDistribution:
+			weights[i] = d.Weights[i]
+		default:
+			weights[i] = 1
+		}
+	}
+
+	return weights
+}
+
+// DistributeCost splits the amount across the months, exact to the cent.
+// Months that receive nothing are left out.
+func (d *Distribution) DistributeCost(amount common.Money) []CostAllocationProps {
+	shares := d.getShares(amount.Decimal())
+
+	exact := make([]common.Money, len(shares))
+	for i, v := range shares {
+		exact[i] = common.NewMoneyFromDecimal(v)
+	}
+
+	_, amounts := common.AllocateCents(exact)
+
+	// any difference left by the division precision goes to the last month
+	residual := amount.Sub(common.SumMoney(amounts...))
+	amounts[len(amounts)-1] = amounts[len(amounts)-1].Add(residual)
+
+	allocations := make([]CostAllocationProps, 0, len(amounts))
+	for i, v := range amounts {
+		if v.IsZero() {
+			continue
+		}
+		date := d.Start.AddDate(0, i, 0)
+		allocations = append(allocations, CostAllocationProps{
+			Year:   date.Year(),
+			Month:  date.Month(),
+			Amount: v,
+		})
+	}
+
+	return allocations
+}
+
+// DistributeHours splits the hours across the months in whole hours.
+// Each month gets the integer part of its share, and the leftover hours go one by one
+// to the months with the largest fractional part, earlier months first on ties.
+// Months that receive no hours are left out.
+func (d *Distribution) DistributeHours(hours int) []EffortAllocationProps {
+	shares := d.getShares(decimal.NewFromInt(int64(hours)))
+
+	allocated := make([]int, len(shares))
+	remainders := make([]decimal.Decimal, len(shares))
+	leftover := hours
+	for i, v := range shares {
+		allocated[i] = int(v.IntPart())
+		remainders[i] = v.Sub(v.Floor())
+		leftover -= allocated[i]
+	}
+
+	order := make([]int, len(shares))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return remainders[order[a]].GreaterThan(remainders[order[b]])
+	})
+
+	for i := 0; leftover > 0; i = (i + 1) % len(order) {
+		allocated[order[i]]++
+		leftover--
+	}
+
+	allocations := make([]EffortAllocationProps, 0, len(allocated))
+	for i, v := range allocated {
+		if v == 0 {
+			continue
+		}
+		date := d.Start.AddDate(0, i, 0)
+		allocations = append(allocations, EffortAllocationProps{
+			Year:  date.Year(),
+			Month: date.Month(),
+			Hours: v,
+		})
+	}
+
+	return allocations
+}
+
+func (d *Distribution) getShares(total decimal.Decimal) []decimal.Decimal {
+	weights := d.GetWeights()
+
+	sum := decimal.Zero
+	for _, w := range weights {
+		sum = sum.Add(decimal.NewFromFloat(w))
+	}
+
+	shares := make([]decimal.Decimal, len(weights))
+	for i, w := range weights {
+		shares[i] = total.Mul(decimal.NewFromFloat(w).DivRound(sum, 16))
+	}
+
+	return shares
+}
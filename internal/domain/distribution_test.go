@@ -0,0 +1,241 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/celsopires1999/estimation/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitDistribution(t *testing.T) {
+	testCases := []struct {
+		label    string
+		strategy domain.DistributionStrategy
+		months   time.Month
+		weights  []float64
+		expected []string
+	}{
+		{
+			label:    "even spreads the residual cents over the first months",
+			strategy: domain.EvenDistribution,
+			months:   time.March,
+			expected: []string{"33.34", "33.33", "33.33"},
+		},
+		{
+			label:    "front loaded decreases month over month",
+			strategy: domain.FrontLoadedDistribution,
+			months:   time.April,
+			expected: []string{"40.00", "30.00", "20.00", "10.00"},
+		},
+		{
+			label:    "back loaded increases month over month",
+			strategy: domain.BackLoadedDistribution,
+			months:   time.April,
+			expected: []string{"10.00", "20.00", "30.00", "40.00"},
+		},
+		{
+			label:    "s curve concentrates the amount in the middle months",
+			strategy: domain.SCurveDistribution,
+			months:   time.April,
+			expected: []string{"14.64", "35.36", "35.36", "14.64"},
+		},
+		{
+			label:    "custom uses the weights",
+			strategy: domain.CustomDistribution,
+			months:   time.March,
+			weights:  []float64{1, 1, 3},
+			expected: []string{"20.00", "20.00", "60.00"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			distribution := domain.NewDistribution(domain.NewDistributionProps{
+				Strategy:   tc.strategy,
+				StartYear:  2025,
+				StartMonth: time.January,
+				EndYear:    2025,
+				EndMonth:   tc.months,
+				Weights:    tc.weights,
+			})
+			assert.NoError(t, distribution.Validate())
+
+			allocations := distribution.DistributeCost(common.NewMoney(100.00))
+
+			assert.Len(t, allocations, len(tc.expected))
+			total := common.ZeroMoney
+			for i, a := range allocations {
+				assert.Equal(t, 2025, a.Year)
+				assert.Equal(t, time.Month(i+1), a.Month)
+				assert.Equal(t, tc.expected[i], a.Amount.String())
+				total = total.Add(a.Amount)
+			}
+			assert.Equal(t, "100.00", total.String())
+		})
+	}
+
+	t.Run("should cross years", func(t *testing.T) {
+		distribution := domain.NewDistribution(domain.NewDistributionProps{
+			Strategy:   domain.EvenDistribution,
+			StartYear:  2024,
+			StartMonth: time.January,
+			EndYear:    2026,
+			EndMonth:   time.December,
+		})
+		assert.NoError(t, distribution.Validate())
+
+		allocations := distribution.DistributeCost(common.NewMoney(1_000.00))
+
+		assert.Len(t, allocations, 36)
+		assert.Equal(t, 2026, allocations[35].Year)
+		assert.Equal(t, time.December, allocations[35].Month)
+	})
+
+	t.Run("should fail when start is after end", func(t *testing.T) {
+		distribution := domain.NewDistribution(domain.NewDistributionProps{
+			Strategy:   domain.EvenDistribution,
+			StartYear:  2025,
+			StartMonth: time.March,
+			EndYear:    2025,
+			EndMonth:   time.January,
+		})
+
+		err := distribution.Validate()
+
+		var errDomainValidation *common.DomainValidationError
+		assert.True(t, errors.As(err, &errDomainValidation))
+		assert.EqualError(t, err, domain.ErrDistributionPeriod.Error())
+	})
+
+	t.Run("should fail when custom weights do not match the months", func(t *testing.T) {
+		distribution := domain.NewDistribution(domain.NewDistributionProps{
+			Strategy:   domain.CustomDistribution,
+			StartYear:  2025,
+			StartMonth: time.January,
+			EndYear:    2025,
+			EndMonth:   time.March,
+			Weights:    []float64{1, 2},
+		})
+
+		err := distribution.Validate()
+
+		var errDomainValidation *common.DomainValidationError
+		assert.True(t, errors.As(err, &errDomainValidation))
+		assert.EqualError(t, err, "distribution weights must have one value per month: expected 3, got 2")
+	})
+
+	t.Run("should fail when weights are sent for another strategy", func(t *testing.T) {
+		distribution := domain.NewDistribution(domain.NewDistributionProps{
+			Strategy:   domain.EvenDistribution,
+			StartYear:  2025,
+			StartMonth: time.January,
+			EndYear:    2025,
+			EndMonth:   time.February,
+			Weights:    []float64{1, 2},
+		})
+
+		err := distribution.Validate()
+
+		var errDomainValidation *common.DomainValidationError
+		assert.True(t, errors.As(err, &errDomainValidation))
+		assert.EqualError(t, err, domain.ErrDistributionUnusedWeights.Error())
+	})
+}
+
+func TestUnitDistributionHours(t *testing.T) {
+	testCases := []struct {
+		label    string
+		strategy domain.DistributionStrategy
+		hours    int
+		months   time.Month
+		weights  []float64
+		expected []int
+	}{
+		{
+			label:    "even gives the leftover hours to the first months",
+			strategy: domain.EvenDistribution,
+			hours:    100,
+			months:   time.March,
+			expected: []int{34, 33, 33},
+		},
+		{
+			label:    "ramp up increases month over month",
+			strategy: domain.RampUpDistribution,
+			hours:    100,
+			months:   time.April,
+			expected: []int{10, 20, 30, 40},
+		},
+		{
+			label:    "ramp down gives the leftover hours to the largest fractions",
+			strategy: domain.RampDownDistribution,
+			hours:    10,
+			months:   time.March,
+			expected: []int{5, 3, 2},
+		},
+		{
+			label:    "custom uses the weights and leaves out empty months",
+			strategy: domain.CustomDistribution,
+			hours:    7,
+			months:   time.March,
+			weights:  []float64{1, 0, 1},
+			expected: []int{4, 3},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			distribution := domain.NewDistribution(domain.NewDistributionProps{
+				Strategy:   tc.strategy,
+				StartYear:  2025,
+				StartMonth: time.January,
+				EndYear:    2025,
+				EndMonth:   tc.months,
+				Weights:    tc.weights,
+			})
+			assert.NoError(t, distribution.Validate())
+
+			allocations := distribution.DistributeHours(tc.hours)
+
+			hours := make([]int, len(allocations))
+			for i, a := range allocations {
+				hours[i] = a.Hours
+			}
+			assert.Equal(t, tc.expected, hours)
+
+			effort := domain.NewEffort(domain.NewEffortProps{
+				BaselineID:        "2eb1f2e6-bceb-45b2-a938-3d76b18b020f",
+				CompetenceID:      "5f9a5e2c-9d8f-4a4e-8d9e-2f3c4b5a6d7e",
+				Hours:             tc.hours,
+				EffortAllocations: allocations,
+			})
+			assert.NoError(t, effort.Validate())
+		})
+	}
+
+	t.Run("should not validate an effort distributed with more than 8000 hours in a month", func(t *testing.T) {
+		distribution := domain.NewDistribution(domain.NewDistributionProps{
+			Strategy:   domain.EvenDistribution,
+			StartYear:  2025,
+			StartMonth: time.January,
+			EndYear:    2025,
+			EndMonth:   time.February,
+		})
+		assert.NoError(t, distribution.Validate())
+
+		effort := domain.NewEffort(domain.NewEffortProps{
+			BaselineID:        "2eb1f2e6-bceb-45b2-a938-3d76b18b020f",
+			CompetenceID:      "5f9a5e2c-9d8f-4a4e-8d9e-2f3c4b5a6d7e",
+			Hours:             160_000,
+			EffortAllocations: distribution.DistributeHours(160_000),
+		})
+
+		err := effort.Validate()
+
+		var errDomainValidation *common.DomainValidationError
+		assert.True(t, errors.As(err, &errDomainValidation))
+		assert.ErrorContains(t, err, domain.ErrEffortAllocationHours.Error())
+	})
+}
@@ -0,0 +1,224 @@
+package domain
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/shopspring/decimal"
+)
+
+// MonthEarnedValue is the earned value status at the end of a month.
+// The planned value, earned value and actual cost add up the months until this one.
+type MonthEarnedValue struct {
+	Year            int
+	Month           time.Month
+	PlannedValue    common.Money
+	EarnedValue     common.Money
+	ActualCost      common.Money
+	PercentComplete float64
+	CPI             *float64
+	SPI             *float64
+	EAC             common.Money
+	ETC             common.Money
+	VAC             common.Money
+}
+
+// EarnedValue is the earned value of a budget, of a workload, or of the whole portfolio, month by month
+type EarnedValue struct {
+	CostID   string
+	EffortID string
+	BAC      common.Money
+	Months   []MonthEarnedValue
+}
+
+// Current is the status at the end of the last month, or an empty status when there are no months
+func (e EarnedValue) Current() MonthEarnedValue {
+	if len(e.Months) == 0 {
+		return earnedValueIndices(e.BAC, MonthEarnedValue{})
+	}
+	return e.Months[len(e.Months)-1]
+}
+
+type PortfolioEarnedValue struct {
+	EarnedValue
+	Costs   []EarnedValue
+	Efforts []EarnedValue
+}
+
+// earnedValueSeries holds the monthly amounts of a cost or an effort used by the earned value
+type earnedValueSeries struct {
+	costID   string
+	effortID string
+	bac      common.Money
+	planned  map[time.Time]common.Money
+	spent    map[time.Time]common.Money
+	percent  map[time.Time]float64
+}
+
+// CalculateEarnedValue returns the earned value by month for each cost and effort of the portfolio and for the whole portfolio.
+// The planned value comes from the budget and workload allocations, the actual cost from the actuals and the earned value
+// is the budget of each cost, or the amount of each workload, times its percent complete,
+// which holds from the month it is recorded until the next record.
+// Actuals are recorded per cost, so the efforts have no actual cost.
+func CalculateEarnedValue(budgets []*Budget, workloads []*Workload, actuals []*Actual, progress []*Progress) *PortfolioEarnedValue {
+	series := make([]*earnedValueSeries, 0, len(budgets)+len(workloads))
+	seriesOf := func(costID, effortID string) *earnedValueSeries {
+		for _, s := range series {
+			if s.costID == costID && s.effortID == effortID {
+				return s
+			}
+		}
+		s := &earnedValueSeries{
+			costID:   costID,
+			effortID: effortID,
+			planned:  make(map[time.Time]common.Money),
+			spent:    make(map[time.Time]common.Money),
+			percent:  make(map[time.Time]float64),
+		}
+		series = append(series, s)
+		return s
+	}
+
+	for _, b := range budgets {
+		s := seriesOf(b.CostID, "")
+		s.bac = s.bac.Add(b.Amount)
+		for _, a := range b.BudgetAllocations {
+			date := monthOf(a.AllocationDate)
+			s.planned[date] = s.planned[date].Add(a.Amount)
+		}
+	}
+	for _, w := range workloads {
+		s := seriesOf("", w.EffortID)
+		s.bac = s.bac.Add(w.Amount)
+		for _, a := range w.WorkloadAllocations {
+			date := monthOf(a.AllocationDate)
+			s.planned[date] = s.planned[date].Add(a.Amount)
+		}
+	}
+	for _, a := range actuals {
+		s := seriesOf(a.CostID, "")
+		date := monthOf(a.SpendDate)
+		s.spent[date] = s.spent[date].Add(a.Amount)
+	}
+	for _, p := range progress {
+		seriesOf(p.CostID, p.EffortID).percent[monthOf(p.ProgressDate)] = p.PercentComplete
+	}
+	slices.SortFunc(series, func(a, b *earnedValueSeries) int {
+		return cmp.Or(cmp.Compare(a.costID, b.costID), cmp.Compare(a.effortID, b.effortID))
+	})
+
+	portfolioDates := make(map[time.Time]bool)
+	for _, s := range series {
+		for date := range s.dates() {
+			portfolioDates[date] = true
+		}
+	}
+	dates := months[bool](portfolioDates, nil)
+
+	portfolio := &PortfolioEarnedValue{
+		EarnedValue: EarnedValue{Months: make([]MonthEarnedValue, len(dates))},
+		Costs:       make([]EarnedValue, 0, len(budgets)),
+		Efforts:     make([]EarnedValue, 0, len(workloads)),
+	}
+	for i, date := range dates {
+		portfolio.Months[i] = MonthEarnedValue{Year: date.Year(), Month: date.Month()}
+	}
+
+	for _, s := range series {
+		portfolio.BAC = portfolio.BAC.Add(s.bac)
+		for i, m := range s.cumulative(dates) {
+			portfolio.Months[i].PlannedValue = portfolio.Months[i].PlannedValue.Add(m.PlannedValue)
+			portfolio.Months[i].EarnedValue = portfolio.Months[i].EarnedValue.Add(m.EarnedValue)
+			portfolio.Months[i].ActualCost = portfolio.Months[i].ActualCost.Add(m.ActualCost)
+		}
+
+		earnedValue := EarnedValue{CostID: s.costID, EffortID: s.effortID, BAC: s.bac, Months: s.cumulative(months[bool](s.dates(), nil))}
+		for i, m := range earnedValue.Months {
+			earnedValue.Months[i] = earnedValueIndices(s.bac, m)
+		}
+		if s.effortID != "" {
+			portfolio.Efforts = append(portfolio.Efforts, earnedValue)
+		} else {
+			portfolio.Costs = append(portfolio.Costs, earnedValue)
+		}
+	}
+
+	for i, m := range portfolio.Months {
+		portfolio.Months[i] = earnedValueIndices(portfolio.BAC, m)
+	}
+
+	return portfolio
+}
+
+func (s *earnedValueSeries) dates() map[time.Time]bool {
+	dates := make(map[time.Time]bool)
+	for date := range s.planned {
+		dates[date] = true
+	}
+	for date := range s.spent {
+		dates[date] = true
+	}
+	for date := range s.percent {
+		dates[date] = true
+	}
+	return dates
+}
+
+// cumulative accumulates the planned value, actual cost and earned value of the series until each of the months
+func (s *earnedValueSeries) cumulative(dates []time.Time) []MonthEarnedValue {
+	result := make([]MonthEarnedValue, len(dates))
+
+	var plannedValue, actualCost common.Money
+	percent := 0.
+	for i, date := range dates {
+		plannedValue = plannedValue.Add(s.planned[date])
+		actualCost = actualCost.Add(s.spent[date])
+		if p, ok := s.percent[date]; ok {
+			percent = p
+		}
+		result[i] = MonthEarnedValue{
+			Year:         date.Year(),
+			Month:        date.Month(),
+			PlannedValue: plannedValue,
+			EarnedValue:  s.bac.Mul(decimal.NewFromFloat(percent).Shift(-2)).Round(),
+			ActualCost:   actualCost,
+		}
+	}
+	return result
+}
+
+// earnedValueIndices completes the month with the performance indices and the forecasts at completion.
+// While there is no cost or no earned value the cost performance is unknown,
+// and the estimate at completion assumes the remaining work will cost what was budgeted.
+func earnedValueIndices(bac common.Money, m MonthEarnedValue) MonthEarnedValue {
+	if !bac.IsZero() {
+		m.PercentComplete = ratio(m.EarnedValue, bac).Shift(2).Round(2).InexactFloat64()
+	}
+
+	m.CPI, m.SPI = nil, nil
+	if !m.ActualCost.IsZero() {
+		cpi := ratio(m.EarnedValue, m.ActualCost).Round(2).InexactFloat64()
+		m.CPI = &cpi
+	}
+	if !m.PlannedValue.IsZero() {
+		spi := ratio(m.EarnedValue, m.PlannedValue).Round(2).InexactFloat64()
+		m.SPI = &spi
+	}
+
+	remaining := bac.Sub(m.EarnedValue)
+	if m.ActualCost.IsZero() || m.EarnedValue.IsZero() {
+		m.EAC = m.ActualCost.Add(remaining)
+	} else {
+		m.EAC = m.ActualCost.Add(remaining.Mul(ratio(m.ActualCost, m.EarnedValue))).Round()
+	}
+	m.ETC = m.EAC.Sub(m.ActualCost)
+	m.VAC = bac.Sub(m.EAC)
+
+	return m
+}
+
+func ratio(a, b common.Money) decimal.Decimal {
+	return a.Decimal().Div(b.Decimal())
+}
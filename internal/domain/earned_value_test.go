@@ -0,0 +1,258 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/celsopires1999/estimation/internal/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitEarnedValue(t *testing.T) {
+	portfolioID := uuid.NewString()
+	costA := "0a000000-0000-4000-8000-000000000000"
+	costB := "0b000000-0000-4000-8000-000000000000"
+	effortE := "0e000000-0000-4000-8000-000000000000"
+
+	budgets := []*domain.Budget{
+		domain.NewBudget(domain.NewBudgetProps{
+			PortfolioID: portfolioID,
+			CostID:      costA,
+			Amount:      common.NewMoney(1000.00),
+			BudgetAllocations: []domain.NewBudgetAllocationProps{
+				{Year: 2025, Month: time.January, Amount: common.NewMoney(400.00)},
+				{Year: 2025, Month: time.February, Amount: common.NewMoney(300.00)},
+				{Year: 2025, Month: time.March, Amount: common.NewMoney(300.00)},
+			},
+		}),
+		domain.NewBudget(domain.NewBudgetProps{
+			PortfolioID: portfolioID,
+			CostID:      costB,
+			Amount:      common.NewMoney(500.00),
+			BudgetAllocations: []domain.NewBudgetAllocationProps{
+				{Year: 2025, Month: time.February, Amount: common.NewMoney(500.00)},
+			},
+		}),
+	}
+
+	newActual := func(costID string, month time.Month, amount float64) *domain.Actual {
+		return domain.NewActual(domain.NewActualProps{
+			PortfolioID: portfolioID,
+			CostID:      costID,
+			Year:        2025,
+			Month:       month,
+			Amount:      common.NewMoney(amount),
+		})
+	}
+
+	newProgress := func(costID string, month time.Month, percent float64) *domain.Progress {
+		return domain.NewProgress(domain.NewProgressProps{
+			PortfolioID:     portfolioID,
+			CostID:          costID,
+			Year:            2025,
+			Month:           month,
+			PercentComplete: percent,
+		})
+	}
+
+	actuals := []*domain.Actual{
+		newActual(costA, time.January, 450.00),
+		newActual(costA, time.February, 250.00),
+	}
+
+	t.Run("should calculate the earned value of each cost by month", func(t *testing.T) {
+		progress := []*domain.Progress{
+			newProgress(costA, time.January, 40),
+			newProgress(costA, time.February, 60),
+		}
+
+		earnedValue := domain.CalculateEarnedValue(budgets, nil, actuals, progress)
+
+		assert.Len(t, earnedValue.Costs, 2)
+		cost := earnedValue.Costs[0]
+		assert.Equal(t, costA, cost.CostID)
+		assert.Len(t, cost.Months, 3)
+
+		january := cost.Months[0]
+		assert.Equal(t, "400.00", january.PlannedValue.String())
+		assert.Equal(t, "400.00", january.EarnedValue.String())
+		assert.Equal(t, "450.00", january.ActualCost.String())
+		assert.Equal(t, 40.0, january.PercentComplete)
+		assert.Equal(t, 0.89, *january.CPI)
+		assert.Equal(t, 1.0, *january.SPI)
+		assert.Equal(t, "1125.00", january.EAC.String())
+		assert.Equal(t, "675.00", january.ETC.String())
+		assert.Equal(t, "-125.00", january.VAC.String())
+
+		march := cost.Months[2]
+		assert.Equal(t, "1000.00", march.PlannedValue.String())
+		assert.Equal(t, "600.00", march.EarnedValue.String())
+		assert.Equal(t, "700.00", march.ActualCost.String())
+		assert.Equal(t, 0.86, *march.CPI)
+		assert.Equal(t, 0.6, *march.SPI)
+		assert.Equal(t, "1166.67", march.EAC.String())
+		assert.Equal(t, "466.67", march.ETC.String())
+		assert.Equal(t, "-166.67", march.VAC.String())
+		assert.Equal(t, march, cost.Current())
+
+		notStarted := earnedValue.Costs[1].Current()
+		assert.Nil(t, notStarted.CPI)
+		assert.Equal(t, 0.0, *notStarted.SPI)
+		assert.Equal(t, "500.00", notStarted.EAC.String())
+	})
+
+	t.Run("should add up the costs for the portfolio", func(t *testing.T) {
+		progress := []*domain.Progress{newProgress(costA, time.February, 60)}
+
+		earnedValue := domain.CalculateEarnedValue(budgets, nil, actuals, progress)
+
+		assert.Equal(t, "1500.00", earnedValue.BAC.String())
+		assert.Len(t, earnedValue.Months, 3)
+
+		february := earnedValue.Months[1]
+		assert.Equal(t, "1200.00", february.PlannedValue.String())
+		assert.Equal(t, "600.00", february.EarnedValue.String())
+		assert.Equal(t, "700.00", february.ActualCost.String())
+		assert.Equal(t, 40.0, february.PercentComplete)
+		assert.Equal(t, 0.86, *february.CPI)
+		assert.Equal(t, 0.5, *february.SPI)
+		assert.Equal(t, "1750.00", february.EAC.String())
+		assert.Equal(t, "-250.00", february.VAC.String())
+	})
+
+	t.Run("should add up the costs and the efforts for the portfolio", func(t *testing.T) {
+		workloads := []*domain.Workload{
+			domain.NewWorkload(domain.NewWorkloadProps{
+				PortfolioID: portfolioID,
+				EffortID:    effortE,
+				Hours:       60,
+				Amount:      common.NewMoney(600.00),
+				WorkloadAllocations: []domain.NewWorkloadAllocationProps{
+					{Year: 2025, Month: time.January, Hours: 20, Amount: common.NewMoney(200.00)},
+					{Year: 2025, Month: time.February, Hours: 20, Amount: common.NewMoney(200.00)},
+					{Year: 2025, Month: time.March, Hours: 20, Amount: common.NewMoney(200.00)},
+				},
+			}),
+		}
+		progress := []*domain.Progress{
+			newProgress(costA, time.February, 60),
+			domain.NewProgress(domain.NewProgressProps{
+				PortfolioID:     portfolioID,
+				EffortID:        effortE,
+				Year:            2025,
+				Month:           time.February,
+				PercentComplete: 50,
+			}),
+		}
+
+		earnedValue := domain.CalculateEarnedValue(budgets, workloads, actuals, progress)
+
+		assert.Equal(t, "2100.00", earnedValue.BAC.String())
+		assert.Len(t, earnedValue.Costs, 2)
+		assert.Len(t, earnedValue.Efforts, 1)
+
+		effort := earnedValue.Efforts[0]
+		assert.Equal(t, effortE, effort.EffortID)
+		assert.Empty(t, effort.CostID)
+		assert.Equal(t, "600.00", effort.BAC.String())
+		assert.Len(t, effort.Months, 3)
+		assert.Equal(t, "400.00", effort.Months[1].PlannedValue.String())
+		assert.Equal(t, "300.00", effort.Months[1].EarnedValue.String())
+		assert.True(t, effort.Months[1].ActualCost.IsZero())
+		assert.Nil(t, effort.Months[1].CPI)
+		assert.Equal(t, 0.75, *effort.Months[1].SPI)
+
+		february := earnedValue.Months[1]
+		assert.Equal(t, "1600.00", february.PlannedValue.String())
+		assert.Equal(t, "900.00", february.EarnedValue.String())
+		assert.Equal(t, "700.00", february.ActualCost.String())
+		assert.Equal(t, 42.86, february.PercentComplete)
+		assert.Equal(t, 1.29, *february.CPI)
+		assert.Equal(t, 0.56, *february.SPI)
+		assert.Equal(t, "1633.33", february.EAC.String())
+		assert.Equal(t, "466.67", february.VAC.String())
+	})
+
+	t.Run("should record progress for either a cost or an effort", func(t *testing.T) {
+		for _, props := range []domain.NewProgressProps{
+			{PortfolioID: portfolioID, Year: 2025, Month: time.January, PercentComplete: 10},
+			{PortfolioID: portfolioID, CostID: costA, EffortID: effortE, Year: 2025, Month: time.January, PercentComplete: 10},
+		} {
+			err := domain.NewProgress(props).Validate()
+
+			var errDomainValidation *common.DomainValidationError
+			assert.True(t, errors.As(err, &errDomainValidation))
+			assert.ErrorContains(t, err, domain.ErrProgressCostOrEffort.Error())
+		}
+
+		progress := newProgress(costA, time.January, 10)
+		assert.NoError(t, progress.Validate())
+		assert.NoError(t, progress.ValidatePortfolio(budgets, nil))
+
+		err := domain.NewProgress(domain.NewProgressProps{
+			PortfolioID: portfolioID, EffortID: effortE, Year: 2025, Month: time.January, PercentComplete: 10,
+		}).ValidatePortfolio(budgets, nil)
+		assert.ErrorContains(t, err, domain.ErrProgressEffortNotInPortfolio.Error())
+	})
+
+	t.Run("should keep the budget as estimate while nothing was earned", func(t *testing.T) {
+		earnedValue := domain.CalculateEarnedValue(budgets, nil, nil, nil)
+
+		current := earnedValue.Current()
+		assert.Nil(t, current.CPI)
+		assert.Equal(t, "1500.00", current.EAC.String())
+		assert.Equal(t, "1500.00", current.ETC.String())
+		assert.True(t, current.VAC.IsZero())
+	})
+
+	t.Run("should re-phase the remaining budget after the as of month", func(t *testing.T) {
+		late := append([]*domain.Actual{newActual(costA, time.April, 10.00)}, actuals...)
+		asOf := time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC)
+
+		forecast, err := domain.ForecastBudgets(budgets, late, asOf, domain.EvenDistribution)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "700.00", forecast.Actual.String())
+		assert.Equal(t, "800.00", forecast.Remaining.String())
+		assert.Equal(t, "1500.00", forecast.Forecast().String())
+
+		cost := forecast.Costs[0]
+		assert.Equal(t, "300.00", cost.Remaining.String())
+		assert.Len(t, cost.Months, 3)
+		assert.Equal(t, "450.00", cost.Months[0].Forecast.String())
+		assert.Equal(t, "250.00", cost.Months[1].Forecast.String())
+		assert.Equal(t, "300.00", cost.Months[2].Forecast.String())
+
+		ended := forecast.Costs[1]
+		assert.Len(t, ended.Months, 2)
+		assert.True(t, ended.Months[0].Forecast.IsZero())
+		assert.Equal(t, time.March, ended.Months[1].Month)
+		assert.Equal(t, "500.00", ended.Months[1].Forecast.String())
+	})
+
+	t.Run("should spread the remaining budget with the strategy", func(t *testing.T) {
+		asOf := time.Date(2024, time.December, 1, 0, 0, 0, 0, time.UTC)
+
+		forecast, err := domain.ForecastBudgets(budgets[:1], nil, asOf, domain.SCurveDistribution)
+
+		assert.NoError(t, err)
+		cost := forecast.Costs[0]
+		assert.Len(t, cost.Months, 3)
+		assert.True(t, cost.Months[1].Forecast.Cmp(cost.Months[0].Forecast) > 0)
+		assert.Equal(t, "1000.00", common.SumMoney(cost.Months[0].Forecast, cost.Months[1].Forecast, cost.Months[2].Forecast).String())
+	})
+
+	t.Run("should leave nothing to re-phase when the budget is overspent", func(t *testing.T) {
+		overspent := []*domain.Actual{newActual(costA, time.January, 1200.00)}
+		asOf := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+		forecast, err := domain.ForecastBudgets(budgets[:1], overspent, asOf, domain.EvenDistribution)
+
+		assert.NoError(t, err)
+		assert.True(t, forecast.Remaining.IsZero())
+		assert.Equal(t, "1200.00", forecast.Forecast().String())
+	})
+}
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"errors"
 	"fmt"
 	"slices"
 	"time"
@@ -9,6 +10,12 @@ import (
 	"github.com/google/uuid"
 )
 
+// MaxEffortAllocationHours is the most hours an effort can have in a month,
+// whether the allocations are entered or distributed
+const MaxEffortAllocationHours = 8_000
+
+var ErrEffortAllocationHours = errors.New("effort allocation has more than 8000 hours in a month")
+
 type Effort struct {
 	EffortID          string             `validate:"required,uuid"`
 	BaselineID        string             `validate:"required,uuid"`
@@ -53,6 +60,23 @@ func NewEffort(props NewEffortProps) *Effort {
 	}
 }
 
+// Copy returns a new effort with the same values in the baseline, with the allocations shifted by the months
+func (e *Effort) Copy(baselineID string, shiftMonths int) *Effort {
+	allocations := make([]EffortAllocationProps, len(e.EffortAllocations))
+	for i, a := range e.EffortAllocations {
+		date := a.AllocationDate.AddDate(0, shiftMonths, 0)
+		allocations[i] = EffortAllocationProps{Year: date.Year(), Month: date.Month(), Hours: a.Hours}
+	}
+
+	return NewEffort(NewEffortProps{
+		BaselineID:        baselineID,
+		CompetenceID:      e.CompetenceID,
+		Comment:           e.Comment,
+		Hours:             e.Hours,
+		EffortAllocations: allocations,
+	})
+}
+
 func newEffortAllocation(year int, month time.Month, hours int) EffortAllocation {
 	date := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
 	return EffortAllocation{
@@ -96,6 +120,9 @@ func (e *Effort) Validate() error {
 
 	total := 0
 	for _, v := range e.EffortAllocations {
+		if v.Hours > MaxEffortAllocationHours {
+			return common.NewDomainValidationError(fmt.Errorf("%w: %d in %s", ErrEffortAllocationHours, v.Hours, v.AllocationDate.Format("2006-01")))
+		}
 		total += v.Hours
 	}
 
@@ -2,12 +2,15 @@ package domain
 
 import (
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/celsopires1999/estimation/internal/common"
 )
 
 type exchange struct {
-	rateMap map[currencyYear]float64
+	rateMap        map[currencyYear]float64
+	monthlyRateMap map[currencyMonth]float64
 }
 
 type currencyYear struct {
@@ -15,30 +18,59 @@ type currencyYear struct {
 	year     int
 }
 
+type currencyMonth struct {
+	currency Currency
+	year     int
+	month    time.Month
+}
+
 type rate struct {
 	currency Currency
 	year     int
 	rate     float64
 }
 
-func NewExchange(rates []rate) *exchange {
+type monthlyRate struct {
+	currency Currency
+	year     int
+	month    time.Month
+	rate     float64
+}
+
+func NewExchange(rates []rate, monthlyRates []monthlyRate) *exchange {
 	rateMap := make(map[currencyYear]float64, len(rates))
 	for _, rate := range rates {
 		rateMap[currencyYear{rate.currency, rate.year}] = rate.rate
 	}
 
+	monthlyRateMap := make(map[currencyMonth]float64, len(monthlyRates))
+	for _, rate := range monthlyRates {
+		monthlyRateMap[currencyMonth{rate.currency, rate.year, rate.month}] = rate.rate
+	}
+
 	return &exchange{
 		rateMap,
+		monthlyRateMap,
 	}
 }
 
 var ErrInvalidCurrencyYear = errors.New("currency and year combination not found")
 
-func (e *exchange) ConvertToBRL(value float64, currency Currency, year int) (float64, error) {
+// ConvertToBRL converts the value using the rate of the month when there is one,
+// otherwise the rate of the year is used. The result is not rounded.
+func (e *exchange) ConvertToBRL(value common.Money, currency Currency, year int, month time.Month) (common.Money, error) {
+	if currency.IsBRL() {
+		return value, nil
+	}
+
+	if rate, ok := e.monthlyRateMap[currencyMonth{currency, year, month}]; ok {
+		return applyFactor(value, rate), nil
+	}
+
 	rate, ok := e.rateMap[currencyYear{currency, year}]
 	if !ok {
-		return 0, common.NewDomainValidationError(ErrInvalidCurrencyYear)
+		return common.ZeroMoney, common.NewDomainValidationError(fmt.Errorf("%w: %s %d", ErrInvalidCurrencyYear, currency, year))
 	}
 
-	return common.RoundToTwoDecimals(value * rate), nil
+	return applyFactor(value, rate), nil
 }
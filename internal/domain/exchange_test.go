@@ -0,0 +1,56 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/celsopires1999/estimation/internal/domain"
+	"github.com/celsopires1999/estimation/internal/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitExchange(t *testing.T) {
+	t.Run("should use the monthly rate and fall back to the yearly rate", func(t *testing.T) {
+		plan := testutils.NewPlanFakeBuilder().Build()
+		for i := range plan.Assumptions[0].Currencies {
+			if plan.Assumptions[0].Currencies[i].Currency.IsUSD() {
+				plan.Assumptions[0].Currencies[i].Monthly = []domain.MonthlyExchange{
+					{Month: 3, Exchange: 5.50},
+				}
+			}
+		}
+		assert.NoError(t, plan.Validate())
+
+		year := plan.Assumptions[0].Year
+		var yearly float64
+		for _, c := range plan.Assumptions[0].Currencies {
+			if c.Currency.IsUSD() {
+				yearly = c.Exchange
+			}
+		}
+
+		exchange := plan.GetExchange()
+
+		amount, err := exchange.ConvertToBRL(common.NewMoney(100.00), domain.USD, year, time.March)
+		assert.NoError(t, err)
+		assert.Equal(t, "550.00", amount.String())
+
+		amount, err = exchange.ConvertToBRL(common.NewMoney(100.00), domain.USD, year, time.April)
+		assert.NoError(t, err)
+		assert.Equal(t, common.NewMoney(100.00*yearly).Round().String(), amount.String())
+	})
+
+	t.Run("should fail when a month is repeated for a currency", func(t *testing.T) {
+		plan := testutils.NewPlanFakeBuilder().Build()
+		plan.Assumptions[0].Currencies[0].Monthly = []domain.MonthlyExchange{
+			{Month: 3, Exchange: 5.50},
+			{Month: 3, Exchange: 5.60},
+		}
+
+		err := plan.Validate()
+		var errDomainValidation *common.DomainValidationError
+		assert.True(t, errors.As(err, &errDomainValidation))
+	})
+}
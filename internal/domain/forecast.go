@@ -0,0 +1,135 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/celsopires1999/estimation/internal/common"
+)
+
+// MonthForecast is the budget of a month against its forecast, which is the actual spend
+// until the forecast month and the re-phased remaining budget after it
+type MonthForecast struct {
+	Year     int
+	Month    time.Month
+	Budget   common.Money
+	Actual   common.Money
+	Forecast common.Money
+}
+
+// BudgetForecast is the forecast of a budget, or of all the budgets of a portfolio
+type BudgetForecast struct {
+	CostID    string
+	Budget    common.Money
+	Actual    common.Money
+	Remaining common.Money
+	Months    []MonthForecast
+}
+
+// Forecast is the total expected to be spent, the actual spend plus the re-phased remaining budget
+func (f BudgetForecast) Forecast() common.Money {
+	return f.Actual.Add(f.Remaining)
+}
+
+type PortfolioForecast struct {
+	BudgetForecast
+	AsOf  time.Time
+	Costs []BudgetForecast
+}
+
+// ForecastBudgets re-phases what is left of each budget after the actual spend until the as of month.
+// The remaining budget is spread with the distribution strategy from the month after the as of month
+// to the last month of the budget, or over the next month when the budget has already ended.
+// Actuals after the as of month are not taken into account and a budget already overspent has nothing left.
+func ForecastBudgets(budgets []*Budget, actuals []*Actual, asOf time.Time, strategy DistributionStrategy) (*PortfolioForecast, error) {
+	asOf = monthOf(asOf)
+
+	portfolioBudget := make(map[time.Time]common.Money)
+	portfolioActual := make(map[time.Time]common.Money)
+	portfolioPhased := make(map[time.Time]common.Money)
+
+	forecast := &PortfolioForecast{AsOf: asOf, Costs: make([]BudgetForecast, 0, len(budgets))}
+
+	for _, b := range budgets {
+		planned := make(map[time.Time]common.Money)
+		spent := make(map[time.Time]common.Money)
+		phased := make(map[time.Time]common.Money)
+
+		end := asOf.AddDate(0, 1, 0)
+		for _, a := range b.BudgetAllocations {
+			date := monthOf(a.AllocationDate)
+			planned[date] = planned[date].Add(a.Amount)
+			if date.After(end) {
+				end = date
+			}
+		}
+
+		cost := BudgetForecast{CostID: b.CostID, Budget: b.Amount}
+		for _, a := range actuals {
+			date := monthOf(a.SpendDate)
+			if a.CostID != b.CostID || date.After(asOf) {
+				continue
+			}
+			spent[date] = spent[date].Add(a.Amount)
+			cost.Actual = cost.Actual.Add(a.Amount)
+		}
+
+		if remaining := b.Amount.Sub(cost.Actual); remaining.IsPositive() {
+			start := asOf.AddDate(0, 1, 0)
+			distribution := NewDistribution(NewDistributionProps{
+				Strategy:   strategy,
+				StartYear:  start.Year(),
+				StartMonth: start.Month(),
+				EndYear:    end.Year(),
+				EndMonth:   end.Month(),
+			})
+			if err := distribution.Validate(); err != nil {
+				return nil, err
+			}
+			for _, a := range distribution.DistributeCost(remaining) {
+				phased[time.Date(a.Year, a.Month, 1, 0, 0, 0, 0, time.UTC)] = a.Amount
+			}
+			cost.Remaining = remaining
+		}
+
+		cost.Months = forecastMonths(planned, spent, phased)
+		forecast.Costs = append(forecast.Costs, cost)
+
+		forecast.Budget = forecast.Budget.Add(cost.Budget)
+		forecast.Actual = forecast.Actual.Add(cost.Actual)
+		forecast.Remaining = forecast.Remaining.Add(cost.Remaining)
+		addMonths(portfolioBudget, planned)
+		addMonths(portfolioActual, spent)
+		addMonths(portfolioPhased, phased)
+	}
+
+	forecast.Months = forecastMonths(portfolioBudget, portfolioActual, portfolioPhased)
+
+	return forecast, nil
+}
+
+func forecastMonths(planned, spent, phased map[time.Time]common.Money) []MonthForecast {
+	dates := make(map[time.Time]bool)
+	for _, amounts := range []map[time.Time]common.Money{planned, spent, phased} {
+		for date := range amounts {
+			dates[date] = true
+		}
+	}
+
+	result := make([]MonthForecast, 0, len(dates))
+	for _, date := range months[bool](dates, nil) {
+		result = append(result, MonthForecast{
+			Year:     date.Year(),
+			Month:    date.Month(),
+			Budget:   planned[date],
+			Actual:   spent[date],
+			Forecast: spent[date].Add(phased[date]),
+		})
+	}
+	return result
+}
+
+func addMonths(total, amounts map[time.Time]common.Money) {
+	for date, amount := range amounts {
+		total[date] = total[date].Add(amount)
+	}
+}
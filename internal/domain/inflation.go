@@ -2,13 +2,34 @@ package domain
 
 import (
 	"errors"
+	"math"
+	"time"
 
 	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/shopspring/decimal"
 )
 
+type InflationMode string
+
+const (
+	AnnualInflation          InflationMode = "annual"
+	MonthlyCompoundInflation InflationMode = "monthly_compound"
+	MonthlyLinearInflation   InflationMode = "monthly_linear"
+)
+
+func (m InflationMode) String() string {
+	return string(m)
+}
+
+func (m InflationMode) IsMonthly() bool {
+	return m == MonthlyCompoundInflation || m == MonthlyLinearInflation
+}
+
 type inflation struct {
-	factors []factor
-	table   map[period]float64
+	mode         InflationMode
+	factors      []factor
+	table        map[period]float64
+	monthlyTable map[period]float64
 }
 
 type factor struct {
@@ -27,22 +48,24 @@ var (
 	ErrNoEndInflation   = errors.New("there is no inflation for end month")
 )
 
-func NewInflation(factors []factor) *inflation {
+func NewInflation(factors []factor, mode InflationMode) *inflation {
 	return &inflation{
-		factors: factors,
-		table:   make(map[period]float64),
+		mode:         mode,
+		factors:      factors,
+		table:        make(map[period]float64),
+		monthlyTable: make(map[period]float64),
 	}
 }
 
-func (i *inflation) ApplyInflation(value float64, start int, end int) (float64, error) {
+func (i *inflation) ApplyInflation(value common.Money, start int, end int) (common.Money, error) {
 	if start > end {
-		return 0.0, common.NewDomainValidationError(ErrInvalidPeriod)
+		return common.ZeroMoney, common.NewDomainValidationError(ErrInvalidPeriod)
 	}
 	if start == end {
-		return common.RoundToTwoDecimals(value), nil
+		return value, nil
 	}
 	if acumulatedInflation, ok := i.table[period{start, end}]; ok {
-		return common.RoundToTwoDecimals(acumulatedInflation * value), nil
+		return applyFactor(value, acumulatedInflation), nil
 	}
 
 	hasStart := false
@@ -66,10 +89,10 @@ func (i *inflation) ApplyInflation(value float64, start int, end int) (float64,
 	}
 
 	if !hasStart {
-		return 0.0, common.NewDomainValidationError(ErrNoStartInflation)
+		return common.ZeroMoney, common.NewDomainValidationError(ErrNoStartInflation)
 	}
 	if !hasEnd {
-		return 0.0, common.NewDomainValidationError(ErrNoEndInflation)
+		return common.ZeroMoney, common.NewDomainValidationError(ErrNoEndInflation)
 	}
 
 	acumulatedInflation = common.RoundToFourDecimals(acumulatedInflation)
@@ -77,5 +100,73 @@ func (i *inflation) ApplyInflation(value float64, start int, end int) (float64,
 	p := period{start, end}
 	i.table[p] = acumulatedInflation
 
-	return common.RoundToTwoDecimals(acumulatedInflation * value), nil
+	return applyFactor(value, acumulatedInflation), nil
+}
+
+// ApplyInflationByMonth applies the inflation accumulated from the start month to the end month.
+// In annual mode only the years are considered, so it behaves like ApplyInflation.
+// In monthly modes each month of a year receives 1/12 of that year's inflation,
+// either compounded month over month or accrued linearly within the year.
+// The result is not rounded, rounding to cents happens when the budget is allocated.
+func (i *inflation) ApplyInflationByMonth(value common.Money, start time.Time, end time.Time) (common.Money, error) {
+	if !i.mode.IsMonthly() {
+		return i.ApplyInflation(value, start.Year(), end.Year())
+	}
+
+	startMonth := monthIndex(start)
+	endMonth := monthIndex(end)
+
+	if startMonth > endMonth {
+		return common.ZeroMoney, common.NewDomainValidationError(ErrInvalidPeriod)
+	}
+	if startMonth == endMonth {
+		return value, nil
+	}
+	if acumulatedInflation, ok := i.monthlyTable[period{startMonth, endMonth}]; ok {
+		return applyFactor(value, acumulatedInflation), nil
+	}
+
+	rates := make(map[int]float64, len(i.factors))
+	for _, f := range i.factors {
+		rates[f.year] = f.inflation
+	}
+
+	firstYear := (startMonth + 1) / 12
+	lastYear := endMonth / 12
+
+	acumulatedInflation := float64(1)
+	for year := firstYear; year <= lastYear; year++ {
+		rate, ok := rates[year]
+		if !ok {
+			if year == firstYear {
+				return common.ZeroMoney, common.NewDomainValidationError(ErrNoStartInflation)
+			}
+			return common.ZeroMoney, common.NewDomainValidationError(ErrNoEndInflation)
+		}
+
+		from := max(startMonth+1, year*12)
+		to := min(endMonth, year*12+11)
+		months := float64(to - from + 1)
+
+		if i.mode == MonthlyCompoundInflation {
+			acumulatedInflation *= math.Pow(1+(rate/100), months/12)
+		} else {
+			acumulatedInflation *= 1 + (rate/100)*(months/12)
+		}
+	}
+
+	acumulatedInflation = common.RoundToFourDecimals(acumulatedInflation)
+
+	p := period{startMonth, endMonth}
+	i.monthlyTable[p] = acumulatedInflation
+
+	return applyFactor(value, acumulatedInflation), nil
+}
+
+func applyFactor(value common.Money, factor float64) common.Money {
+	return value.Mul(decimal.NewFromFloat(factor))
+}
+
+func monthIndex(date time.Time) int {
+	return date.Year()*12 + int(date.Month()) - 1
 }
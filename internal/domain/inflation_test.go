@@ -0,0 +1,107 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/celsopires1999/estimation/internal/domain"
+	"github.com/celsopires1999/estimation/internal/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitInflation(t *testing.T) {
+	date := func(year int, month time.Month) time.Time {
+		return time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	}
+
+	testCases := []struct {
+		label    string
+		mode     domain.InflationMode
+		start    time.Time
+		end      time.Time
+		expected string
+	}{
+		{
+			label:    "annual mode applies a full year of inflation when the year changes",
+			mode:     domain.AnnualInflation,
+			start:    date(2024, time.December),
+			end:      date(2025, time.January),
+			expected: "1052.00",
+		},
+		{
+			label:    "annual mode ignores months within the same year",
+			mode:     domain.AnnualInflation,
+			start:    date(2025, time.January),
+			end:      date(2025, time.December),
+			expected: "1000.00",
+		},
+		{
+			label:    "monthly compound mode applies one month of inflation",
+			mode:     domain.MonthlyCompoundInflation,
+			start:    date(2024, time.December),
+			end:      date(2025, time.January),
+			expected: "1004.20",
+		},
+		{
+			label:    "monthly compound mode applies twelve months of the same year",
+			mode:     domain.MonthlyCompoundInflation,
+			start:    date(2024, time.December),
+			end:      date(2025, time.December),
+			expected: "1052.00",
+		},
+		{
+			label:    "monthly compound mode spreads months across years",
+			mode:     domain.MonthlyCompoundInflation,
+			start:    date(2025, time.June),
+			end:      date(2026, time.June),
+			expected: "1052.30",
+		},
+		{
+			label:    "monthly linear mode accrues inflation linearly within a year",
+			mode:     domain.MonthlyLinearInflation,
+			start:    date(2024, time.December),
+			end:      date(2025, time.June),
+			expected: "1026.00",
+		},
+		{
+			label:    "monthly linear mode compounds across years",
+			mode:     domain.MonthlyLinearInflation,
+			start:    date(2025, time.June),
+			end:      date(2026, time.June),
+			expected: "1053.00",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			plan := testutils.NewPlanFakeBuilder().WithInflationMode(tc.mode).Build()
+
+			amount, err := plan.GetInflation().ApplyInflationByMonth(common.NewMoney(1000.00), tc.start, tc.end)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, amount.String())
+		})
+	}
+
+	t.Run("should fail when start is after end", func(t *testing.T) {
+		plan := testutils.NewPlanFakeBuilder().WithInflationMode(domain.MonthlyCompoundInflation).Build()
+
+		_, err := plan.GetInflation().ApplyInflationByMonth(common.NewMoney(1000.00), date(2025, time.March), date(2025, time.February))
+
+		var errDomainValidation *common.DomainValidationError
+		assert.True(t, errors.As(err, &errDomainValidation))
+		assert.EqualError(t, err, domain.ErrInvalidPeriod.Error())
+	})
+
+	t.Run("should fail when there is no inflation for the end month", func(t *testing.T) {
+		plan := testutils.NewPlanFakeBuilder().WithInflationMode(domain.MonthlyLinearInflation).Build()
+
+		_, err := plan.GetInflation().ApplyInflationByMonth(common.NewMoney(1000.00), date(2027, time.June), date(2028, time.January))
+
+		var errDomainValidation *common.DomainValidationError
+		assert.True(t, errors.As(err, &errDomainValidation))
+		assert.EqualError(t, err, domain.ErrNoEndInflation.Error())
+	})
+}
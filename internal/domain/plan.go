@@ -12,12 +12,13 @@ import (
 )
 
 type Plan struct {
-	PlanID      string      `validate:"required,uuid4"`
-	Code        string      `validate:"required,max=10"`
-	Name        string      `validate:"required,max=50"`
-	Assumptions Assumptions `validate:"required,dive"`
-	CreatedAt   time.Time   `validate:"-"`
-	UpdatedAt   time.Time   `validate:"-"`
+	PlanID        string        `validate:"required,uuid4"`
+	Code          string        `validate:"required,max=10"`
+	Name          string        `validate:"required,max=50"`
+	Assumptions   Assumptions   `validate:"required,dive"`
+	InflationMode InflationMode `validate:"required,oneof=annual monthly_compound monthly_linear"`
+	CreatedAt     time.Time     `validate:"-"`
+	UpdatedAt     time.Time     `validate:"-"`
 }
 
 type Assumptions []Assumption
@@ -29,8 +30,15 @@ type Assumption struct {
 }
 
 type CurrencyAssumption struct {
-	Currency Currency `json:"currency" validate:"required,oneof=USD EUR"`
-	Exchange float64  `json:"exchange" validate:"gte=0,twodecimals"`
+	Currency Currency          `json:"currency" validate:"required,len=3,alpha,uppercase,ne=BRL"`
+	Exchange float64           `json:"exchange" validate:"gte=0,twodecimals"`
+	Monthly  []MonthlyExchange `json:"monthly,omitempty" validate:"omitempty,dive"`
+}
+
+// MonthlyExchange overrides the yearly exchange rate for one month
+type MonthlyExchange struct {
+	Month    int     `json:"month" validate:"gte=1,lte=12"`
+	Exchange float64 `json:"exchange" validate:"gte=0,twodecimals"`
 }
 
 type RestorePlanProps Plan
@@ -38,20 +46,22 @@ type RestorePlanProps Plan
 var (
 	ErrPlanValidation             = errors.New("plan domain validation failed")
 	ErrAssumptionConsecutiveYears = errors.New("assumptions must have consecutive years")
-	ErrAssumptionCurrencyEUR      = errors.New("one EUR currency per year is required")
-	ErrAssumptionCurrencyUSD      = errors.New("one USD currency per year is required")
+	ErrAssumptionCurrencyRepeated = errors.New("each currency must have one exchange rate per year")
+	ErrAssumptionMonthRepeated    = errors.New("each currency must have one exchange rate per month")
 )
 
 func NewPlan(
 	code string,
 	name string,
 	assumptions Assumptions,
+	inflationMode InflationMode,
 ) *Plan {
 	plan := &Plan{
-		PlanID:      uuid.NewString(),
-		Code:        code,
-		Name:        name,
-		Assumptions: assumptions,
+		PlanID:        uuid.NewString(),
+		Code:          code,
+		Name:          name,
+		Assumptions:   assumptions,
+		InflationMode: inflationMode,
 	}
 
 	plan.sortAssumptions()
@@ -60,12 +70,13 @@ func NewPlan(
 
 func RestorePlan(props RestorePlanProps) *Plan {
 	plan := &Plan{
-		PlanID:      props.PlanID,
-		Code:        props.Code,
-		Name:        props.Name,
-		Assumptions: props.Assumptions,
-		CreatedAt:   props.CreatedAt,
-		UpdatedAt:   props.UpdatedAt,
+		PlanID:        props.PlanID,
+		Code:          props.Code,
+		Name:          props.Name,
+		Assumptions:   props.Assumptions,
+		InflationMode: props.InflationMode,
+		CreatedAt:     props.CreatedAt,
+		UpdatedAt:     props.UpdatedAt,
 	}
 	plan.sortAssumptions()
 	return plan
@@ -84,6 +95,10 @@ func (p *Plan) ChangeAssumptions(assumptions Assumptions) {
 	p.sortAssumptions()
 }
 
+func (p *Plan) ChangeInflationMode(inflationMode InflationMode) {
+	p.InflationMode = inflationMode
+}
+
 func (p *Plan) Validate() error {
 	err := common.Validate.Struct(p)
 	if err != nil {
@@ -105,22 +120,21 @@ func (p *Plan) validateAssumptions() error {
 			return common.NewDomainValidationError(ErrAssumptionConsecutiveYears)
 		}
 		previousYear = assumption.Year
-		var numEUR int
-		var numUSD int
+		currencies := make(map[Currency]bool, len(assumption.Currencies))
 		for _, currencyAssumption := range assumption.Currencies {
-			if currencyAssumption.Currency.IsEUR() {
-				numEUR++
+			if currencies[currencyAssumption.Currency] {
+				return common.NewDomainValidationError(ErrAssumptionCurrencyRepeated)
 			}
-			if currencyAssumption.Currency.IsUSD() {
-				numUSD++
+			currencies[currencyAssumption.Currency] = true
+
+			months := make(map[int]bool, len(currencyAssumption.Monthly))
+			for _, monthlyExchange := range currencyAssumption.Monthly {
+				if months[monthlyExchange.Month] {
+					return common.NewDomainValidationError(ErrAssumptionMonthRepeated)
+				}
+				months[monthlyExchange.Month] = true
 			}
 		}
-		if numEUR != 1 {
-			return common.NewDomainValidationError(ErrAssumptionCurrencyEUR)
-		}
-		if numUSD != 1 {
-			return common.NewDomainValidationError(ErrAssumptionCurrencyUSD)
-		}
 	}
 	return nil
 }
@@ -140,11 +154,32 @@ func (p *Plan) GetInflation() *inflation {
 			inflation: assumption.Inflation,
 		}
 	}
-	return NewInflation(factors)
+	return NewInflation(factors, p.InflationMode)
+}
+
+// HasYear checks that the plan has assumptions for the year
+func (p *Plan) HasYear(year int) bool {
+	return slices.ContainsFunc(p.Assumptions, func(a Assumption) bool {
+		return a.Year == year
+	})
+}
+
+// GetCurrencies returns the distinct currencies with exchange rates in the assumptions
+func (p *Plan) GetCurrencies() []Currency {
+	currencies := make([]Currency, 0)
+	for _, assumption := range p.Assumptions {
+		for _, currencyAssumption := range assumption.Currencies {
+			if !slices.Contains(currencies, currencyAssumption.Currency) {
+				currencies = append(currencies, currencyAssumption.Currency)
+			}
+		}
+	}
+	return currencies
 }
 
 func (p *Plan) GetExchange() *exchange {
 	rates := make([]rate, 0)
+	monthlyRates := make([]monthlyRate, 0)
 	for _, assumption := range p.Assumptions {
 		for i := range assumption.Currencies {
 			rate := rate{
@@ -153,8 +188,94 @@ func (p *Plan) GetExchange() *exchange {
 				rate:     assumption.Currencies[i].Exchange,
 			}
 			rates = append(rates, rate)
+
+			for _, monthlyExchange := range assumption.Currencies[i].Monthly {
+				monthlyRates = append(monthlyRates, monthlyRate{
+					year:     assumption.Year,
+					month:    time.Month(monthlyExchange.Month),
+					currency: assumption.Currencies[i].Currency,
+					rate:     monthlyExchange.Exchange,
+				})
+			}
 		}
 	}
 
-	return NewExchange(rates)
+	return NewExchange(rates, monthlyRates)
+}
+
+// Clone returns a new plan with a copy of the assumptions of the plan
+func (p *Plan) Clone(code string, name string) *Plan {
+	assumptions := make(Assumptions, len(p.Assumptions))
+	for i, assumption := range p.Assumptions {
+		assumptions[i] = assumption.copy(assumption.Year)
+	}
+	return NewPlan(code, name, assumptions, p.InflationMode)
+}
+
+// RollOver drops the first years of the assumptions and appends the same number of years,
+// which carry forward the inflation and the yearly exchange rates of the last year
+func (p *Plan) RollOver(years int) {
+	for range years {
+		if len(p.Assumptions) == 0 {
+			return
+		}
+		last := p.Assumptions[len(p.Assumptions)-1]
+		next := last.copy(last.Year + 1)
+		for i := range next.Currencies {
+			next.Currencies[i].Monthly = nil
+		}
+		p.Assumptions = append(p.Assumptions[1:], next)
+	}
+}
+
+// OverrideAssumptions replaces the assumptions of the years in the overrides
+// and adds the years that are not in the plan
+func (p *Plan) OverrideAssumptions(overrides Assumptions) {
+	for _, override := range overrides {
+		i := slices.IndexFunc(p.Assumptions, func(a Assumption) bool {
+			return a.Year == override.Year
+		})
+		if i < 0 {
+			p.Assumptions = append(p.Assumptions, override)
+			continue
+		}
+		p.Assumptions[i] = override
+	}
+	p.sortAssumptions()
+}
+
+func (a Assumption) copy(year int) Assumption {
+	currencies := make([]CurrencyAssumption, len(a.Currencies))
+	for i, c := range a.Currencies {
+		currencies[i] = CurrencyAssumption{
+			Currency: c.Currency,
+			Exchange: c.Exchange,
+			Monthly:  slices.Clone(c.Monthly),
+		}
+	}
+	return Assumption{Year: year, Inflation: a.Inflation, Currencies: currencies}
+}
+
+// AssumptionAdjustment changes the assumptions of every year of a plan, such as for a what-if simulation
+type AssumptionAdjustment struct {
+	InflationPoints float64              `json:"inflation_points" validate:"twodecimals"`
+	ExchangeFactors map[Currency]float64 `json:"exchange_factors" validate:"omitempty,dive,keys,len=3,alpha,uppercase,endkeys,gt=0"`
+}
+
+// Adjust adds the points to the inflation of every year and multiplies the exchange rates of the currencies by their factors
+func (p *Plan) Adjust(adjustment AssumptionAdjustment) {
+	for i := range p.Assumptions {
+		p.Assumptions[i].Inflation += adjustment.InflationPoints
+		for j := range p.Assumptions[i].Currencies {
+			currency := &p.Assumptions[i].Currencies[j]
+			factor, ok := adjustment.ExchangeFactors[currency.Currency]
+			if !ok {
+				continue
+			}
+			currency.Exchange *= factor
+			for k := range currency.Monthly {
+				currency.Monthly[k].Exchange *= factor
+			}
+		}
+	}
 }
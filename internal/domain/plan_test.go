@@ -0,0 +1,89 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/celsopires1999/estimation/internal/domain"
+	"github.com/celsopires1999/estimation/internal/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitPlan(t *testing.T) {
+	t.Run("should clone a plan with a copy of the assumptions", func(t *testing.T) {
+		plan := testutils.NewPlanFakeBuilder().Build()
+
+		clone := plan.Clone("FC 01", "Forecast 01")
+		clone.Assumptions[0].Currencies[0].Exchange = 9.99
+
+		assert.NoError(t, clone.Validate())
+		assert.NotEqual(t, plan.PlanID, clone.PlanID)
+		assert.Equal(t, "FC 01", clone.Code)
+		assert.Equal(t, plan.InflationMode, clone.InflationMode)
+		assert.Len(t, clone.Assumptions, len(plan.Assumptions))
+		assert.Equal(t, 4.15, plan.Assumptions[0].Currencies[0].Exchange)
+	})
+
+	t.Run("should roll the years over carrying forward the last year", func(t *testing.T) {
+		plan := testutils.NewPlanFakeBuilder().Build()
+		last := plan.Assumptions[len(plan.Assumptions)-1]
+
+		plan.RollOver(2)
+
+		assert.NoError(t, plan.Validate())
+		assert.Len(t, plan.Assumptions, 6)
+		assert.Equal(t, 2024, plan.Assumptions[0].Year)
+		assert.Equal(t, 2029, plan.Assumptions[5].Year)
+		assert.Equal(t, last.Inflation, plan.Assumptions[5].Inflation)
+		assert.Equal(t, last.Currencies, plan.Assumptions[5].Currencies)
+	})
+
+	t.Run("should override the assumptions of a year and add new years", func(t *testing.T) {
+		plan := testutils.NewPlanFakeBuilder().Build()
+		currencies := []domain.CurrencyAssumption{{Currency: domain.USD, Exchange: 6.00}}
+
+		plan.OverrideAssumptions(domain.Assumptions{
+			{Year: 2028, Inflation: 4.00, Currencies: currencies},
+			{Year: 2023, Inflation: 2.00, Currencies: currencies},
+		})
+
+		assert.NoError(t, plan.Validate())
+		assert.Len(t, plan.Assumptions, 7)
+		assert.Equal(t, 2.00, plan.Assumptions[1].Inflation)
+		assert.Equal(t, 2028, plan.Assumptions[6].Year)
+		assert.Equal(t, 4.00, plan.Assumptions[6].Inflation)
+	})
+
+	t.Run("should adjust the inflation and the exchange rates", func(t *testing.T) {
+		plan := testutils.NewPlanFakeBuilder().Build()
+		adjustment := domain.AssumptionAdjustment{
+			InflationPoints: 2.00,
+			ExchangeFactors: map[domain.Currency]float64{domain.EUR: 1.1},
+		}
+		assert.NoError(t, common.Validate.Struct(adjustment))
+
+		plan.Adjust(adjustment)
+
+		assert.InDelta(t, 5.10, plan.Assumptions[0].Inflation, 0.0001)
+		assert.Equal(t, 4.15, plan.Assumptions[0].Currencies[0].Exchange)
+		assert.InDelta(t, 5.896, plan.Assumptions[0].Currencies[1].Exchange, 0.0001)
+	})
+
+	t.Run("should not validate a plan adjusted to a negative inflation", func(t *testing.T) {
+		plan := testutils.NewPlanFakeBuilder().Build()
+
+		plan.Adjust(domain.AssumptionAdjustment{InflationPoints: -4.00})
+
+		var errDomainValidation *common.DomainValidationError
+		assert.True(t, errors.As(plan.Validate(), &errDomainValidation))
+	})
+
+	t.Run("should not validate an exchange factor that is not positive", func(t *testing.T) {
+		adjustment := domain.AssumptionAdjustment{
+			ExchangeFactors: map[domain.Currency]float64{domain.EUR: 0},
+		}
+
+		assert.Error(t, common.Validate.Struct(adjustment))
+	})
+}
@@ -0,0 +1,309 @@
+package domain
+
+import (
+	"cmp"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/celsopires1999/estimation/internal/common"
+)
+
+// PlanPortfolio is a portfolio of a plan with the baseline it was generated from
+type PlanPortfolio struct {
+	Portfolio *Portfolio
+	Baseline  *Baseline
+	Costs     []*Cost
+	Efforts   []*Effort
+	Budgets   []*Budget
+	Workloads []*Workload
+}
+
+// Variance is the change of an amount from a plan to another.
+// The part of the delta that is not explained by shift, inflation or exchange is other,
+// such as a different baseline review, rate cards or taxes.
+type Variance struct {
+	From      common.Money
+	To        common.Money
+	Shift     common.Money
+	Inflation common.Money
+	Exchange  common.Money
+}
+
+func (v Variance) Delta() common.Money {
+	return v.To.Sub(v.From)
+}
+
+func (v Variance) Other() common.Money {
+	return v.Delta().Sub(v.Shift).Sub(v.Inflation).Sub(v.Exchange)
+}
+
+func (v Variance) IsZero() bool {
+	return v.From.Equal(v.To) && v.Shift.Equal(common.ZeroMoney) && v.Inflation.Equal(common.ZeroMoney) && v.Exchange.Equal(common.ZeroMoney)
+}
+
+func (v Variance) add(o Variance) Variance {
+	return Variance{
+		From:      v.From.Add(o.From),
+		To:        v.To.Add(o.To),
+		Shift:     v.Shift.Add(o.Shift),
+		Inflation: v.Inflation.Add(o.Inflation),
+		Exchange:  v.Exchange.Add(o.Exchange),
+	}
+}
+
+// PeriodVariance is the variance of a month, or of a year when Month is zero
+type PeriodVariance struct {
+	Year      int
+	Month     time.Month
+	Budget    Variance
+	Workload  Variance
+	FromHours int
+	ToHours   int
+}
+
+func (p PeriodVariance) add(o PeriodVariance) PeriodVariance {
+	p.Budget = p.Budget.add(o.Budget)
+	p.Workload = p.Workload.add(o.Workload)
+	p.FromHours += o.FromHours
+	p.ToHours += o.ToHours
+	return p
+}
+
+type BaselineVariance struct {
+	Code            string
+	Status          DiffStatus
+	FromPortfolioID string
+	ToPortfolioID   string
+	Months          []PeriodVariance
+}
+
+func (b BaselineVariance) Years() []PeriodVariance {
+	return yearsOf(b.Months)
+}
+
+// PlanVariance has the variance of every baseline code present in either plan
+type PlanVariance struct {
+	Baselines []BaselineVariance
+}
+
+func (p PlanVariance) Months() []PeriodVariance {
+	months := make([]PeriodVariance, 0)
+	for _, b := range p.Baselines {
+		months = append(months, b.Months...)
+	}
+	return sumPeriods(months)
+}
+
+func (p PlanVariance) Years() []PeriodVariance {
+	return yearsOf(p.Months())
+}
+
+type PlanVarianceService struct {
+	from  *Plan
+	to    *Plan
+	rates *rateTable
+	taxes *taxTable
+}
+
+// NewPlanVarianceService compares the portfolios of a plan (to) with the ones of another plan (from).
+// The rates are the rate cards of the from plan.
+func NewPlanVarianceService(from *Plan, to *Plan, rates *rateTable, taxes *taxTable) *PlanVarianceService {
+	return &PlanVarianceService{from, to, rates, taxes}
+}
+
+// ComparePlans matches the portfolios by baseline code. The delta of a baseline in both plans is attributed
+// by generating the from portfolio again, one step at a time: with the start month of the to portfolio (shift),
+// then with the inflation of the to plan (inflation), then with its exchange rates (exchange).
+func (s *PlanVarianceService) ComparePlans(fromPortfolios []*PlanPortfolio, toPortfolios []*PlanPortfolio) (*PlanVariance, error) {
+	portfolios := make(map[string][2]*PlanPortfolio)
+	for _, p := range fromPortfolios {
+		pair := portfolios[p.Baseline.Code]
+		pair[0] = p
+		portfolios[p.Baseline.Code] = pair
+	}
+	for _, p := range toPortfolios {
+		pair := portfolios[p.Baseline.Code]
+		pair[1] = p
+		portfolios[p.Baseline.Code] = pair
+	}
+
+	variance := &PlanVariance{Baselines: make([]BaselineVariance, 0, len(portfolios))}
+	for code, pair := range portfolios {
+		baselineVariance, err := s.compareBaseline(code, pair[0], pair[1])
+		if err != nil {
+			return nil, err
+		}
+		variance.Baselines = append(variance.Baselines, baselineVariance)
+	}
+	slices.SortFunc(variance.Baselines, func(a, b BaselineVariance) int {
+		return strings.Compare(a.Code, b.Code)
+	})
+
+	return variance, nil
+}
+
+func (s *PlanVarianceService) compareBaseline(code string, from, to *PlanPortfolio) (BaselineVariance, error) {
+	v := BaselineVariance{Code: code}
+	months := make([]PeriodVariance, 0)
+
+	if from != nil {
+		v.FromPortfolioID = from.Portfolio.PortfolioID
+		months = append(months, periodsOf(from.Budgets, from.Workloads)...)
+	}
+	if to != nil {
+		v.ToPortfolioID = to.Portfolio.PortfolioID
+		for _, p := range periodsOf(to.Budgets, to.Workloads) {
+			months = append(months, PeriodVariance{
+				Year:     p.Year,
+				Month:    p.Month,
+				Budget:   Variance{To: p.Budget.From},
+				Workload: Variance{To: p.Workload.From},
+				ToHours:  p.FromHours,
+			})
+		}
+	}
+
+	if from != nil && to != nil {
+		attributed, err := s.attribute(from, to)
+		if err != nil {
+			return v, err
+		}
+		months = append(months, attributed...)
+	}
+
+	v.Months = sumPeriods(months)
+
+	switch {
+	case from == nil:
+		v.Status = DiffAdded
+	case to == nil:
+		v.Status = DiffRemoved
+	case slices.ContainsFunc(v.Months, func(p PeriodVariance) bool {
+		return !p.Budget.IsZero() || !p.Workload.IsZero() || p.FromHours != p.ToHours
+	}):
+		v.Status = DiffChanged
+	default:
+		v.Status = DiffUnchanged
+	}
+
+	return v, nil
+}
+
+// attribute returns the shift, inflation and exchange parts of the variance by month.
+// When a step cannot be generated, such as a shift past the years of the from plan,
+// the parts from that step on are left in other.
+func (s *PlanVarianceService) attribute(from, to *PlanPortfolio) ([]PeriodVariance, error) {
+	shiftMonths := ShiftMonthsTo(from.Baseline, to.Portfolio.StartDate.Year(), to.Portfolio.StartDate.Month())
+	steps := []struct {
+		inflation   *inflation
+		exchange    *exchange
+		shiftMonths int
+	}{
+		{s.from.GetInflation(), s.from.GetExchange(), from.Portfolio.ShiftMonths(from.Baseline)},
+		{s.from.GetInflation(), s.from.GetExchange(), shiftMonths},
+		{s.to.GetInflation(), s.from.GetExchange(), shiftMonths},
+		{s.to.GetInflation(), s.to.GetExchange(), shiftMonths},
+	}
+
+	generated := make([][]PeriodVariance, 0, len(steps))
+	for _, step := range steps {
+		service := NewPortfolioService(s.from.PlanID, from.Baseline, from.Costs, from.Efforts, step.inflation, step.exchange, s.rates, s.taxes, step.shiftMonths)
+		_, budgets, workloads, err := service.GeneratePortfolio()
+		if err != nil {
+			var errDomainValidation *common.DomainValidationError
+			if errors.As(err, &errDomainValidation) {
+				break
+			}
+			return nil, err
+		}
+		generated = append(generated, periodsOf(budgets, workloads))
+	}
+
+	// each part is the amount of a step less the amount of the step before it
+	parts := []func(*Variance) *common.Money{
+		func(v *Variance) *common.Money { return &v.Shift },
+		func(v *Variance) *common.Money { return &v.Inflation },
+		func(v *Variance) *common.Money { return &v.Exchange },
+	}
+	months := make([]PeriodVariance, 0)
+	for i, part := range parts {
+		if i+1 >= len(generated) {
+			break
+		}
+		for _, p := range generated[i] {
+			m := PeriodVariance{Year: p.Year, Month: p.Month}
+			*part(&m.Budget) = common.ZeroMoney.Sub(p.Budget.From)
+			*part(&m.Workload) = common.ZeroMoney.Sub(p.Workload.From)
+			months = append(months, m)
+		}
+		for _, p := range generated[i+1] {
+			m := PeriodVariance{Year: p.Year, Month: p.Month}
+			*part(&m.Budget) = p.Budget.From
+			*part(&m.Workload) = p.Workload.From
+			months = append(months, m)
+		}
+	}
+
+	return months, nil
+}
+
+// periodsOf returns one period for each budget and workload allocation, with the amounts and hours as from
+func periodsOf(budgets []*Budget, workloads []*Workload) []PeriodVariance {
+	periods := make([]PeriodVariance, 0)
+	for _, b := range budgets {
+		for _, a := range b.BudgetAllocations {
+			periods = append(periods, PeriodVariance{
+				Year:   a.AllocationDate.Year(),
+				Month:  a.AllocationDate.Month(),
+				Budget: Variance{From: a.Amount},
+			})
+		}
+	}
+	for _, w := range workloads {
+		for _, a := range w.WorkloadAllocations {
+			periods = append(periods, PeriodVariance{
+				Year:      a.AllocationDate.Year(),
+				Month:     a.AllocationDate.Month(),
+				Workload:  Variance{From: a.Amount},
+				FromHours: a.Hours,
+			})
+		}
+	}
+	return periods
+}
+
+func yearsOf(months []PeriodVariance) []PeriodVariance {
+	years := make([]PeriodVariance, len(months))
+	for i, m := range months {
+		m.Month = 0
+		years[i] = m
+	}
+	return sumPeriods(years)
+}
+
+// sumPeriods adds up the periods of the same year and month, sorted by year and month
+func sumPeriods(periods []PeriodVariance) []PeriodVariance {
+	type period struct {
+		year  int
+		month time.Month
+	}
+	sums := make(map[period]PeriodVariance)
+	for _, p := range periods {
+		key := period{p.Year, p.Month}
+		if sum, ok := sums[key]; ok {
+			p = sum.add(p)
+		}
+		sums[key] = p
+	}
+
+	result := make([]PeriodVariance, 0, len(sums))
+	for _, p := range sums {
+		result = append(result, p)
+	}
+	slices.SortFunc(result, func(a, b PeriodVariance) int {
+		return cmp.Or(cmp.Compare(a.Year, b.Year), cmp.Compare(a.Month, b.Month))
+	})
+	return result
+}
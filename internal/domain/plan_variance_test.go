@@ -0,0 +1,151 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/celsopires1999/estimation/internal/domain"
+	"github.com/celsopires1999/estimation/internal/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitPlanVariance(t *testing.T) {
+	from := testutils.NewPlanFakeBuilder().Build()
+	to := testutils.NewPlanFakeBuilder().Build()
+	for i, a := range to.Assumptions {
+		if a.Year == 2026 {
+			to.Assumptions[i].Inflation = 6.00
+			to.Assumptions[i].Currencies[0].Exchange = 6.00
+		}
+	}
+
+	newPlanPortfolio := func(plan *domain.Plan, code string, shiftMonths int) *domain.PlanPortfolio {
+		baseline := testutils.NewBaselineFakeBuilder().
+			WithCode(code).
+			WithStartDate(time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)).
+			WithDuration(12).
+			Build()
+		costs := []*domain.Cost{
+			testutils.NewCostFakeBuilder().
+				WithBaselineID(baseline.BaselineID).
+				WithCurrency(domain.BRL).
+				WithTax(0).
+				WithApplyInflation(true).
+				WithAmount(common.NewMoney(1000.00)).
+				WithCostAllocationProps([]domain.CostAllocationProps{
+					{Year: 2025, Month: time.June, Amount: common.NewMoney(1000.00)},
+				}).
+				Build(),
+			testutils.NewCostFakeBuilder().
+				WithBaselineID(baseline.BaselineID).
+				WithCurrency(domain.USD).
+				WithTax(0).
+				WithAmount(common.NewMoney(100.00)).
+				WithCostAllocationProps([]domain.CostAllocationProps{
+					{Year: 2025, Month: time.March, Amount: common.NewMoney(100.00)},
+				}).
+				Build(),
+		}
+
+		service := domain.NewPortfolioService(plan.PlanID, baseline, costs, nil, plan.GetInflation(), plan.GetExchange(), domain.NewRateTable(nil), domain.NewTaxTable(nil), shiftMonths)
+		portfolio, budgets, workloads, err := service.GeneratePortfolio()
+		assert.NoError(t, err)
+
+		return &domain.PlanPortfolio{
+			Portfolio: portfolio,
+			Baseline:  baseline,
+			Costs:     costs,
+			Budgets:   budgets,
+			Workloads: workloads,
+		}
+	}
+
+	t.Run("should attribute the delta to shift, inflation and exchange", func(t *testing.T) {
+		fromPortfolio := newPlanPortfolio(from, "BL01", 0)
+		toPortfolio := &domain.PlanPortfolio{Baseline: fromPortfolio.Baseline, Costs: fromPortfolio.Costs}
+		service := domain.NewPortfolioService(to.PlanID, toPortfolio.Baseline, toPortfolio.Costs, nil, to.GetInflation(), to.GetExchange(), domain.NewRateTable(nil), domain.NewTaxTable(nil), 12)
+		var err error
+		toPortfolio.Portfolio, toPortfolio.Budgets, toPortfolio.Workloads, err = service.GeneratePortfolio()
+		assert.NoError(t, err)
+
+		variance, err := domain.NewPlanVarianceService(from, to, domain.NewRateTable(nil), domain.NewTaxTable(nil)).
+			ComparePlans([]*domain.PlanPortfolio{fromPortfolio}, []*domain.PlanPortfolio{toPortfolio})
+
+		assert.NoError(t, err)
+		assert.Len(t, variance.Baselines, 1)
+		assert.Equal(t, domain.DiffChanged, variance.Baselines[0].Status)
+
+		years := variance.Years()
+		assert.Len(t, years, 2)
+		assert.Equal(t, "1500.00", years[0].Budget.From.String())
+		assert.Equal(t, "-1500.00", years[0].Budget.Shift.String())
+		assert.Equal(t, "1660.00", years[1].Budget.To.String())
+		assert.Equal(t, "1607.60", years[1].Budget.Shift.String())
+		assert.Equal(t, "7.40", years[1].Budget.Inflation.String())
+		assert.Equal(t, "45.00", years[1].Budget.Exchange.String())
+
+		assert.Equal(t, "0.00", years[0].Budget.Other().String())
+		assert.Equal(t, "0.00", years[1].Budget.Other().String())
+
+		months := variance.Months()
+		assert.Len(t, months, 4)
+		assert.Equal(t, 2026, months[3].Year)
+		assert.Equal(t, time.June, months[3].Month)
+		assert.Equal(t, "1060.00", months[3].Budget.To.String())
+		assert.Equal(t, "1052.60", months[3].Budget.Shift.String())
+	})
+
+	t.Run("should leave the delta in other when the shift is past the years of the from plan", func(t *testing.T) {
+		longer := testutils.NewPlanFakeBuilder().Build()
+		longer.Assumptions = append(longer.Assumptions, domain.Assumption{
+			Year:      2028,
+			Inflation: 5.40,
+			Currencies: []domain.CurrencyAssumption{
+				{Currency: domain.USD, Exchange: 5.90},
+				{Currency: domain.EUR, Exchange: 7.00},
+			},
+		})
+		fromPortfolio := newPlanPortfolio(from, "BL01", 0)
+		toPortfolio := &domain.PlanPortfolio{Baseline: fromPortfolio.Baseline, Costs: fromPortfolio.Costs}
+		service := domain.NewPortfolioService(longer.PlanID, toPortfolio.Baseline, toPortfolio.Costs, nil, longer.GetInflation(), longer.GetExchange(), domain.NewRateTable(nil), domain.NewTaxTable(nil), 36)
+		var err error
+		toPortfolio.Portfolio, toPortfolio.Budgets, toPortfolio.Workloads, err = service.GeneratePortfolio()
+		assert.NoError(t, err)
+
+		variance, err := domain.NewPlanVarianceService(from, longer, domain.NewRateTable(nil), domain.NewTaxTable(nil)).
+			ComparePlans([]*domain.PlanPortfolio{fromPortfolio}, []*domain.PlanPortfolio{toPortfolio})
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.DiffChanged, variance.Baselines[0].Status)
+
+		years := variance.Years()
+		assert.Len(t, years, 2)
+		assert.Equal(t, 2025, years[0].Year)
+		assert.Equal(t, 2028, years[1].Year)
+		for _, year := range years {
+			assert.True(t, year.Budget.Shift.Equal(common.ZeroMoney))
+			assert.True(t, year.Budget.Inflation.Equal(common.ZeroMoney))
+			assert.True(t, year.Budget.Exchange.Equal(common.ZeroMoney))
+			assert.Equal(t, year.Budget.Delta().String(), year.Budget.Other().String())
+		}
+		assert.Equal(t, "-1500.00", years[0].Budget.Other().String())
+	})
+
+	t.Run("should report every baseline code of both plans", func(t *testing.T) {
+		unchanged := newPlanPortfolio(from, "BL01", 0)
+		removed := newPlanPortfolio(from, "BL02", 0)
+		added := newPlanPortfolio(from, "BL03", 0)
+
+		variance, err := domain.NewPlanVarianceService(from, from, domain.NewRateTable(nil), domain.NewTaxTable(nil)).
+			ComparePlans([]*domain.PlanPortfolio{unchanged, removed}, []*domain.PlanPortfolio{unchanged, added})
+
+		assert.NoError(t, err)
+		assert.Len(t, variance.Baselines, 3)
+		assert.Equal(t, domain.DiffUnchanged, variance.Baselines[0].Status)
+		assert.Equal(t, domain.DiffRemoved, variance.Baselines[1].Status)
+		assert.Equal(t, removed.Portfolio.PortfolioID, variance.Baselines[1].FromPortfolioID)
+		assert.Equal(t, domain.DiffAdded, variance.Baselines[2].Status)
+		assert.Equal(t, "1500.00", variance.Baselines[2].Years()[0].Budget.Other().String())
+	})
+}
@@ -1,7 +1,11 @@
 package domain
 
 import (
+	"errors"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/celsopires1999/estimation/internal/common"
@@ -20,6 +24,14 @@ type Portfolio struct {
 
 type RestorePortfolioProps Portfolio
 
+// MaxShiftMonths is how many months a portfolio can start after its baseline
+const MaxShiftMonths = 36
+
+var (
+	ErrPortfolioShift         = errors.New("portfolio must start from 0 to 36 months after the baseline")
+	ErrPortfolioYearNotInPlan = errors.New("portfolio years are not in the plan assumptions")
+)
+
 func NewPortfolio(baselineID string, planID string, startDate time.Time) *Portfolio {
 	return &Portfolio{
 		PortfolioID: uuid.New().String(),
@@ -48,3 +60,51 @@ func (p *Portfolio) Validate() error {
 	}
 	return nil
 }
+
+// ShiftMonths returns how many months the portfolio starts after the baseline
+func (p *Portfolio) ShiftMonths(baseline *Baseline) int {
+	return ShiftMonthsTo(baseline, p.StartDate.Year(), p.StartDate.Month())
+}
+
+// ShiftMonthsTo returns the shift for a portfolio of the baseline to start in the month
+func ShiftMonthsTo(baseline *Baseline, year int, month time.Month) int {
+	start := baseline.StartMonth()
+	return (year-start.Year())*12 + int(month-start.Month())
+}
+
+func ValidateShiftMonths(shiftMonths int) error {
+	if shiftMonths < 0 || shiftMonths > MaxShiftMonths {
+		return common.NewDomainValidationError(fmt.Errorf("%w: %d", ErrPortfolioShift, shiftMonths))
+	}
+	return nil
+}
+
+// ValidateYears checks that the plan has assumptions for every year the budgets and workloads are allocated
+func (p *Portfolio) ValidateYears(plan *Plan, budgets []*Budget, workloads []*Workload) error {
+	years := make([]int, 0)
+	addYear := func(year int) {
+		if !plan.HasYear(year) && !slices.Contains(years, year) {
+			years = append(years, year)
+		}
+	}
+	for _, b := range budgets {
+		for _, a := range b.BudgetAllocations {
+			addYear(a.AllocationDate.Year())
+		}
+	}
+	for _, w := range workloads {
+		for _, a := range w.WorkloadAllocations {
+			addYear(a.AllocationDate.Year())
+		}
+	}
+
+	if len(years) > 0 {
+		slices.Sort(years)
+		yearsStr := make([]string, len(years))
+		for i, year := range years {
+			yearsStr[i] = strconv.Itoa(year)
+		}
+		return common.NewDomainValidationError(fmt.Errorf("%w: %s", ErrPortfolioYearNotInPlan, strings.Join(yearsStr, ", ")))
+	}
+	return nil
+}
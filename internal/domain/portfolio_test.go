@@ -0,0 +1,109 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/celsopires1999/estimation/internal/domain"
+	"github.com/celsopires1999/estimation/internal/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitPortfolio(t *testing.T) {
+	plan := testutils.NewPlanFakeBuilder().Build()
+	baseline := testutils.NewBaselineFakeBuilder().
+		WithStartDate(time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)).
+		WithDuration(24).
+		Build()
+
+	newCost := func(amount float64) *domain.Cost {
+		return testutils.NewCostFakeBuilder().
+			WithBaselineID(baseline.BaselineID).
+			WithCurrency(domain.BRL).
+			WithTax(0).
+			WithApplyInflation(false).
+			WithAmount(common.NewMoney(amount)).
+			WithCostAllocationProps([]domain.CostAllocationProps{
+				{Year: 2025, Month: time.March, Amount: common.NewMoney(amount)},
+			}).
+			Build()
+	}
+
+	t.Run("should return the months the portfolio is shifted", func(t *testing.T) {
+		service := domain.NewPortfolioService(plan.PlanID, baseline, nil, nil, plan.GetInflation(), plan.GetExchange(), domain.NewRateTable(nil), domain.NewTaxTable(nil), 14)
+		portfolio, _, _, err := service.GeneratePortfolio()
+
+		assert.NoError(t, err)
+		assert.Equal(t, 14, portfolio.ShiftMonths(baseline))
+	})
+
+	t.Run("should keep the portfolio and budget IDs on recalculation", func(t *testing.T) {
+		kept := newCost(100.00)
+		removed := newCost(200.00)
+
+		service := domain.NewPortfolioService(plan.PlanID, baseline, []*domain.Cost{kept, removed}, nil, plan.GetInflation(), plan.GetExchange(), domain.NewRateTable(nil), domain.NewTaxTable(nil), 2)
+		portfolio, budgets, workloads, err := service.GeneratePortfolio()
+		assert.NoError(t, err)
+		portfolioID := portfolio.PortfolioID
+		keptBudgetID := budgets[0].BudgetID
+
+		amount := common.NewMoney(150.00)
+		kept.ChangeAmount(&amount)
+		kept.ChangeCostAllocations([]domain.CostAllocationProps{
+			{Year: 2025, Month: time.March, Amount: common.NewMoney(150.00)},
+		})
+		added := newCost(300.00)
+
+		service = domain.NewPortfolioService(plan.PlanID, baseline, []*domain.Cost{kept, added}, nil, plan.GetInflation(), plan.GetExchange(), domain.NewRateTable(nil), domain.NewTaxTable(nil), portfolio.ShiftMonths(baseline))
+		recalculation, err := service.RecalculatePortfolio(portfolio, budgets, workloads)
+
+		assert.NoError(t, err)
+		assert.Equal(t, portfolioID, recalculation.Portfolio.PortfolioID)
+		assert.Len(t, recalculation.UpdatedBudgets, 1)
+		assert.Equal(t, keptBudgetID, recalculation.UpdatedBudgets[0].BudgetID)
+		assert.Equal(t, "150.00", recalculation.UpdatedBudgets[0].Amount.String())
+		assert.Equal(t, time.May, recalculation.UpdatedBudgets[0].BudgetAllocations[0].AllocationDate.Month())
+		assert.Len(t, recalculation.CreatedBudgets, 1)
+		assert.Equal(t, added.CostID, recalculation.CreatedBudgets[0].CostID)
+		assert.Equal(t, portfolioID, recalculation.CreatedBudgets[0].PortfolioID)
+		assert.Len(t, recalculation.DeletedBudgets, 1)
+		assert.Equal(t, removed.CostID, recalculation.DeletedBudgets[0].CostID)
+	})
+
+	t.Run("should shift the portfolio to a start month", func(t *testing.T) {
+		shiftMonths := domain.ShiftMonthsTo(baseline, 2026, time.April)
+
+		assert.Equal(t, 15, shiftMonths)
+		assert.NoError(t, domain.ValidateShiftMonths(shiftMonths))
+
+		err := domain.ValidateShiftMonths(domain.ShiftMonthsTo(baseline, 2024, time.December))
+		var errDomainValidation *common.DomainValidationError
+		assert.True(t, errors.As(err, &errDomainValidation))
+		assert.EqualError(t, err, domain.ErrPortfolioShift.Error()+": -1")
+	})
+
+	t.Run("should fail when the shift moves allocations out of the plan years", func(t *testing.T) {
+		cost := testutils.NewCostFakeBuilder().
+			WithBaselineID(baseline.BaselineID).
+			WithCurrency(domain.BRL).
+			WithTax(0).
+			WithApplyInflation(false).
+			WithAmount(common.NewMoney(100.00)).
+			WithCostAllocationProps([]domain.CostAllocationProps{
+				{Year: 2026, Month: time.June, Amount: common.NewMoney(100.00)},
+			}).
+			Build()
+
+		service := domain.NewPortfolioService(plan.PlanID, baseline, []*domain.Cost{cost}, nil, plan.GetInflation(), plan.GetExchange(), domain.NewRateTable(nil), domain.NewTaxTable(nil), 24)
+		portfolio, budgets, workloads, err := service.GeneratePortfolio()
+		assert.NoError(t, err)
+
+		err = portfolio.ValidateYears(plan, budgets, workloads)
+
+		var errDomainValidation *common.DomainValidationError
+		assert.True(t, errors.As(err, &errDomainValidation))
+		assert.EqualError(t, err, domain.ErrPortfolioYearNotInPlan.Error()+": 2028")
+	})
+}
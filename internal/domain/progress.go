@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/google/uuid"
+)
+
+// Progress is the share of the work on a cost or an effort of a portfolio that is complete at the end of a month, in percent
+type Progress struct {
+	ProgressID      string    `validate:"required,uuid4"`
+	PortfolioID     string    `validate:"required,uuid4"`
+	CostID          string    `validate:"omitempty,uuid4"`
+	EffortID        string    `validate:"omitempty,uuid4"`
+	ProgressDate    time.Time `validate:"required"`
+	PercentComplete float64   `validate:"gte=0,lte=100"`
+	CreatedAt       time.Time `validate:"-"`
+	UpdatedAt       time.Time `validate:"-"`
+}
+
+type RestoreProgressProps Progress
+
+type NewProgressProps struct {
+	PortfolioID     string
+	CostID          string
+	EffortID        string
+	Year            int
+	Month           time.Month
+	PercentComplete float64
+}
+
+var (
+	ErrProgressCostOrEffort         = errors.New("progress is recorded for either a cost or an effort")
+	ErrProgressCostNotInPortfolio   = errors.New("the cost has no budget in the portfolio")
+	ErrProgressEffortNotInPortfolio = errors.New("the effort has no workload in the portfolio")
+)
+
+func NewProgress(props NewProgressProps) *Progress {
+	return &Progress{
+		ProgressID:      uuid.NewString(),
+		PortfolioID:     props.PortfolioID,
+		CostID:          props.CostID,
+		EffortID:        props.EffortID,
+		ProgressDate:    time.Date(props.Year, props.Month, 1, 0, 0, 0, 0, time.UTC),
+		PercentComplete: props.PercentComplete,
+	}
+}
+
+func RestoreProgress(props RestoreProgressProps) *Progress {
+	return &Progress{
+		ProgressID:      props.ProgressID,
+		PortfolioID:     props.PortfolioID,
+		CostID:          props.CostID,
+		EffortID:        props.EffortID,
+		ProgressDate:    props.ProgressDate,
+		PercentComplete: props.PercentComplete,
+		CreatedAt:       props.CreatedAt,
+		UpdatedAt:       props.UpdatedAt,
+	}
+}
+
+func (p *Progress) ChangePercentComplete(percentComplete float64) {
+	p.PercentComplete = percentComplete
+}
+
+// IsFor tells whether the progress is the one of the cost or the effort in the month
+func (p *Progress) IsFor(costID, effortID string, year int, month time.Month) bool {
+	return p.CostID == costID && p.EffortID == effortID && p.ProgressDate.Year() == year && p.ProgressDate.Month() == month
+}
+
+func (p *Progress) Validate() error {
+	err := common.Validate.Struct(p)
+	if err != nil {
+		return common.NewDomainValidationError(fmt.Errorf("progress domain validation failed: %w", err))
+	}
+	if (p.CostID == "") == (p.EffortID == "") {
+		return common.NewDomainValidationError(fmt.Errorf("%w: cost %q, effort %q", ErrProgressCostOrEffort, p.CostID, p.EffortID))
+	}
+	return nil
+}
+
+// ValidatePortfolio checks that the cost of the progress has a budget in the portfolio,
+// or that its effort has a workload
+func (p *Progress) ValidatePortfolio(budgets []*Budget, workloads []*Workload) error {
+	if p.EffortID != "" {
+		if slices.ContainsFunc(workloads, func(w *Workload) bool { return w.EffortID == p.EffortID }) {
+			return nil
+		}
+		return common.NewDomainValidationError(fmt.Errorf("%w: %s", ErrProgressEffortNotInPortfolio, p.EffortID))
+	}
+	if slices.ContainsFunc(budgets, func(b *Budget) bool { return b.CostID == p.CostID }) {
+		return nil
+	}
+	return common.NewDomainValidationError(fmt.Errorf("%w: %s", ErrProgressCostNotInPortfolio, p.CostID))
+}
@@ -0,0 +1,176 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// RateCard is the hourly rate of a competence in a year of a plan
+type RateCard struct {
+	RateCardID   string       `validate:"required,uuid4"`
+	PlanID       string       `validate:"required,uuid4"`
+	CompetenceID string       `validate:"required,uuid4"`
+	Year         int          `validate:"required"`
+	HourlyRate   common.Money `validate:"required,gt=0,twodecimals"`
+	Currency     Currency     `validate:"required,len=3,alpha,uppercase"`
+	CreatedAt    time.Time    `validate:"-"`
+	UpdatedAt    time.Time    `validate:"-"`
+}
+
+type RestoreRateCardProps RateCard
+
+type NewRateCardProps struct {
+	PlanID       string
+	CompetenceID string
+	Year         int
+	HourlyRate   common.Money
+	Currency     Currency
+}
+
+var ErrRateCardYearNotInPlan = errors.New("rate card year is not in the plan assumptions")
+
+func NewRateCard(props NewRateCardProps) *RateCard {
+	return &RateCard{
+		RateCardID:   uuid.NewString(),
+		PlanID:       props.PlanID,
+		CompetenceID: props.CompetenceID,
+		Year:         props.Year,
+		HourlyRate:   props.HourlyRate,
+		Currency:     props.Currency,
+	}
+}
+
+func RestoreRateCard(props RestoreRateCardProps) *RateCard {
+	return &RateCard{
+		RateCardID:   props.RateCardID,
+		PlanID:       props.PlanID,
+		CompetenceID: props.CompetenceID,
+		Year:         props.Year,
+		HourlyRate:   props.HourlyRate,
+		Currency:     props.Currency,
+		CreatedAt:    props.CreatedAt,
+		UpdatedAt:    props.UpdatedAt,
+	}
+}
+
+func (r *RateCard) ChangeYear(year *int) {
+	if year == nil {
+		return
+	}
+	r.Year = *year
+}
+
+func (r *RateCard) ChangeHourlyRate(hourlyRate *common.Money) {
+	if hourlyRate == nil {
+		return
+	}
+	r.HourlyRate = *hourlyRate
+}
+
+func (r *RateCard) ChangeCurrency(currencyStr *string) {
+	if currencyStr == nil {
+		return
+	}
+	r.Currency = Currency(*currencyStr)
+}
+
+func (r *RateCard) Validate() error {
+	err := common.Validate.Struct(r)
+	if err != nil {
+		return common.NewDomainValidationError(fmt.Errorf("rate card domain validation failed: %w", err))
+	}
+	return nil
+}
+
+// ValidateYear checks that the plan has assumptions for the rate card year
+func (r *RateCard) ValidateYear(plan *Plan) error {
+	for _, assumption := range plan.Assumptions {
+		if assumption.Year == r.Year {
+			return nil
+		}
+	}
+	return common.NewDomainValidationError(fmt.Errorf("%w: %d", ErrRateCardYearNotInPlan, r.Year))
+}
+
+// Price returns the exact cost of the hours at the rate card rate
+func (r *RateCard) Price(hours int) common.Money {
+	return r.HourlyRate.Mul(decimal.NewFromInt(int64(hours)))
+}
+
+type competenceYear struct {
+	competenceID string
+	year         int
+}
+
+type rateTable struct {
+	rates map[competenceYear]*RateCard
+}
+
+func NewRateTable(rateCards []*RateCard) *rateTable {
+	rates := make(map[competenceYear]*RateCard, len(rateCards))
+	for _, r := range rateCards {
+		rates[competenceYear{r.CompetenceID, r.Year}] = r
+	}
+	return &rateTable{rates}
+}
+
+func (t *rateTable) IsEmpty() bool {
+	return len(t.rates) == 0
+}
+
+// GetRate returns the rate card of the competence for the year.
+// When the year has no rate card, the latest earlier one is returned.
+func (t *rateTable) GetRate(competenceID string, year int) (*RateCard, bool) {
+	var found *RateCard
+	for key, r := range t.rates {
+		if key.competenceID != competenceID || key.year > year {
+			continue
+		}
+		if found == nil || key.year > found.Year {
+			found = r
+		}
+	}
+	return found, found != nil
+}
+
+// CopyRateCards returns a copy of the rate cards for the years of another plan.
+// A competence without a rate card in the first year of the plan gets its latest earlier one in that year.
+func CopyRateCards(rateCards []*RateCard, plan *Plan) []*RateCard {
+	copies := make([]*RateCard, 0, len(rateCards))
+	if len(plan.Assumptions) == 0 {
+		return copies
+	}
+	firstYear := plan.Assumptions[0].Year
+	table := NewRateTable(rateCards)
+
+	carried := make(map[string]bool)
+	for _, r := range rateCards {
+		switch {
+		case plan.HasYear(r.Year):
+			copies = append(copies, r.copyTo(plan.PlanID, r.Year))
+		case r.Year < firstYear && !carried[r.CompetenceID]:
+			carried[r.CompetenceID] = true
+			if _, ok := table.rates[competenceYear{r.CompetenceID, firstYear}]; !ok {
+				latest, _ := table.GetRate(r.CompetenceID, firstYear)
+				copies = append(copies, latest.copyTo(plan.PlanID, firstYear))
+			}
+		}
+	}
+
+	return copies
+}
+
+func (r *RateCard) copyTo(planID string, year int) *RateCard {
+	return NewRateCard(NewRateCardProps{
+		PlanID:       planID,
+		CompetenceID: r.CompetenceID,
+		Year:         year,
+		HourlyRate:   r.HourlyRate,
+		Currency:     r.Currency,
+	})
+}
@@ -0,0 +1,183 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/celsopires1999/estimation/internal/domain"
+	"github.com/celsopires1999/estimation/internal/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitRateCard(t *testing.T) {
+	plan := testutils.NewPlanFakeBuilder().Build()
+	baseline := testutils.NewBaselineFakeBuilder().
+		WithStartDate(time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)).
+		WithDuration(24).
+		Build()
+	effort := testutils.NewEffortFakeBuilder().
+		WithBaselineID(baseline.BaselineID).
+		WithHours(20).
+		WithEffortAllocationsProps([]domain.EffortAllocationProps{
+			{Year: 2025, Month: time.January, Hours: 10},
+			{Year: 2026, Month: time.January, Hours: 10},
+		}).
+		Build()
+
+	t.Run("should price workloads with the plan inflation", func(t *testing.T) {
+		rateCard := domain.NewRateCard(domain.NewRateCardProps{
+			PlanID:       plan.PlanID,
+			CompetenceID: effort.CompetenceID,
+			Year:         2025,
+			HourlyRate:   common.NewMoney(100.00),
+			Currency:     domain.BRL,
+		})
+		assert.NoError(t, rateCard.Validate())
+
+		rates := domain.NewRateTable([]*domain.RateCard{rateCard})
+		service := domain.NewPortfolioService(plan.PlanID, baseline, nil, []*domain.Effort{effort}, plan.GetInflation(), plan.GetExchange(), rates, domain.NewTaxTable(nil), 0)
+		_, _, workloads, err := service.GeneratePortfolio()
+
+		assert.NoError(t, err)
+		assert.Len(t, workloads, 1)
+		assert.Equal(t, "2052.60", workloads[0].Amount.String())
+		assert.Equal(t, "1000.00", workloads[0].WorkloadAllocations[0].Amount.String())
+		assert.Equal(t, "1052.60", workloads[0].WorkloadAllocations[1].Amount.String())
+	})
+
+	t.Run("should convert rates in a foreign currency", func(t *testing.T) {
+		rates := domain.NewRateTable([]*domain.RateCard{
+			domain.NewRateCard(domain.NewRateCardProps{
+				PlanID:       plan.PlanID,
+				CompetenceID: effort.CompetenceID,
+				Year:         2025,
+				HourlyRate:   common.NewMoney(20.00),
+				Currency:     domain.USD,
+			}),
+			domain.NewRateCard(domain.NewRateCardProps{
+				PlanID:       plan.PlanID,
+				CompetenceID: effort.CompetenceID,
+				Year:         2026,
+				HourlyRate:   common.NewMoney(25.00),
+				Currency:     domain.USD,
+			}),
+		})
+		service := domain.NewPortfolioService(plan.PlanID, baseline, nil, []*domain.Effort{effort}, plan.GetInflation(), plan.GetExchange(), rates, domain.NewTaxTable(nil), 0)
+		_, _, workloads, err := service.GeneratePortfolio()
+
+		assert.NoError(t, err)
+		assert.Equal(t, "1000.00", workloads[0].WorkloadAllocations[0].Amount.String())
+		assert.Equal(t, "1387.50", workloads[0].WorkloadAllocations[1].Amount.String())
+		assert.Equal(t, "2387.50", workloads[0].Amount.String())
+	})
+
+	t.Run("should leave the hours unpriced when a competence has no rate", func(t *testing.T) {
+		other := testutils.NewEffortFakeBuilder().
+			WithBaselineID(baseline.BaselineID).
+			WithHours(10).
+			WithEffortAllocationsProps([]domain.EffortAllocationProps{
+				{Year: 2025, Month: time.January, Hours: 10},
+			}).
+			Build()
+		rates := domain.NewRateTable([]*domain.RateCard{
+			domain.NewRateCard(domain.NewRateCardProps{
+				PlanID:       plan.PlanID,
+				CompetenceID: effort.CompetenceID,
+				Year:         2026,
+				HourlyRate:   common.NewMoney(100.00),
+				Currency:     domain.BRL,
+			}),
+		})
+		service := domain.NewPortfolioService(plan.PlanID, baseline, nil, []*domain.Effort{effort, other}, plan.GetInflation(), plan.GetExchange(), rates, domain.NewTaxTable(nil), 0)
+		_, _, workloads, err := service.GeneratePortfolio()
+
+		assert.NoError(t, err)
+		assert.Equal(t, "0.00", workloads[0].WorkloadAllocations[0].Amount.String())
+		assert.Equal(t, "1000.00", workloads[0].WorkloadAllocations[1].Amount.String())
+		assert.Equal(t, "1000.00", workloads[0].Amount.String())
+		assert.Equal(t, "0.00", workloads[1].Amount.String())
+	})
+
+	t.Run("should price workloads with the monthly inflation of the plan", func(t *testing.T) {
+		monthlyPlan := testutils.NewPlanFakeBuilder().WithInflationMode(domain.MonthlyLinearInflation).Build()
+		monthlyEffort := testutils.NewEffortFakeBuilder().
+			WithBaselineID(baseline.BaselineID).
+			WithHours(20).
+			WithEffortAllocationsProps([]domain.EffortAllocationProps{
+				{Year: 2025, Month: time.January, Hours: 10},
+				{Year: 2025, Month: time.July, Hours: 10},
+			}).
+			Build()
+		rates := domain.NewRateTable([]*domain.RateCard{
+			domain.NewRateCard(domain.NewRateCardProps{
+				PlanID:       monthlyPlan.PlanID,
+				CompetenceID: monthlyEffort.CompetenceID,
+				Year:         2025,
+				HourlyRate:   common.NewMoney(100.00),
+				Currency:     domain.BRL,
+			}),
+		})
+		service := domain.NewPortfolioService(monthlyPlan.PlanID, baseline, nil, []*domain.Effort{monthlyEffort}, monthlyPlan.GetInflation(), monthlyPlan.GetExchange(), rates, domain.NewTaxTable(nil), 0)
+		_, _, workloads, err := service.GeneratePortfolio()
+
+		assert.NoError(t, err)
+		assert.Equal(t, "1000.00", workloads[0].WorkloadAllocations[0].Amount.String())
+		assert.Equal(t, "1026.00", workloads[0].WorkloadAllocations[1].Amount.String())
+	})
+
+	t.Run("should fail when the year is not in the plan", func(t *testing.T) {
+		rateCard := domain.NewRateCard(domain.NewRateCardProps{
+			PlanID:       plan.PlanID,
+			CompetenceID: effort.CompetenceID,
+			Year:         2030,
+			HourlyRate:   common.NewMoney(100.00),
+			Currency:     domain.BRL,
+		})
+
+		err := rateCard.ValidateYear(plan)
+
+		var errDomainValidation *common.DomainValidationError
+		assert.True(t, errors.As(err, &errDomainValidation))
+		assert.EqualError(t, err, "rate card year is not in the plan assumptions: 2030")
+	})
+
+	t.Run("should copy the rate cards to the years of another plan", func(t *testing.T) {
+		newRateCard := func(competenceID string, year int, rate float64) *domain.RateCard {
+			return domain.NewRateCard(domain.NewRateCardProps{
+				PlanID:       plan.PlanID,
+				CompetenceID: competenceID,
+				Year:         year,
+				HourlyRate:   common.NewMoney(rate),
+				Currency:     domain.BRL,
+			})
+		}
+		other := testutils.NewCompetenceFakeBuilder().Build()
+		rateCards := []*domain.RateCard{
+			newRateCard(effort.CompetenceID, 2022, 80.00),
+			newRateCard(effort.CompetenceID, 2023, 90.00),
+			newRateCard(effort.CompetenceID, 2025, 100.00),
+			newRateCard(other.CompetenceID, 2022, 50.00),
+			newRateCard(other.CompetenceID, 2023, 60.00),
+		}
+		clone := plan.Clone("FC 01", "Forecast 01")
+		clone.RollOver(2)
+
+		copies := domain.CopyRateCards(rateCards, clone)
+
+		assert.Len(t, copies, 3)
+		assert.Equal(t, clone.PlanID, copies[0].PlanID)
+		assert.Equal(t, effort.CompetenceID, copies[0].CompetenceID)
+		assert.Equal(t, 2024, copies[0].Year)
+		assert.Equal(t, "90.00", copies[0].HourlyRate.String())
+		assert.Equal(t, 2025, copies[1].Year)
+		assert.Equal(t, "100.00", copies[1].HourlyRate.String())
+		assert.Equal(t, other.CompetenceID, copies[2].CompetenceID)
+		assert.Equal(t, 2024, copies[2].Year)
+		assert.Equal(t, "60.00", copies[2].HourlyRate.String())
+		for _, c := range copies {
+			assert.NoError(t, c.ValidateYear(clone))
+		}
+	})
+}
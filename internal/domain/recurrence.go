@@ -0,0 +1,133 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/shopspring/decimal"
+)
+
+type RecurrenceFrequency string
+
+func (f RecurrenceFrequency) String() string {
+	return string(f)
+}
+
+const (
+	MonthlyRecurrence   RecurrenceFrequency = "monthly"
+	QuarterlyRecurrence RecurrenceFrequency = "quarterly"
+	YearlyRecurrence    RecurrenceFrequency = "yearly"
+)
+
+// MaxRecurrenceMonths is the longest a recurrence can run, the duration limit of a baseline
+const MaxRecurrenceMonths = 60
+
+var (
+	ErrRecurrenceEnd        = errors.New("recurrence must have either an end month or an occurrence count")
+	ErrRecurrencePeriod     = errors.New("recurrence start month is after end month")
+	ErrRecurrenceTooLong    = errors.New("recurrence runs for more than 60 months")
+	ErrRecurrenceNotRunning = errors.New("only running costs can have a recurrence")
+)
+
+// Recurrence is the rule of a running cost: the same amount charged every month, quarter or year
+// from the start month until the end month, both included, or for a number of occurrences
+type Recurrence struct {
+	Frequency   RecurrenceFrequency `json:"frequency" validate:"required,oneof=monthly quarterly yearly"`
+	Amount      common.Money        `json:"amount" validate:"required,gt=0,twodecimals"`
+	StartYear   int                 `json:"start_year" validate:"required"`
+	StartMonth  time.Month          `json:"start_month" validate:"gte=1,lte=12"`
+	EndYear     int                 `json:"end_year,omitempty" validate:"required_with=EndMonth"`
+	EndMonth    time.Month          `json:"end_month,omitempty" validate:"required_with=EndYear,omitempty,gte=1,lte=12"`
+	Occurrences int                 `json:"occurrences,omitempty" validate:"omitempty,gte=1,lte=60"`
+}
+
+func (r *Recurrence) Validate() error {
+	err := common.Validate.Struct(r)
+	if err != nil {
+		return common.NewDomainValidationError(fmt.Errorf("recurrence domain validation failed: %w", err))
+	}
+
+	hasEnd := r.EndYear != 0 || r.EndMonth != 0
+	if hasEnd == (r.Occurrences != 0) {
+		return common.NewDomainValidationError(ErrRecurrenceEnd)
+	}
+
+	if hasEnd && r.start().After(r.end()) {
+		return common.NewDomainValidationError(ErrRecurrencePeriod)
+	}
+
+	if r.months() > MaxRecurrenceMonths {
+		return common.NewDomainValidationError(ErrRecurrenceTooLong)
+	}
+
+	return nil
+}
+
+// months returns the number of months from the start month to the last occurrence, both included
+func (r *Recurrence) months() int {
+	if r.Occurrences > 0 {
+		return (r.Occurrences-1)*r.step() + 1
+	}
+	return (r.EndYear-r.StartYear)*12 + int(r.EndMonth-r.StartMonth) + 1
+}
+
+// Expand returns one allocation of the amount for each occurrence, and expects a valid recurrence
+func (r *Recurrence) Expand() []CostAllocationProps {
+	step := r.step()
+	allocations := make([]CostAllocationProps, 0)
+
+	for i := 0; ; i++ {
+		if r.Occurrences > 0 && i >= r.Occurrences {
+			break
+		}
+		date := r.start().AddDate(0, i*step, 0)
+		if r.Occurrences == 0 && date.After(r.end()) {
+			break
+		}
+		allocations = append(allocations, CostAllocationProps{
+			Year:   date.Year(),
+			Month:  date.Month(),
+			Amount: r.Amount,
+		})
+	}
+
+	return allocations
+}
+
+// Total returns the amount of all occurrences
+func (r *Recurrence) Total() common.Money {
+	return r.Amount.Mul(decimal.NewFromInt(int64(len(r.Expand()))))
+}
+
+// shift returns a copy of the recurrence moved by the months
+func (r *Recurrence) shift(months int) *Recurrence {
+	shifted := *r
+	start := r.start().AddDate(0, months, 0)
+	shifted.StartYear, shifted.StartMonth = start.Year(), start.Month()
+	if r.Occurrences == 0 {
+		end := r.end().AddDate(0, months, 0)
+		shifted.EndYear, shifted.EndMonth = end.Year(), end.Month()
+	}
+	return &shifted
+}
+
+func (r *Recurrence) step() int {
+	switch r.Frequency {
+	case QuarterlyRecurrence:
+		return 3
+	case YearlyRecurrence:
+		return 12
+	default:
+		return 1
+	}
+}
+
+func (r *Recurrence) start() time.Time {
+	return time.Date(r.StartYear, r.StartMonth, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func (r *Recurrence) end() time.Time {
+	return time.Date(r.EndYear, r.EndMonth, 1, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,218 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/celsopires1999/estimation/internal/domain"
+	"github.com/celsopires1999/estimation/internal/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitRecurrence(t *testing.T) {
+	t.Run("should expand a monthly recurrence until the end month", func(t *testing.T) {
+		recurrence := &domain.Recurrence{
+			Frequency:  domain.MonthlyRecurrence,
+			Amount:     common.NewMoney(150.00),
+			StartYear:  2025,
+			StartMonth: time.November,
+			EndYear:    2026,
+			EndMonth:   time.February,
+		}
+		assert.NoError(t, recurrence.Validate())
+
+		allocations := recurrence.Expand()
+
+		assert.Len(t, allocations, 4)
+		assert.Equal(t, 2025, allocations[0].Year)
+		assert.Equal(t, time.November, allocations[0].Month)
+		assert.Equal(t, 2026, allocations[3].Year)
+		assert.Equal(t, time.February, allocations[3].Month)
+		assert.Equal(t, "600.00", recurrence.Total().String())
+	})
+
+	t.Run("should expand a quarterly recurrence by occurrences", func(t *testing.T) {
+		cost := testutils.NewCostFakeBuilder().
+			WithTax(0).
+			WithRecurrence(&domain.Recurrence{
+				Frequency:   domain.QuarterlyRecurrence,
+				Amount:      common.NewMoney(1000.00),
+				StartYear:   2025,
+				StartMonth:  time.December,
+				Occurrences: 3,
+			}).
+			Build()
+
+		assert.Equal(t, domain.RunningCost, cost.CostType)
+		assert.Equal(t, "3000.00", cost.Amount.String())
+		assert.Len(t, cost.CostAllocations, 3)
+		assert.Equal(t, time.Date(2025, time.December, 1, 0, 0, 0, 0, time.UTC), cost.CostAllocations[0].AllocationDate)
+		assert.Equal(t, time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC), cost.CostAllocations[1].AllocationDate)
+		assert.Equal(t, time.Date(2026, time.June, 1, 0, 0, 0, 0, time.UTC), cost.CostAllocations[2].AllocationDate)
+	})
+
+	t.Run("should regenerate the allocations when the rule changes", func(t *testing.T) {
+		cost := testutils.NewCostFakeBuilder().
+			WithTax(0).
+			WithRecurrence(&domain.Recurrence{
+				Frequency:   domain.MonthlyRecurrence,
+				Amount:      common.NewMoney(100.00),
+				StartYear:   2025,
+				StartMonth:  time.January,
+				Occurrences: 12,
+			}).
+			Build()
+
+		cost.ChangeRecurrence(&domain.Recurrence{
+			Frequency:  domain.YearlyRecurrence,
+			Amount:     common.NewMoney(1200.00),
+			StartYear:  2025,
+			StartMonth: time.January,
+			EndYear:    2027,
+			EndMonth:   time.January,
+		})
+
+		assert.NoError(t, cost.Validate())
+		assert.Equal(t, "3600.00", cost.Amount.String())
+		assert.Len(t, cost.CostAllocations, 3)
+		assert.Equal(t, 2027, cost.CostAllocations[2].AllocationDate.Year())
+	})
+
+	t.Run("should drop the rule when the allocations are replaced", func(t *testing.T) {
+		cost := testutils.NewCostFakeBuilder().
+			WithTax(0).
+			WithRecurrence(&domain.Recurrence{
+				Frequency:   domain.MonthlyRecurrence,
+				Amount:      common.NewMoney(50.00),
+				StartYear:   2025,
+				StartMonth:  time.January,
+				Occurrences: 2,
+			}).
+			Build()
+
+		cost.ChangeCostAllocations([]domain.CostAllocationProps{
+			{Year: 2025, Month: time.March, Amount: common.NewMoney(100.00)},
+		})
+
+		assert.Nil(t, cost.Recurrence)
+		assert.NoError(t, cost.Validate())
+	})
+
+	t.Run("should fail without an end month or occurrences", func(t *testing.T) {
+		recurrence := &domain.Recurrence{
+			Frequency:  domain.MonthlyRecurrence,
+			Amount:     common.NewMoney(100.00),
+			StartYear:  2025,
+			StartMonth: time.January,
+		}
+
+		err := recurrence.Validate()
+
+		var errDomainValidation *common.DomainValidationError
+		assert.True(t, errors.As(err, &errDomainValidation))
+		assert.EqualError(t, err, domain.ErrRecurrenceEnd.Error())
+	})
+
+	t.Run("should fail when the end month is before the start month", func(t *testing.T) {
+		recurrence := &domain.Recurrence{
+			Frequency:  domain.MonthlyRecurrence,
+			Amount:     common.NewMoney(100.00),
+			StartYear:  2025,
+			StartMonth: time.June,
+			EndYear:    2025,
+			EndMonth:   time.May,
+		}
+
+		err := recurrence.Validate()
+
+		var errDomainValidation *common.DomainValidationError
+		assert.True(t, errors.As(err, &errDomainValidation))
+		assert.EqualError(t, err, domain.ErrRecurrencePeriod.Error())
+	})
+
+	t.Run("should fail with an end year and no end month", func(t *testing.T) {
+		recurrence := &domain.Recurrence{
+			Frequency:  domain.MonthlyRecurrence,
+			Amount:     common.NewMoney(100.00),
+			StartYear:  2025,
+			StartMonth: time.January,
+			EndYear:    2025,
+		}
+
+		err := recurrence.Validate()
+
+		var errDomainValidation *common.DomainValidationError
+		assert.True(t, errors.As(err, &errDomainValidation))
+		assert.ErrorContains(t, err, "EndMonth")
+	})
+
+	t.Run("should fail when the recurrence runs for more than 60 months", func(t *testing.T) {
+		recurrences := []*domain.Recurrence{
+			{
+				Frequency:   domain.MonthlyRecurrence,
+				Amount:      common.NewMoney(100.00),
+				StartYear:   2025,
+				StartMonth:  time.January,
+				Occurrences: 1_000_000_000,
+			},
+			{
+				Frequency:   domain.YearlyRecurrence,
+				Amount:      common.NewMoney(100.00),
+				StartYear:   2025,
+				StartMonth:  time.January,
+				Occurrences: 6,
+			},
+			{
+				Frequency:  domain.MonthlyRecurrence,
+				Amount:     common.NewMoney(100.00),
+				StartYear:  2025,
+				StartMonth: time.January,
+				EndYear:    2030,
+				EndMonth:   time.January,
+			},
+		}
+
+		for _, recurrence := range recurrences {
+			err := recurrence.Validate()
+
+			var errDomainValidation *common.DomainValidationError
+			assert.True(t, errors.As(err, &errDomainValidation))
+		}
+		assert.EqualError(t, recurrences[1].Validate(), domain.ErrRecurrenceTooLong.Error())
+		assert.EqualError(t, recurrences[2].Validate(), domain.ErrRecurrenceTooLong.Error())
+	})
+
+	t.Run("should accept a recurrence of 60 months", func(t *testing.T) {
+		recurrence := &domain.Recurrence{
+			Frequency:  domain.MonthlyRecurrence,
+			Amount:     common.NewMoney(100.00),
+			StartYear:  2025,
+			StartMonth: time.January,
+			EndYear:    2029,
+			EndMonth:   time.December,
+		}
+
+		assert.NoError(t, recurrence.Validate())
+		assert.Len(t, recurrence.Expand(), 60)
+	})
+
+	t.Run("should fail when the cost is not running", func(t *testing.T) {
+		cost := testutils.NewCostFakeBuilder().WithTax(0).Build()
+		cost.CostType = domain.OneTimeCost
+		cost.ChangeRecurrence(&domain.Recurrence{
+			Frequency:   domain.MonthlyRecurrence,
+			Amount:      common.NewMoney(100.00),
+			StartYear:   2025,
+			StartMonth:  time.January,
+			Occurrences: 1,
+		})
+
+		err := cost.Validate()
+
+		var errDomainValidation *common.DomainValidationError
+		assert.True(t, errors.As(err, &errDomainValidation))
+		assert.EqualError(t, err, domain.ErrRecurrenceNotRunning.Error())
+	})
+}
@@ -7,11 +7,19 @@ type EstimationRepository interface {
 	BaselineRepository
 	CostRepository
 	CompetenceRepository
+	CurrencyRepository
 	EffortRepository
 	PlanRepository
 	PortfolioRepository
 	BudgetRepository
+	ActualRepository
+	ProgressRepository
+	TimesheetRepository
 	WorkloadRepository
+	RateCardRepository
+	CapacityRepository
+	TaxProfileRepository
+	AuditRepository
 }
 
 type UserRepository interface {
@@ -26,6 +34,9 @@ type BaselineRepository interface {
 	GetBaseline(ctx context.Context, baselineID string) (*Baseline, error)
 	UpdateBaseline(ctx context.Context, baseline *Baseline) error
 	DeleteBaseline(ctx context.Context, baselineID string) error
+	GetBaselineManyByCode(ctx context.Context, code string) ([]*Baseline, error)
+	UpdateBaselineStatus(ctx context.Context, baseline *Baseline) error
+	CreateBaselineTransition(ctx context.Context, transition *BaselineTransition) error
 }
 
 type CostRepository interface {
@@ -40,10 +51,19 @@ type CostRepository interface {
 type CompetenceRepository interface {
 	CreateCompetence(ctx context.Context, competence *Competence) error
 	GetCompetence(ctx context.Context, competenceID string) (*Competence, error)
+	GetCompetenceByCode(ctx context.Context, code string) (*Competence, error)
 	UpdateCompetence(ctx context.Context, competence *Competence) error
 	DeleteCompetence(ctx context.Context, competenceID string) error
 }
 
+type CurrencyRepository interface {
+	CreateCurrency(ctx context.Context, currency *CurrencyEntry) error
+	GetCurrency(ctx context.Context, currencyID string) (*CurrencyEntry, error)
+	UpdateCurrency(ctx context.Context, currency *CurrencyEntry) error
+	DeleteCurrency(ctx context.Context, currencyID string) error
+	ValidateCurrency(ctx context.Context, code Currency) error
+}
+
 type EffortRepository interface {
 	CreateEffort(ctx context.Context, effort *Effort) error
 	CreateEffortMany(ctx context.Context, efforts []*Effort) error
@@ -64,6 +84,8 @@ type PlanRepository interface {
 type PortfolioRepository interface {
 	CreatePortfolio(ctx context.Context, portfolio *Portfolio) error
 	GetPortfolio(ctx context.Context, portfolioID string) (*Portfolio, error)
+	GetPortfolioManyByPlanID(ctx context.Context, planID string) ([]*Portfolio, error)
+	GetPortfolioByPlanIDAndBaselineCode(ctx context.Context, planID, baselineCode string) (*Portfolio, error)
 	UpdatePortfolio(ctx context.Context, portfolio *Portfolio) error
 	DeletePortfolio(ctx context.Context, portfolioID string) error
 	CountPortfoliosByPlanId(ctx context.Context, planID string) (int64, error)
@@ -81,6 +103,29 @@ type BudgetRepository interface {
 	GetBudgetManyByPortfolioID(ctx context.Context, portfolioID string) ([]*Budget, error)
 }
 
+type ActualRepository interface {
+	CreateActual(ctx context.Context, actual *Actual) error
+	GetActual(ctx context.Context, actualID string) (*Actual, error)
+	UpdateActual(ctx context.Context, actual *Actual) error
+	DeleteActual(ctx context.Context, actualID string) error
+	GetActualManyByPortfolioID(ctx context.Context, portfolioID string) ([]*Actual, error)
+}
+
+type ProgressRepository interface {
+	CreateProgress(ctx context.Context, progress *Progress) error
+	GetProgress(ctx context.Context, progressID string) (*Progress, error)
+	UpdateProgress(ctx context.Context, progress *Progress) error
+	DeleteProgress(ctx context.Context, progressID string) error
+	GetProgressManyByPortfolioID(ctx context.Context, portfolioID string) ([]*Progress, error)
+}
+
+type TimesheetRepository interface {
+	CreateTimesheet(ctx context.Context, timesheet *Timesheet) error
+	GetTimesheet(ctx context.Context, timesheetID string) (*Timesheet, error)
+	UpdateTimesheet(ctx context.Context, timesheet *Timesheet) error
+	GetTimesheetManyByPortfolioID(ctx context.Context, portfolioID string) ([]*Timesheet, error)
+}
+
 type WorkloadRepository interface {
 	CreateWorkload(ctx context.Context, workload *Workload) error
 	CreateWorkloadMany(ctx context.Context, workloads []*Workload) error
@@ -90,3 +135,30 @@ type WorkloadRepository interface {
 	DeleteWorkloadsByPortfolioID(ctx context.Context, portfolioID string) error
 	GetWorkloadManyByPortfolioID(ctx context.Context, portfolioID string) ([]*Workload, error)
 }
+
+type RateCardRepository interface {
+	CreateRateCard(ctx context.Context, rateCard *RateCard) error
+	GetRateCard(ctx context.Context, rateCardID string) (*RateCard, error)
+	UpdateRateCard(ctx context.Context, rateCard *RateCard) error
+	DeleteRateCard(ctx context.Context, rateCardID string) error
+	GetRateCardManyByPlanID(ctx context.Context, planID string) ([]*RateCard, error)
+}
+
+type CapacityRepository interface {
+	CreateCapacity(ctx context.Context, capacity *Capacity) error
+	GetCapacity(ctx context.Context, capacityID string) (*Capacity, error)
+	UpdateCapacity(ctx context.Context, capacity *Capacity) error
+	DeleteCapacity(ctx context.Context, capacityID string) error
+}
+
+type TaxProfileRepository interface {
+	CreateTaxProfile(ctx context.Context, taxProfile *TaxProfile) error
+	GetTaxProfile(ctx context.Context, taxProfileID string) (*TaxProfile, error)
+	UpdateTaxProfile(ctx context.Context, taxProfile *TaxProfile) error
+	DeleteTaxProfile(ctx context.Context, taxProfileID string) error
+	GetTaxProfileMany(ctx context.Context) ([]*TaxProfile, error)
+}
+
+type AuditRepository interface {
+	CreateAuditEntry(ctx context.Context, entry *AuditEntry) error
+}
@@ -13,6 +13,8 @@ type PortfolioService struct {
 	efforts     []*Effort
 	inflation   *inflation
 	exchange    *exchange
+	rates       *rateTable
+	taxes       *taxTable
 	shiftMonths int
 }
 
@@ -23,6 +25,8 @@ func NewPortfolioService(
 	efforts []*Effort,
 	inflation *inflation,
 	exchange *exchange,
+	rates *rateTable,
+	taxes *taxTable,
 	shiftMonths int,
 ) *PortfolioService {
 	return &PortfolioService{
@@ -32,6 +36,8 @@ func NewPortfolioService(
 		efforts,
 		inflation,
 		exchange,
+		rates,
+		taxes,
 		shiftMonths,
 	}
 }
@@ -50,24 +56,48 @@ func (s *PortfolioService) GeneratePortfolio() (*Portfolio, []*Budget, []*Worklo
 		budgetProps := NewBudgetProps{}
 		budgetProps.PortfolioID = portfolio.PortfolioID
 		budgetProps.CostID = cost.CostID
-		budgetProps.Amount = 0.0
-		for _, costAllocation := range cost.CostAllocations {
+		components, err := s.taxes.GetComponents(cost)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		exactTaxes := make([]common.Money, len(components))
+		exactAmounts := make([]common.Money, len(cost.CostAllocations))
+		for j, costAllocation := range cost.CostAllocations {
 			newAllocationDate := costAllocation.AllocationDate.AddDate(0, s.shiftMonths, 0)
-			amount, err := s.calculateBudgetAllocation(cost, costAllocation, newAllocationDate)
+			amount, taxes, err := s.calculateBudgetAllocation(cost, components, costAllocation, newAllocationDate)
 			if err != nil {
 				return nil, nil, nil, err
 			}
-			budgetProps.Amount += amount
+			exactAmounts[j] = amount
+			for k, tax := range taxes {
+				exactTaxes[k] = exactTaxes[k].Add(tax)
+			}
 			budgetProps.BudgetAllocations = append(budgetProps.BudgetAllocations, NewBudgetAllocationProps{
-				Year:   newAllocationDate.Year(),
-				Month:  newAllocationDate.Month(),
-				Amount: amount,
+				Year:  newAllocationDate.Year(),
+				Month: newAllocationDate.Month(),
 			})
 		}
 
-		budgetProps.Amount = common.RoundToTwoDecimals(budgetProps.Amount)
+		// the budget amount is the exact total rounded once, and the residual cents are spread across the months
+		total, amounts := common.AllocateCents(exactAmounts)
+		budgetProps.Amount = total
+		for j := range budgetProps.BudgetAllocations {
+			budgetProps.BudgetAllocations[j].Amount = amounts[j]
+		}
+
+		_, taxAmounts := common.AllocateCents(exactTaxes)
+		budgetProps.Taxes = make(BudgetTaxes, len(components))
+		for k, component := range components {
+			budgetProps.Taxes[k] = BudgetTax{
+				Name:     component.Name,
+				Rate:     component.Rate,
+				Compound: component.Compound,
+				Amount:   taxAmounts[k],
+			}
+		}
+
 		budgets[i] = NewBudget(budgetProps)
-		err := budgets[i].Validate()
+		err = budgets[i].Validate()
 		if err != nil {
 			return nil, nil, nil, err
 		}
@@ -80,14 +110,30 @@ func (s *PortfolioService) GeneratePortfolio() (*Portfolio, []*Budget, []*Worklo
 		workloadProps.PortfolioID = portfolio.PortfolioID
 		workloadProps.EffortID = effort.EffortID
 		workloadProps.Hours = effort.Hours
-		for _, effortAllocation := range effort.EffortAllocations {
+		exactAmounts := make([]common.Money, len(effort.EffortAllocations))
+		for j, effortAllocation := range effort.EffortAllocations {
 			newAllocationDate := effortAllocation.AllocationDate.AddDate(0, s.shiftMonths, 0)
+			// workloads are only priced when the plan has a rate card for the competence
+			if !s.rates.IsEmpty() {
+				amount, err := s.calculateWorkloadAllocation(effort, effortAllocation, newAllocationDate)
+				if err != nil {
+					return nil, nil, nil, err
+				}
+				exactAmounts[j] = amount
+			}
 			workloadProps.WorkloadAllocations = append(workloadProps.WorkloadAllocations, NewWorkloadAllocationProps{
 				Year:  newAllocationDate.Year(),
 				Month: newAllocationDate.Month(),
 				Hours: effortAllocation.Hours,
 			})
 		}
+
+		total, amounts := common.AllocateCents(exactAmounts)
+		workloadProps.Amount = total
+		for j := range workloadProps.WorkloadAllocations {
+			workloadProps.WorkloadAllocations[j].Amount = amounts[j]
+		}
+
 		workloads[i] = NewWorkload(workloadProps)
 		err := workloads[i].Validate()
 		if err != nil {
@@ -99,29 +145,122 @@ func (s *PortfolioService) GeneratePortfolio() (*Portfolio, []*Budget, []*Worklo
 
 }
 
-func (s *PortfolioService) calculateBudgetAllocation(cost *Cost, costAllocation CostAllocation, budgetAllocationDate time.Time) (float64, error) {
-	if cost.Currency.IsBRL() {
-		if !cost.ApplyInflation {
-			return common.RoundToTwoDecimals(s.applyTax(cost, costAllocation)), nil
+// PortfolioRecalculation has the budgets and workloads of an existing portfolio generated again.
+// Budgets and workloads of costs and efforts that are still in the baseline keep their IDs.
+type PortfolioRecalculation struct {
+	Portfolio        *Portfolio
+	CreatedBudgets   []*Budget
+	UpdatedBudgets   []*Budget
+	DeletedBudgets   []*Budget
+	CreatedWorkloads []*Workload
+	UpdatedWorkloads []*Workload
+	DeletedWorkloads []*Workload
+}
+
+// RecalculatePortfolio generates the portfolio again and matches the result with its current budgets and workloads
+func (s *PortfolioService) RecalculatePortfolio(portfolio *Portfolio, budgets []*Budget, workloads []*Workload) (*PortfolioRecalculation, error) {
+	generated, newBudgets, newWorkloads, err := s.GeneratePortfolio()
+	if err != nil {
+		return nil, err
+	}
+
+	portfolio.StartDate = generated.StartDate
+	recalculation := &PortfolioRecalculation{Portfolio: portfolio}
+
+	currentBudgets := make(map[string]*Budget, len(budgets))
+	for _, b := range budgets {
+		currentBudgets[b.CostID] = b
+	}
+	for _, b := range newBudgets {
+		b.PortfolioID = portfolio.PortfolioID
+		current, ok := currentBudgets[b.CostID]
+		if !ok {
+			recalculation.CreatedBudgets = append(recalculation.CreatedBudgets, b)
+			continue
 		}
-		amount, err := s.inflation.ApplyInflation(s.applyTax(cost, costAllocation), s.baseline.StartDate.Year(), budgetAllocationDate.Year())
-		if err != nil {
-			return 0.0, err
+		b.BudgetID = current.BudgetID
+		b.CreatedAt = current.CreatedAt
+		recalculation.UpdatedBudgets = append(recalculation.UpdatedBudgets, b)
+		delete(currentBudgets, b.CostID)
+	}
+	for _, b := range budgets {
+		if _, ok := currentBudgets[b.CostID]; ok {
+			recalculation.DeletedBudgets = append(recalculation.DeletedBudgets, b)
+		}
+	}
+
+	currentWorkloads := make(map[string]*Workload, len(workloads))
+	for _, w := range workloads {
+		currentWorkloads[w.EffortID] = w
+	}
+	for _, w := range newWorkloads {
+		w.PortfolioID = portfolio.PortfolioID
+		current, ok := currentWorkloads[w.EffortID]
+		if !ok {
+			recalculation.CreatedWorkloads = append(recalculation.CreatedWorkloads, w)
+			continue
+		}
+		w.WorkloadID = current.WorkloadID
+		w.CreatedAt = current.CreatedAt
+		recalculation.UpdatedWorkloads = append(recalculation.UpdatedWorkloads, w)
+		delete(currentWorkloads, w.EffortID)
+	}
+	for _, w := range workloads {
+		if _, ok := currentWorkloads[w.EffortID]; ok {
+			recalculation.DeletedWorkloads = append(recalculation.DeletedWorkloads, w)
 		}
-		return amount, nil
 	}
 
-	amount, err := s.exchange.ConvertToBRL(s.applyTax(cost, costAllocation), cost.Currency, budgetAllocationDate.Year())
+	return recalculation, nil
+}
+
+// calculateBudgetAllocation returns the allocation amount with taxes in BRL,
+// and the amount of each tax component in BRL
+func (s *PortfolioService) calculateBudgetAllocation(cost *Cost, components TaxComponents, costAllocation CostAllocation, budgetAllocationDate time.Time) (common.Money, []common.Money, error) {
+	taxes := components.Apply(costAllocation.Amount)
+
+	amount, err := s.convertCostAmount(cost, costAllocation.Amount.Add(common.SumMoney(taxes...)), budgetAllocationDate)
 	if err != nil {
-		return 0.0, err
+		return common.ZeroMoney, nil, err
 	}
 
-	return amount, nil
+	for i, tax := range taxes {
+		taxes[i], err = s.convertCostAmount(cost, tax, budgetAllocationDate)
+		if err != nil {
+			return common.ZeroMoney, nil, err
+		}
+	}
+
+	return amount, taxes, nil
+}
+
+func (s *PortfolioService) convertCostAmount(cost *Cost, amount common.Money, budgetAllocationDate time.Time) (common.Money, error) {
+	if cost.Currency.IsBRL() {
+		if !cost.ApplyInflation {
+			return amount, nil
+		}
+		return s.inflation.ApplyInflationByMonth(amount, s.baseline.StartDate, budgetAllocationDate)
+	}
+
+	return s.exchange.ConvertToBRL(amount, cost.Currency, budgetAllocationDate.Year(), budgetAllocationDate.Month())
 }
 
-func (s *PortfolioService) applyTax(cost *Cost, costAllocation CostAllocation) float64 {
-	if cost.Tax == 0 {
-		return costAllocation.Amount
+// calculateWorkloadAllocation prices the hours with the rate card of the competence,
+// and leaves them unpriced when the competence has no rate card.
+// A rate in BRL is the price at the start of its year and is brought to the allocation month
+// by the plan inflation in the plan inflation mode, and a rate in another currency is converted to BRL.
+func (s *PortfolioService) calculateWorkloadAllocation(effort *Effort, effortAllocation EffortAllocation, workloadAllocationDate time.Time) (common.Money, error) {
+	rate, ok := s.rates.GetRate(effort.CompetenceID, workloadAllocationDate.Year())
+	if !ok {
+		return common.ZeroMoney, nil
+	}
+
+	amount := rate.Price(effortAllocation.Hours)
+
+	if rate.Currency.IsBRL() {
+		rateDate := time.Date(rate.Year, time.January, 1, 0, 0, 0, 0, time.UTC)
+		return s.inflation.ApplyInflationByMonth(amount, rateDate, workloadAllocationDate)
 	}
-	return (1 + cost.Tax/100) * costAllocation.Amount
+
+	return s.exchange.ConvertToBRL(amount, rate.Currency, workloadAllocationDate.Year(), workloadAllocationDate.Month())
 }
@@ -0,0 +1,146 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// TaxProfile is a named set of taxes applied together on a cost, e.g. ISS and PIS/COFINS on services
+type TaxProfile struct {
+	TaxProfileID string        `validate:"required,uuid4"`
+	Name         string        `validate:"required,max=50"`
+	Description  string        `validate:"-"`
+	Components   TaxComponents `validate:"required,min=1,dive"`
+	CreatedAt    time.Time     `validate:"-"`
+	UpdatedAt    time.Time     `validate:"-"`
+}
+
+type TaxComponents []TaxComponent
+
+// TaxComponent is one tax of a profile.
+// A simple component is charged on the cost amount, and a compound component
+// is charged on the cost amount plus the taxes of the components before it.
+type TaxComponent struct {
+	Name     string  `json:"name" validate:"required,max=50"`
+	Rate     float64 `json:"rate" validate:"gt=0,lte=100,twodecimals"`
+	Compound bool    `json:"compound"`
+}
+
+type RestoreTaxProfileProps TaxProfile
+
+// LegacyTaxComponent is the name of the component created from the single cost tax
+const LegacyTaxComponent = "tax"
+
+var (
+	ErrTaxComponentRepeated = errors.New("each tax component must have a unique name")
+	ErrTaxProfileNotFound   = errors.New("tax profile not found")
+)
+
+func NewTaxProfile(name string, description string, components TaxComponents) *TaxProfile {
+	return &TaxProfile{
+		TaxProfileID: uuid.NewString(),
+		Name:         name,
+		Description:  description,
+		Components:   components,
+	}
+}
+
+func RestoreTaxProfile(props RestoreTaxProfileProps) *TaxProfile {
+	return &TaxProfile{
+		TaxProfileID: props.TaxProfileID,
+		Name:         props.Name,
+		Description:  props.Description,
+		Components:   props.Components,
+		CreatedAt:    props.CreatedAt,
+		UpdatedAt:    props.UpdatedAt,
+	}
+}
+
+func (t *TaxProfile) ChangeName(name *string) {
+	if name == nil {
+		return
+	}
+	t.Name = *name
+}
+
+func (t *TaxProfile) ChangeDescription(description *string) {
+	if description == nil {
+		return
+	}
+	t.Description = *description
+}
+
+func (t *TaxProfile) ChangeComponents(components TaxComponents) {
+	if components == nil {
+		return
+	}
+	t.Components = components
+}
+
+func (t *TaxProfile) Validate() error {
+	err := common.Validate.Struct(t)
+	if err != nil {
+		return common.NewDomainValidationError(fmt.Errorf("tax profile domain validation failed: %w", err))
+	}
+
+	names := make(map[string]bool, len(t.Components))
+	for _, component := range t.Components {
+		if names[component.Name] {
+			return common.NewDomainValidationError(fmt.Errorf("%w: %s", ErrTaxComponentRepeated, component.Name))
+		}
+		names[component.Name] = true
+	}
+
+	return nil
+}
+
+// Apply returns the exact tax of each component on the amount, in the order of the components
+func (c TaxComponents) Apply(amount common.Money) []common.Money {
+	taxes := make([]common.Money, len(c))
+	accumulated := common.ZeroMoney
+
+	for i, component := range c {
+		base := amount
+		if component.Compound {
+			base = amount.Add(accumulated)
+		}
+		taxes[i] = base.Mul(decimal.NewFromFloat(component.Rate).Div(decimal.NewFromInt(100)))
+		accumulated = accumulated.Add(taxes[i])
+	}
+
+	return taxes
+}
+
+type taxTable struct {
+	profiles map[string]*TaxProfile
+}
+
+func NewTaxTable(taxProfiles []*TaxProfile) *taxTable {
+	profiles := make(map[string]*TaxProfile, len(taxProfiles))
+	for _, p := range taxProfiles {
+		profiles[p.TaxProfileID] = p
+	}
+	return &taxTable{profiles}
+}
+
+// GetComponents returns the taxes of the cost.
+// A cost without a tax profile has its single tax as a simple component.
+func (t *taxTable) GetComponents(cost *Cost) (TaxComponents, error) {
+	if cost.TaxProfileID == "" {
+		if cost.Tax == 0 {
+			return TaxComponents{}, nil
+		}
+		return TaxComponents{{Name: LegacyTaxComponent, Rate: cost.Tax}}, nil
+	}
+
+	profile, ok := t.profiles[cost.TaxProfileID]
+	if !ok {
+		return nil, common.NewDomainValidationError(fmt.Errorf("%w: %s", ErrTaxProfileNotFound, cost.TaxProfileID))
+	}
+	return profile.Components, nil
+}
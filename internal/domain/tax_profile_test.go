@@ -0,0 +1,121 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/celsopires1999/estimation/internal/domain"
+	"github.com/celsopires1999/estimation/internal/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitTaxProfile(t *testing.T) {
+	plan := testutils.NewPlanFakeBuilder().Build()
+	baseline := testutils.NewBaselineFakeBuilder().
+		WithStartDate(time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)).
+		WithDuration(12).
+		Build()
+	services := domain.NewTaxProfile("Services", "ISS and PIS/COFINS", domain.TaxComponents{
+		{Name: "ISS", Rate: 5.00},
+		{Name: "PIS/COFINS", Rate: 9.25, Compound: true},
+	})
+
+	t.Run("should apply simple and compound components", func(t *testing.T) {
+		taxes := services.Components.Apply(common.NewMoney(1000.00))
+
+		assert.Equal(t, "50.00", taxes[0].String())
+		assert.Equal(t, "97.13", taxes[1].String())
+		assert.Equal(t, "97.125", taxes[1].Decimal().String())
+	})
+
+	t.Run("should break the budget taxes out per component", func(t *testing.T) {
+		cost := testutils.NewCostFakeBuilder().
+			WithBaselineID(baseline.BaselineID).
+			WithCurrency(domain.BRL).
+			WithApplyInflation(false).
+			WithTax(0).
+			WithTaxProfileID(services.TaxProfileID).
+			WithAmount(common.NewMoney(1000.00)).
+			WithCostAllocationProps([]domain.CostAllocationProps{
+				{Year: 2025, Month: time.January, Amount: common.NewMoney(1000.00)},
+			}).
+			Build()
+
+		taxes := domain.NewTaxTable([]*domain.TaxProfile{services})
+		service := domain.NewPortfolioService(plan.PlanID, baseline, []*domain.Cost{cost}, nil, plan.GetInflation(), plan.GetExchange(), domain.NewRateTable(nil), taxes, 0)
+		_, budgets, _, err := service.GeneratePortfolio()
+
+		assert.NoError(t, err)
+		assert.Equal(t, "1147.13", budgets[0].Amount.String())
+		assert.Len(t, budgets[0].Taxes, 2)
+		assert.Equal(t, "ISS", budgets[0].Taxes[0].Name)
+		assert.Equal(t, "50.00", budgets[0].Taxes[0].Amount.String())
+		assert.Equal(t, "PIS/COFINS", budgets[0].Taxes[1].Name)
+		assert.True(t, budgets[0].Taxes[1].Compound)
+		assert.Equal(t, "97.13", budgets[0].Taxes[1].Amount.String())
+	})
+
+	t.Run("should convert the taxes of a foreign cost", func(t *testing.T) {
+		cost := testutils.NewCostFakeBuilder().
+			WithBaselineID(baseline.BaselineID).
+			WithCurrency(domain.USD).
+			WithTax(10.00).
+			WithAmount(common.NewMoney(100.00)).
+			WithCostAllocationProps([]domain.CostAllocationProps{
+				{Year: 2025, Month: time.January, Amount: common.NewMoney(100.00)},
+			}).
+			Build()
+
+		service := domain.NewPortfolioService(plan.PlanID, baseline, []*domain.Cost{cost}, nil, plan.GetInflation(), plan.GetExchange(), domain.NewRateTable(nil), domain.NewTaxTable(nil), 0)
+		_, budgets, _, err := service.GeneratePortfolio()
+
+		assert.NoError(t, err)
+		assert.Equal(t, "550.00", budgets[0].Amount.String())
+		assert.Len(t, budgets[0].Taxes, 1)
+		assert.Equal(t, domain.LegacyTaxComponent, budgets[0].Taxes[0].Name)
+		assert.Equal(t, "50.00", budgets[0].Taxes[0].Amount.String())
+	})
+
+	t.Run("should fail when the tax profile is unknown", func(t *testing.T) {
+		cost := testutils.NewCostFakeBuilder().
+			WithBaselineID(baseline.BaselineID).
+			WithTax(0).
+			WithTaxProfileID(services.TaxProfileID).
+			Build()
+
+		service := domain.NewPortfolioService(plan.PlanID, baseline, []*domain.Cost{cost}, nil, plan.GetInflation(), plan.GetExchange(), domain.NewRateTable(nil), domain.NewTaxTable(nil), 0)
+		_, _, _, err := service.GeneratePortfolio()
+
+		var errDomainValidation *common.DomainValidationError
+		assert.True(t, errors.As(err, &errDomainValidation))
+		assert.ErrorContains(t, err, domain.ErrTaxProfileNotFound.Error())
+	})
+
+	t.Run("should fail when component names are repeated", func(t *testing.T) {
+		profile := domain.NewTaxProfile("Goods", "", domain.TaxComponents{
+			{Name: "ICMS", Rate: 18.00},
+			{Name: "ICMS", Rate: 12.00},
+		})
+
+		err := profile.Validate()
+
+		var errDomainValidation *common.DomainValidationError
+		assert.True(t, errors.As(err, &errDomainValidation))
+		assert.EqualError(t, err, "each tax component must have a unique name: ICMS")
+	})
+
+	t.Run("should fail when a cost has both a tax and a tax profile", func(t *testing.T) {
+		cost := testutils.NewCostFakeBuilder().Build()
+		cost.ChangeTaxProfileID(&services.TaxProfileID)
+		tax := 10.00
+		cost.ChangeTax(&tax)
+
+		err := cost.Validate()
+
+		var errDomainValidation *common.DomainValidationError
+		assert.True(t, errors.As(err, &errDomainValidation))
+		assert.EqualError(t, err, domain.ErrCostTaxWithProfile.Error())
+	})
+}
@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/google/uuid"
+)
+
+// Timesheet is the number of hours worked by a competence on a portfolio in a month
+type Timesheet struct {
+	TimesheetID  string    `validate:"required,uuid4"`
+	PortfolioID  string    `validate:"required,uuid4"`
+	CompetenceID string    `validate:"required,uuid4"`
+	WorkDate     time.Time `validate:"required"`
+	Hours        int       `validate:"gte=0"`
+	CreatedAt    time.Time `validate:"-"`
+	UpdatedAt    time.Time `validate:"-"`
+}
+
+type RestoreTimesheetProps Timesheet
+
+type NewTimesheetProps struct {
+	PortfolioID  string
+	CompetenceID string
+	Year         int
+	Month        time.Month
+	Hours        int
+}
+
+var ErrTimesheetCompetenceNotInPortfolio = errors.New("the competence has no workload in the portfolio")
+
+func NewTimesheet(props NewTimesheetProps) *Timesheet {
+	return &Timesheet{
+		TimesheetID:  uuid.NewString(),
+		PortfolioID:  props.PortfolioID,
+		CompetenceID: props.CompetenceID,
+		WorkDate:     time.Date(props.Year, props.Month, 1, 0, 0, 0, 0, time.UTC),
+		Hours:        props.Hours,
+	}
+}
+
+func RestoreTimesheet(props RestoreTimesheetProps) *Timesheet {
+	return &Timesheet{
+		TimesheetID:  props.TimesheetID,
+		PortfolioID:  props.PortfolioID,
+		CompetenceID: props.CompetenceID,
+		WorkDate:     props.WorkDate,
+		Hours:        props.Hours,
+		CreatedAt:    props.CreatedAt,
+		UpdatedAt:    props.UpdatedAt,
+	}
+}
+
+func (t *Timesheet) ChangeHours(hours int) {
+	t.Hours = hours
+}
+
+// IsFor tells whether the timesheet holds the hours of the competence in the month
+func (t *Timesheet) IsFor(competenceID string, year int, month time.Month) bool {
+	return t.CompetenceID == competenceID && t.WorkDate.Year() == year && t.WorkDate.Month() == month
+}
+
+func (t *Timesheet) Validate() error {
+	err := common.Validate.Struct(t)
+	if err != nil {
+		return common.NewDomainValidationError(fmt.Errorf("timesheet domain validation failed: %w", err))
+	}
+	return nil
+}
+
+// ValidateEffort checks that the competence of the timesheet has an effort in the
+// baseline of the portfolio, which is what its workloads are generated from
+func (t *Timesheet) ValidateEffort(efforts []*Effort) error {
+	if slices.ContainsFunc(efforts, func(e *Effort) bool { return e.CompetenceID == t.CompetenceID }) {
+		return nil
+	}
+	return common.NewDomainValidationError(fmt.Errorf("%w: %s", ErrTimesheetCompetenceNotInPortfolio, t.CompetenceID))
+}
@@ -0,0 +1,64 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/celsopires1999/estimation/internal/domain"
+	"github.com/celsopires1999/estimation/internal/testutils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitTimesheet(t *testing.T) {
+	effort := testutils.NewEffortFakeBuilder().Build()
+
+	newTimesheet := func(competenceID string, hours int) *domain.Timesheet {
+		return domain.NewTimesheet(domain.NewTimesheetProps{
+			PortfolioID:  uuid.NewString(),
+			CompetenceID: competenceID,
+			Year:         2025,
+			Month:        time.March,
+			Hours:        hours,
+		})
+	}
+
+	t.Run("should keep the hours in the first day of the month", func(t *testing.T) {
+		timesheet := newTimesheet(effort.CompetenceID, 80)
+
+		assert.NoError(t, timesheet.Validate())
+		assert.Equal(t, time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC), timesheet.WorkDate)
+		assert.True(t, timesheet.IsFor(effort.CompetenceID, 2025, time.March))
+		assert.False(t, timesheet.IsFor(effort.CompetenceID, 2025, time.April))
+	})
+
+	t.Run("should reject negative hours", func(t *testing.T) {
+		err := newTimesheet(effort.CompetenceID, -1).Validate()
+
+		var errDomainValidation *common.DomainValidationError
+		assert.True(t, errors.As(err, &errDomainValidation))
+	})
+
+	t.Run("should accept a competence with effort in the baseline", func(t *testing.T) {
+		timesheet := newTimesheet(effort.CompetenceID, 80)
+
+		assert.NoError(t, timesheet.ValidateEffort([]*domain.Effort{effort}))
+	})
+
+	t.Run("should reject a competence without effort in the baseline", func(t *testing.T) {
+		err := newTimesheet(uuid.NewString(), 80).ValidateEffort([]*domain.Effort{effort})
+
+		var errDomainValidation *common.DomainValidationError
+		assert.True(t, errors.As(err, &errDomainValidation))
+		assert.ErrorContains(t, err, domain.ErrTimesheetCompetenceNotInPortfolio.Error())
+	})
+
+	t.Run("should change the hours", func(t *testing.T) {
+		timesheet := newTimesheet(effort.CompetenceID, 80)
+		timesheet.ChangeHours(96)
+
+		assert.Equal(t, 96, timesheet.Hours)
+	})
+}
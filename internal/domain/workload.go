@@ -13,6 +13,7 @@ type Workload struct {
 	PortfolioID         string
 	EffortID            string
 	Hours               int
+	Amount              common.Money
 	WorkloadAllocations []WorkloadAllocation
 	CreatedAt           time.Time
 	UpdatedAt           time.Time
@@ -20,15 +21,17 @@ type Workload struct {
 
 type RestoreWorkloadProps Workload
 type NewWorkloadAllocationProps struct {
-	Year  int
-	Month time.Month
-	Hours int
+	Year   int
+	Month  time.Month
+	Hours  int
+	Amount common.Money
 }
 
 type NewWorkloadProps struct {
 	PortfolioID         string
 	EffortID            string
 	Hours               int
+	Amount              common.Money
 	WorkloadAllocations []NewWorkloadAllocationProps
 }
 
@@ -39,6 +42,7 @@ func NewWorkload(props NewWorkloadProps) *Workload {
 		PortfolioID:         props.PortfolioID,
 		EffortID:            props.EffortID,
 		Hours:               props.Hours,
+		Amount:              props.Amount,
 		WorkloadAllocations: workloadAllocations,
 	}
 }
@@ -47,7 +51,7 @@ func createWorkloadAllocation(params []NewWorkloadAllocationProps) []WorkloadAll
 	workloadAllocations := make([]WorkloadAllocation, len(params))
 
 	for i, v := range params {
-		workloadAllocations[i] = NewWorkloadAllocation(v.Year, v.Month, v.Hours)
+		workloadAllocations[i] = NewWorkloadAllocation(v.Year, v.Month, v.Hours, v.Amount)
 	}
 
 	return workloadAllocations
@@ -59,6 +63,7 @@ func RestoreWorkload(props RestoreWorkloadProps) *Workload {
 		PortfolioID:         props.PortfolioID,
 		EffortID:            props.EffortID,
 		Hours:               props.Hours,
+		Amount:              props.Amount,
 		WorkloadAllocations: props.WorkloadAllocations,
 		CreatedAt:           props.CreatedAt,
 		UpdatedAt:           props.UpdatedAt,
@@ -71,12 +76,17 @@ func (w *Workload) Validate() error {
 	}
 
 	total := 0
+	amount := common.ZeroMoney
 	for _, v := range w.WorkloadAllocations {
 		total += v.Hours
+		amount = amount.Add(v.Amount)
 	}
 	if total != w.Hours {
 		return common.NewDomainValidationError(fmt.Errorf("workload allocation total %d is not equal to workload hours %d", total, w.Hours))
 	}
+	if !amount.Equal(w.Amount) {
+		return common.NewDomainValidationError(fmt.Errorf("workload allocation amount %s is not equal to workload amount %s", amount, w.Amount))
+	}
 
 	return nil
 }
@@ -88,12 +98,14 @@ func (w *Workload) GetWorloadAllocation() []WorkloadAllocation {
 type WorkloadAllocation struct {
 	AllocationDate time.Time
 	Hours          int
+	Amount         common.Money
 }
 
-func NewWorkloadAllocation(year int, month time.Month, hours int) WorkloadAllocation {
+func NewWorkloadAllocation(year int, month time.Month, hours int, amount common.Money) WorkloadAllocation {
 	date := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
 	return WorkloadAllocation{
 		AllocationDate: date,
 		Hours:          hours,
+		Amount:         amount,
 	}
 }
@@ -12,7 +12,7 @@ import (
 )
 
 const deleteBaseline = `-- name: DeleteBaseline :one
-DELETE FROM baselines WHERE baseline_id = $1 RETURNING baseline_id, code, review, title, description, start_date, duration, manager_id, estimator_id, created_at, updated_at
+DELETE FROM baselines WHERE baseline_id = $1 RETURNING baseline_id, code, review, title, description, start_date, duration, manager_id, estimator_id, created_at, updated_at, status
 `
 
 func (q *Queries) DeleteBaseline(ctx context.Context, baselineID string) (Baseline, error) {
@@ -30,12 +30,13 @@ func (q *Queries) DeleteBaseline(ctx context.Context, baselineID string) (Baseli
 		&i.EstimatorID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Status,
 	)
 	return i, err
 }
 
 const findAllBaselines = `-- name: FindAllBaselines :many
-SELECT baselines.baseline_id, baselines.code, baselines.review, baselines.title, baselines.description, baselines.start_date, baselines.duration, baselines.manager_id, baselines.estimator_id, baselines.created_at, baselines.updated_at, managers.name AS manager, estimators.name AS estimator
+SELECT baselines.baseline_id, baselines.code, baselines.review, baselines.title, baselines.description, baselines.start_date, baselines.duration, baselines.manager_id, baselines.estimator_id, baselines.created_at, baselines.updated_at, baselines.status, managers.name AS manager, estimators.name AS estimator
 FROM
     baselines
     INNER JOIN users AS managers ON managers.user_id = baselines.manager_id
@@ -55,6 +56,7 @@ type FindAllBaselinesRow struct {
 	EstimatorID string
 	CreatedAt   pgtype.Timestamp
 	UpdatedAt   pgtype.Timestamp
+	Status      string
 	Manager     string
 	Estimator   string
 }
@@ -80,6 +82,7 @@ func (q *Queries) FindAllBaselines(ctx context.Context) ([]FindAllBaselinesRow,
 			&i.EstimatorID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Status,
 			&i.Manager,
 			&i.Estimator,
 		); err != nil {
@@ -94,7 +97,7 @@ func (q *Queries) FindAllBaselines(ctx context.Context) ([]FindAllBaselinesRow,
 }
 
 const findBaselineById = `-- name: FindBaselineById :one
-SELECT baseline_id, code, review, title, description, start_date, duration, manager_id, estimator_id, created_at, updated_at FROM baselines WHERE baseline_id = $1
+SELECT baseline_id, code, review, title, description, start_date, duration, manager_id, estimator_id, created_at, updated_at, status FROM baselines WHERE baseline_id = $1
 `
 
 func (q *Queries) FindBaselineById(ctx context.Context, baselineID string) (Baseline, error) {
@@ -112,12 +115,13 @@ func (q *Queries) FindBaselineById(ctx context.Context, baselineID string) (Base
 		&i.EstimatorID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Status,
 	)
 	return i, err
 }
 
 const findBaselineByIdWithRelations = `-- name: FindBaselineByIdWithRelations :one
-SELECT baselines.baseline_id, baselines.code, baselines.review, baselines.title, baselines.description, baselines.start_date, baselines.duration, baselines.manager_id, baselines.estimator_id, baselines.created_at, baselines.updated_at, managers.name AS manager, estimators.name AS estimator
+SELECT baselines.baseline_id, baselines.code, baselines.review, baselines.title, baselines.description, baselines.start_date, baselines.duration, baselines.manager_id, baselines.estimator_id, baselines.created_at, baselines.updated_at, baselines.status, managers.name AS manager, estimators.name AS estimator
 FROM
     baselines
     INNER JOIN users AS managers ON managers.user_id = baselines.manager_id
@@ -138,6 +142,7 @@ type FindBaselineByIdWithRelationsRow struct {
 	EstimatorID string
 	CreatedAt   pgtype.Timestamp
 	UpdatedAt   pgtype.Timestamp
+	Status      string
 	Manager     string
 	Estimator   string
 }
@@ -157,12 +162,110 @@ func (q *Queries) FindBaselineByIdWithRelations(ctx context.Context, baselineID
 		&i.EstimatorID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Status,
 		&i.Manager,
 		&i.Estimator,
 	)
 	return i, err
 }
 
+const findBaselineTransitionsByBaselineId = `-- name: FindBaselineTransitionsByBaselineId :many
+SELECT
+    bt.transition_id AS transition_id,
+    bt.baseline_id AS baseline_id,
+    bt.from_status AS from_status,
+    bt.to_status AS to_status,
+    bt.user_id AS user_id,
+    us.name AS user_name,
+    us.user_type AS user_type,
+    bt.comment AS comment,
+    bt.created_at AS created_at
+FROM baseline_transitions AS bt
+    INNER JOIN users AS us ON us.user_id = bt.user_id
+WHERE
+    bt.baseline_id = $1
+ORDER BY bt.created_at ASC
+`
+
+type FindBaselineTransitionsByBaselineIdRow struct {
+	TransitionID string
+	BaselineID   string
+	FromStatus   string
+	ToStatus     string
+	UserID       string
+	UserName     string
+	UserType     string
+	Comment      pgtype.Text
+	CreatedAt    pgtype.Timestamp
+}
+
+func (q *Queries) FindBaselineTransitionsByBaselineId(ctx context.Context, baselineID string) ([]FindBaselineTransitionsByBaselineIdRow, error) {
+	rows, err := q.db.Query(ctx, findBaselineTransitionsByBaselineId, baselineID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FindBaselineTransitionsByBaselineIdRow
+	for rows.Next() {
+		var i FindBaselineTransitionsByBaselineIdRow
+		if err := rows.Scan(
+			&i.TransitionID,
+			&i.BaselineID,
+			&i.FromStatus,
+			&i.ToStatus,
+			&i.UserID,
+			&i.UserName,
+			&i.UserType,
+			&i.Comment,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findBaselinesByCode = `-- name: FindBaselinesByCode :many
+SELECT baseline_id, code, review, title, description, start_date, duration, manager_id, estimator_id, created_at, updated_at, status FROM baselines WHERE code = $1 ORDER BY review ASC
+`
+
+func (q *Queries) FindBaselinesByCode(ctx context.Context, code string) ([]Baseline, error) {
+	rows, err := q.db.Query(ctx, findBaselinesByCode, code)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Baseline
+	for rows.Next() {
+		var i Baseline
+		if err := rows.Scan(
+			&i.BaselineID,
+			&i.Code,
+			&i.Review,
+			&i.Title,
+			&i.Description,
+			&i.StartDate,
+			&i.Duration,
+			&i.ManagerID,
+			&i.EstimatorID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Status,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const insertBaseline = `-- name: InsertBaseline :exec
 INSERT INTO
     baselines (
@@ -175,6 +278,7 @@ INSERT INTO
         duration,
         manager_id,
         estimator_id,
+        status,
         created_at
     )
 VALUES (
@@ -187,7 +291,8 @@ VALUES (
         $7,
         $8,
         $9,
-        $10
+        $10,
+        $11
     )
 `
 
@@ -201,6 +306,7 @@ type InsertBaselineParams struct {
 	Duration    int32
 	ManagerID   string
 	EstimatorID string
+	Status      string
 	CreatedAt   pgtype.Timestamp
 }
 
@@ -215,6 +321,44 @@ func (q *Queries) InsertBaseline(ctx context.Context, arg InsertBaselineParams)
 		arg.Duration,
 		arg.ManagerID,
 		arg.EstimatorID,
+		arg.Status,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const insertBaselineTransition = `-- name: InsertBaselineTransition :exec
+INSERT INTO
+    baseline_transitions (
+        transition_id,
+        baseline_id,
+        from_status,
+        to_status,
+        user_id,
+        comment,
+        created_at
+    )
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+`
+
+type InsertBaselineTransitionParams struct {
+	TransitionID string
+	BaselineID   string
+	FromStatus   string
+	ToStatus     string
+	UserID       string
+	Comment      pgtype.Text
+	CreatedAt    pgtype.Timestamp
+}
+
+func (q *Queries) InsertBaselineTransition(ctx context.Context, arg InsertBaselineTransitionParams) error {
+	_, err := q.db.Exec(ctx, insertBaselineTransition,
+		arg.TransitionID,
+		arg.BaselineID,
+		arg.FromStatus,
+		arg.ToStatus,
+		arg.UserID,
+		arg.Comment,
 		arg.CreatedAt,
 	)
 	return err
@@ -264,3 +408,27 @@ func (q *Queries) UpdateBaseline(ctx context.Context, arg UpdateBaselineParams)
 	)
 	return err
 }
+
+const updateBaselineStatus = `-- name: UpdateBaselineStatus :exec
+UPDATE baselines
+SET
+    status = $2,
+    updated_at = $3
+WHERE
+    baseline_id = $1
+`
+
+type UpdateBaselineStatusParams struct {
+	BaselineID string
+	Status     string
+	UpdatedAt  pgtype.Timestamp
+}
+
+func (q *Queries) UpdateBaselineStatus(ctx context.Context, arg UpdateBaselineStatusParams) error {
+	_, err := q.db.Exec(ctx, updateBaselineStatus,
+		arg.BaselineID,
+		arg.Status,
+		arg.UpdatedAt,
+	)
+	return err
+}
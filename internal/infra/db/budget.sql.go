@@ -8,6 +8,8 @@ package db
 import (
 	"context"
 
+	common "github.com/celsopires1999/estimation/internal/common"
+	domain "github.com/celsopires1999/estimation/internal/domain"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
@@ -18,14 +20,16 @@ INSERT INTO
         portfolio_id,
         cost_id,
         amount,
-        created_at
+        created_at,
+        taxes
     )
 VALUES (
         unnest($1::text []),
         unnest($2::text []),
         unnest($3::text []),
-        unnest($4::float8[]),
-        unnest($5::timestamp[])
+        unnest($4::numeric[]),
+        unnest($5::timestamp[]),
+        unnest($6::jsonb[])
     )
 `
 
@@ -33,8 +37,9 @@ type BulkInsertBudgetParams struct {
 	Column1 []string
 	Column2 []string
 	Column3 []string
-	Column4 []float64
+	Column4 []common.Money
 	Column5 []pgtype.Timestamp
+	Column6 [][]byte
 }
 
 func (q *Queries) BulkInsertBudget(ctx context.Context, arg BulkInsertBudgetParams) error {
@@ -44,6 +49,7 @@ func (q *Queries) BulkInsertBudget(ctx context.Context, arg BulkInsertBudgetPara
 		arg.Column3,
 		arg.Column4,
 		arg.Column5,
+		arg.Column6,
 	)
 	return err
 }
@@ -61,7 +67,7 @@ VALUES (
         unnest($1::text []),
         unnest($2::text []),
         unnest($3::date[]),
-        unnest($4::float8[]),
+        unnest($4::numeric[]),
         unnest($5::timestamp[])
     )
 `
@@ -70,7 +76,7 @@ type BulkInsertBudgetAllocationParams struct {
 	Column1 []string
 	Column2 []string
 	Column3 []pgtype.Date
-	Column4 []float64
+	Column4 []common.Money
 	Column5 []pgtype.Timestamp
 }
 
@@ -86,7 +92,7 @@ func (q *Queries) BulkInsertBudgetAllocation(ctx context.Context, arg BulkInsert
 }
 
 const deleteBudget = `-- name: DeleteBudget :execrows
-DELETE FROM budgets WHERE budget_id = $1 RETURNING budget_id, portfolio_id, cost_id, amount, created_at, updated_at
+DELETE FROM budgets WHERE budget_id = $1 RETURNING budget_id, portfolio_id, cost_id, amount, created_at, updated_at, taxes
 `
 
 func (q *Queries) DeleteBudget(ctx context.Context, budgetID string) (int64, error) {
@@ -160,7 +166,7 @@ const findBudgetAllocationsGroupedByYear = `-- name: FindBudgetAllocationsGroupe
 SELECT EXTRACT(
         YEAR
         FROM budget_allocations.allocation_date
-    )::int AS year, SUM(budget_allocations.amount)::float8 AS amount
+    )::int AS year, SUM(budget_allocations.amount)::numeric AS amount
 FROM budget_allocations
 WHERE
     budget_id = $1
@@ -171,7 +177,7 @@ ORDER BY year ASC
 
 type FindBudgetAllocationsGroupedByYearRow struct {
 	Year   int32
-	Amount float64
+	Amount common.Money
 }
 
 func (q *Queries) FindBudgetAllocationsGroupedByYear(ctx context.Context, budgetID string) ([]FindBudgetAllocationsGroupedByYearRow, error) {
@@ -195,7 +201,7 @@ func (q *Queries) FindBudgetAllocationsGroupedByYear(ctx context.Context, budget
 }
 
 const findBudgetById = `-- name: FindBudgetById :one
-SELECT budget_id, portfolio_id, cost_id, amount, created_at, updated_at FROM budgets WHERE budget_id = $1
+SELECT budget_id, portfolio_id, cost_id, amount, created_at, updated_at, taxes FROM budgets WHERE budget_id = $1
 `
 
 func (q *Queries) FindBudgetById(ctx context.Context, budgetID string) (Budget, error) {
@@ -208,12 +214,13 @@ func (q *Queries) FindBudgetById(ctx context.Context, budgetID string) (Budget,
 		&i.Amount,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Taxes,
 	)
 	return i, err
 }
 
 const findBudgetsByPortfolioId = `-- name: FindBudgetsByPortfolioId :many
-SELECT budget_id, portfolio_id, cost_id, amount, created_at, updated_at FROM budgets WHERE portfolio_id = $1
+SELECT budget_id, portfolio_id, cost_id, amount, created_at, updated_at, taxes FROM budgets WHERE portfolio_id = $1
 `
 
 func (q *Queries) FindBudgetsByPortfolioId(ctx context.Context, portfolioID string) ([]Budget, error) {
@@ -232,6 +239,7 @@ func (q *Queries) FindBudgetsByPortfolioId(ctx context.Context, portfolioID stri
 			&i.Amount,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Taxes,
 		); err != nil {
 			return nil, err
 		}
@@ -256,9 +264,12 @@ SELECT
     co.apply_inflation AS cost_apply_inflation,
     bu.amount AS amount,
     bu.created_at AS created_at,
-    bu.updated_at AS updated_at
+    bu.updated_at AS updated_at,
+    tp.name AS cost_tax_profile,
+    bu.taxes AS taxes
 FROM budgets AS bu
     INNER JOIN costs AS co ON bu.cost_id = co.cost_id
+    LEFT JOIN tax_profiles AS tp ON co.tax_profile_id = tp.tax_profile_id
 WHERE
     bu.portfolio_id = $1
 ORDER BY co.cost_type, co.description
@@ -270,13 +281,15 @@ type FindBudgetsByPortfolioIdWithRelationsRow struct {
 	CostType           string
 	Description        string
 	Comment            pgtype.Text
-	CostAmount         float64
+	CostAmount         common.Money
 	CostCurrency       string
 	CostTax            float64
 	CostApplyInflation bool
-	Amount             float64
+	Amount             common.Money
 	CreatedAt          pgtype.Timestamp
 	UpdatedAt          pgtype.Timestamp
+	CostTaxProfile     pgtype.Text
+	Taxes              domain.BudgetTaxes
 }
 
 func (q *Queries) FindBudgetsByPortfolioIdWithRelations(ctx context.Context, portfolioID string) ([]FindBudgetsByPortfolioIdWithRelationsRow, error) {
@@ -301,6 +314,8 @@ func (q *Queries) FindBudgetsByPortfolioIdWithRelations(ctx context.Context, por
 			&i.Amount,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.CostTaxProfile,
+			&i.Taxes,
 		); err != nil {
 			return nil, err
 		}
@@ -319,17 +334,19 @@ INSERT INTO
         portfolio_id,
         cost_id,
         amount,
-        created_at
+        created_at,
+        taxes
     )
-VALUES ($1, $2, $3, $4, $5)
+VALUES ($1, $2, $3, $4, $5, $6)
 `
 
 type InsertBudgetParams struct {
 	BudgetID    string
 	PortfolioID string
 	CostID      string
-	Amount      float64
+	Amount      common.Money
 	CreatedAt   pgtype.Timestamp
+	Taxes       domain.BudgetTaxes
 }
 
 func (q *Queries) InsertBudget(ctx context.Context, arg InsertBudgetParams) error {
@@ -339,6 +356,7 @@ func (q *Queries) InsertBudget(ctx context.Context, arg InsertBudgetParams) erro
 		arg.CostID,
 		arg.Amount,
 		arg.CreatedAt,
+		arg.Taxes,
 	)
 	return err
 }
@@ -358,7 +376,7 @@ VALUES ($1, $2, $3, $4, $5)
 type InsertBudgetAllocationParams struct {
 	BudgetAllocationID string
 	BudgetID           string
-	Amount             float64
+	Amount             common.Money
 	AllocationDate     pgtype.Date
 	CreatedAt          pgtype.Timestamp
 }
@@ -380,7 +398,8 @@ SET
     portfolio_id = $2,
     cost_id = $3,
     amount = $4,
-    updated_at = $5
+    updated_at = $5,
+    taxes = $6
 WHERE
     budget_id = $1
 `
@@ -389,8 +408,9 @@ type UpdateBudgetParams struct {
 	BudgetID    string
 	PortfolioID string
 	CostID      string
-	Amount      float64
+	Amount      common.Money
 	UpdatedAt   pgtype.Timestamp
+	Taxes       domain.BudgetTaxes
 }
 
 func (q *Queries) UpdateBudget(ctx context.Context, arg UpdateBudgetParams) error {
@@ -400,6 +420,7 @@ func (q *Queries) UpdateBudget(ctx context.Context, arg UpdateBudgetParams) erro
 		arg.CostID,
 		arg.Amount,
 		arg.UpdatedAt,
+		arg.Taxes,
 	)
 	return err
 }
@@ -53,6 +53,23 @@ func (q *Queries) FindAllCompetences(ctx context.Context) ([]Competence, error)
 	return items, nil
 }
 
+const findCompetenceByCode = `-- name: FindCompetenceByCode :one
+SELECT competence_id, code, name, created_at, updated_at FROM competences WHERE code = $1
+`
+
+func (q *Queries) FindCompetenceByCode(ctx context.Context, code string) (Competence, error) {
+	row := q.db.QueryRow(ctx, findCompetenceByCode, code)
+	var i Competence
+	err := row.Scan(
+		&i.CompetenceID,
+		&i.Code,
+		&i.Name,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const findCompetenceById = `-- name: FindCompetenceById :one
 SELECT competence_id, code, name, created_at, updated_at FROM competences WHERE competence_id = $1
 `
@@ -8,6 +8,8 @@ package db
 import (
 	"context"
 
+	common "github.com/celsopires1999/estimation/internal/common"
+	domain "github.com/celsopires1999/estimation/internal/domain"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
@@ -23,7 +25,9 @@ INSERT INTO
         currency,
         tax,
         apply_inflation,
-        created_at
+        created_at,
+        tax_profile_id,
+        recurrence
     )
 VALUES (
         unnest($1::text []),
@@ -31,11 +35,13 @@ VALUES (
         unnest($3::text []),
         unnest($4::text []),
         unnest($5::text []),
-        unnest($6::float8[]),
+        unnest($6::numeric[]),
         unnest($7::text []),
         unnest($8::float8[]),
         unnest($9::boolean[]),
-        unnest($10::timestamp[])
+        unnest($10::timestamp[]),
+        NULLIF(unnest($11::text []), ''),
+        unnest($12::jsonb[])
     )
 `
 
@@ -45,11 +51,13 @@ type BulkInsertCostParams struct {
 	Column3  []string
 	Column4  []string
 	Column5  []string
-	Column6  []float64
+	Column6  []common.Money
 	Column7  []string
 	Column8  []float64
 	Column9  []bool
 	Column10 []pgtype.Timestamp
+	Column11 []string
+	Column12 [][]byte
 }
 
 func (q *Queries) BulkInsertCost(ctx context.Context, arg BulkInsertCostParams) error {
@@ -64,6 +72,8 @@ func (q *Queries) BulkInsertCost(ctx context.Context, arg BulkInsertCostParams)
 		arg.Column8,
 		arg.Column9,
 		arg.Column10,
+		arg.Column11,
+		arg.Column12,
 	)
 	return err
 }
@@ -81,7 +91,7 @@ VALUES (
         unnest($1::text []),
         unnest($2::text []),
         unnest($3::date[]),
-        unnest($4::float8[]),
+        unnest($4::numeric[]),
         unnest($5::timestamp[])
     )
 `
@@ -90,7 +100,7 @@ type BulkInsertCostAllocationParams struct {
 	Column1 []string
 	Column2 []string
 	Column3 []pgtype.Date
-	Column4 []float64
+	Column4 []common.Money
 	Column5 []pgtype.Timestamp
 }
 
@@ -106,7 +116,7 @@ func (q *Queries) BulkInsertCostAllocation(ctx context.Context, arg BulkInsertCo
 }
 
 const deleteCost = `-- name: DeleteCost :one
-DELETE FROM costs WHERE cost_id = $1 RETURNING cost_id, baseline_id, cost_type, description, comment, amount, currency, tax, apply_inflation, created_at, updated_at
+DELETE FROM costs WHERE cost_id = $1 RETURNING cost_id, baseline_id, cost_type, description, comment, amount, currency, tax, apply_inflation, created_at, updated_at, tax_profile_id, recurrence
 `
 
 func (q *Queries) DeleteCost(ctx context.Context, costID string) (Cost, error) {
@@ -124,6 +134,8 @@ func (q *Queries) DeleteCost(ctx context.Context, costID string) (Cost, error) {
 		&i.ApplyInflation,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TaxProfileID,
+		&i.Recurrence,
 	)
 	return i, err
 }
@@ -176,7 +188,7 @@ func (q *Queries) FindCostAllocationsByCostId(ctx context.Context, costID string
 }
 
 const findCostById = `-- name: FindCostById :one
-SELECT cost_id, baseline_id, cost_type, description, comment, amount, currency, tax, apply_inflation, created_at, updated_at FROM costs WHERE cost_id = $1
+SELECT cost_id, baseline_id, cost_type, description, comment, amount, currency, tax, apply_inflation, created_at, updated_at, tax_profile_id, recurrence FROM costs WHERE cost_id = $1
 `
 
 func (q *Queries) FindCostById(ctx context.Context, costID string) (Cost, error) {
@@ -194,12 +206,14 @@ func (q *Queries) FindCostById(ctx context.Context, costID string) (Cost, error)
 		&i.ApplyInflation,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TaxProfileID,
+		&i.Recurrence,
 	)
 	return i, err
 }
 
 const findCostsByBaselineId = `-- name: FindCostsByBaselineId :many
-SELECT cost_id, baseline_id, cost_type, description, comment, amount, currency, tax, apply_inflation, created_at, updated_at
+SELECT cost_id, baseline_id, cost_type, description, comment, amount, currency, tax, apply_inflation, created_at, updated_at, tax_profile_id, recurrence
 FROM costs
 WHERE
     baseline_id = $1
@@ -227,6 +241,8 @@ func (q *Queries) FindCostsByBaselineId(ctx context.Context, baselineID string)
 			&i.ApplyInflation,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.TaxProfileID,
+			&i.Recurrence,
 		); err != nil {
 			return nil, err
 		}
@@ -250,7 +266,9 @@ INSERT INTO
         currency,
         tax,
         apply_inflation,
-        created_at
+        created_at,
+        tax_profile_id,
+        recurrence
     )
 VALUES (
         $1,
@@ -262,7 +280,9 @@ VALUES (
         $7,
         $8,
         $9,
-        $10
+        $10,
+        $11,
+        $12
     )
 `
 
@@ -272,11 +292,13 @@ type InsertCostParams struct {
 	CostType       string
 	Description    string
 	Comment        pgtype.Text
-	Amount         float64
+	Amount         common.Money
 	Currency       string
 	Tax            float64
 	ApplyInflation bool
 	CreatedAt      pgtype.Timestamp
+	TaxProfileID   pgtype.Text
+	Recurrence     *domain.Recurrence
 }
 
 func (q *Queries) InsertCost(ctx context.Context, arg InsertCostParams) error {
@@ -291,6 +313,8 @@ func (q *Queries) InsertCost(ctx context.Context, arg InsertCostParams) error {
 		arg.Tax,
 		arg.ApplyInflation,
 		arg.CreatedAt,
+		arg.TaxProfileID,
+		arg.Recurrence,
 	)
 	return err
 }
@@ -311,7 +335,7 @@ type InsertCostAllocationParams struct {
 	CostAllocationID string
 	CostID           string
 	AllocationDate   pgtype.Date
-	Amount           float64
+	Amount           common.Money
 	CreatedAt        pgtype.Timestamp
 }
 
@@ -337,7 +361,9 @@ SET
     currency = $7,
     tax = $8,
     apply_inflation = $9,
-    updated_at = $10
+    updated_at = $10,
+    tax_profile_id = $11,
+    recurrence = $12
 WHERE
     cost_id = $1
 `
@@ -348,11 +374,13 @@ type UpdateCostParams struct {
 	CostType       string
 	Description    string
 	Comment        pgtype.Text
-	Amount         float64
+	Amount         common.Money
 	Currency       string
 	Tax            float64
 	ApplyInflation bool
 	UpdatedAt      pgtype.Timestamp
+	TaxProfileID   pgtype.Text
+	Recurrence     *domain.Recurrence
 }
 
 func (q *Queries) UpdateCost(ctx context.Context, arg UpdateCostParams) error {
@@ -367,6 +395,8 @@ func (q *Queries) UpdateCost(ctx context.Context, arg UpdateCostParams) error {
 		arg.Tax,
 		arg.ApplyInflation,
 		arg.UpdatedAt,
+		arg.TaxProfileID,
+		arg.Recurrence,
 	)
 	return err
 }
@@ -5,10 +5,33 @@
 package db
 
 import (
+	common "github.com/celsopires1999/estimation/internal/common"
 	domain "github.com/celsopires1999/estimation/internal/domain"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type Actual struct {
+	ActualID    string
+	PortfolioID string
+	CostID      string
+	SpendDate   pgtype.Date
+	Amount      common.Money
+	Comment     pgtype.Text
+	CreatedAt   pgtype.Timestamp
+	UpdatedAt   pgtype.Timestamp
+}
+
+type AuditLog struct {
+	AuditID   string
+	Actor     pgtype.Text
+	Action    string
+	Entity    string
+	EntityID  string
+	Before    []byte
+	After     []byte
+	CreatedAt pgtype.Timestamp
+}
+
 type Baseline struct {
 	BaselineID  string
 	Code        string
@@ -21,26 +44,49 @@ type Baseline struct {
 	EstimatorID string
 	CreatedAt   pgtype.Timestamp
 	UpdatedAt   pgtype.Timestamp
+	Status      string
+}
+
+type BaselineTransition struct {
+	TransitionID string
+	BaselineID   string
+	FromStatus   string
+	ToStatus     string
+	UserID       string
+	Comment      pgtype.Text
+	CreatedAt    pgtype.Timestamp
 }
 
 type Budget struct {
 	BudgetID    string
 	PortfolioID string
 	CostID      string
-	Amount      float64
+	Amount      common.Money
 	CreatedAt   pgtype.Timestamp
 	UpdatedAt   pgtype.Timestamp
+	Taxes       domain.BudgetTaxes
 }
 
 type BudgetAllocation struct {
 	BudgetAllocationID string
 	BudgetID           string
 	AllocationDate     pgtype.Date
-	Amount             float64
+	Amount             common.Money
 	CreatedAt          pgtype.Timestamp
 	UpdatedAt          pgtype.Timestamp
 }
 
+type Capacity struct {
+	CapacityID   string
+	PlanID       string
+	CompetenceID string
+	Year         int32
+	Month        int32
+	Hours        int32
+	CreatedAt    pgtype.Timestamp
+	UpdatedAt    pgtype.Timestamp
+}
+
 type Competence struct {
 	CompetenceID string
 	Code         string
@@ -55,23 +101,33 @@ type Cost struct {
 	CostType       string
 	Description    string
 	Comment        pgtype.Text
-	Amount         float64
+	Amount         common.Money
 	Currency       string
 	Tax            float64
 	ApplyInflation bool
 	CreatedAt      pgtype.Timestamp
 	UpdatedAt      pgtype.Timestamp
+	TaxProfileID   pgtype.Text
+	Recurrence     *domain.Recurrence
 }
 
 type CostAllocation struct {
 	CostAllocationID string
 	CostID           string
 	AllocationDate   pgtype.Date
-	Amount           float64
+	Amount           common.Money
 	CreatedAt        pgtype.Timestamp
 	UpdatedAt        pgtype.Timestamp
 }
 
+type Currency struct {
+	CurrencyID string
+	Code       string
+	Name       string
+	CreatedAt  pgtype.Timestamp
+	UpdatedAt  pgtype.Timestamp
+}
+
 type Effort struct {
 	EffortID     string
 	BaselineID   string
@@ -92,12 +148,37 @@ type EffortAllocation struct {
 }
 
 type Plan struct {
+	PlanID        string
+	Code          string
+	Name          string
+	Assumptions   domain.Assumptions
+	CreatedAt     pgtype.Timestamp
+	UpdatedAt     pgtype.Timestamp
+	InflationMode string
+}
+
+type PlanBudgetAllocation struct {
 	PlanID      string
-	Code        string
-	Name        string
-	Assumptions domain.Assumptions
-	CreatedAt   pgtype.Timestamp
-	UpdatedAt   pgtype.Timestamp
+	PortfolioID string
+	Year        int32
+	Month       int32
+	CostType    string
+	Currency    string
+	ManagerID   string
+	Manager     string
+	Amount      common.Money
+}
+
+type PlanWorkloadAllocation struct {
+	PlanID         string
+	PortfolioID    string
+	Year           int32
+	Month          int32
+	CompetenceID   string
+	CompetenceCode string
+	CompetenceName string
+	Hours          int32
+	Amount         common.Money
 }
 
 type Portfolio struct {
@@ -109,6 +190,47 @@ type Portfolio struct {
 	UpdatedAt   pgtype.Timestamp
 }
 
+type Progress struct {
+	ProgressID      string
+	PortfolioID     string
+	CostID          pgtype.Text
+	ProgressDate    pgtype.Date
+	PercentComplete float64
+	CreatedAt       pgtype.Timestamp
+	UpdatedAt       pgtype.Timestamp
+	EffortID        pgtype.Text
+}
+
+type RateCard struct {
+	RateCardID   string
+	PlanID       string
+	CompetenceID string
+	Year         int32
+	HourlyRate   common.Money
+	Currency     string
+	CreatedAt    pgtype.Timestamp
+	UpdatedAt    pgtype.Timestamp
+}
+
+type TaxProfile struct {
+	TaxProfileID string
+	Name         string
+	Description  pgtype.Text
+	Components   domain.TaxComponents
+	CreatedAt    pgtype.Timestamp
+	UpdatedAt    pgtype.Timestamp
+}
+
+type Timesheet struct {
+	TimesheetID  string
+	PortfolioID  string
+	CompetenceID string
+	WorkDate     pgtype.Date
+	Hours        int32
+	CreatedAt    pgtype.Timestamp
+	UpdatedAt    pgtype.Timestamp
+}
+
 type User struct {
 	UserID    string
 	Email     string
@@ -126,6 +248,7 @@ type Workload struct {
 	Hours       int32
 	CreatedAt   pgtype.Timestamp
 	UpdatedAt   pgtype.Timestamp
+	Amount      common.Money
 }
 
 type WorkloadAllocation struct {
@@ -135,4 +258,5 @@ type WorkloadAllocation struct {
 	Hours                int32
 	CreatedAt            pgtype.Timestamp
 	UpdatedAt            pgtype.Timestamp
+	Amount               common.Money
 }
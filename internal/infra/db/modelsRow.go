@@ -1,6 +1,10 @@
 package db
 
-import "github.com/jackc/pgx/v5/pgtype"
+import (
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/celsopires1999/estimation/internal/domain"
+	"github.com/jackc/pgx/v5/pgtype"
+)
 
 type BaselineRow struct {
 	BaselineID  string
@@ -14,6 +18,7 @@ type BaselineRow struct {
 	EstimatorID string
 	CreatedAt   pgtype.Timestamp
 	UpdatedAt   pgtype.Timestamp
+	Status      string
 	Manager     string
 	Estimator   string
 }
@@ -24,13 +29,15 @@ type BudgetRow struct {
 	CostType           string
 	Description        string
 	Comment            pgtype.Text
-	CostAmount         float64
+	CostAmount         common.Money
 	CostCurrency       string
 	CostTax            float64
 	CostApplyInflation bool
-	Amount             float64
+	Amount             common.Money
 	CreatedAt          pgtype.Timestamp
 	UpdatedAt          pgtype.Timestamp
+	CostTaxProfile     pgtype.Text
+	Taxes              domain.BudgetTaxes
 }
 
 type WorkloadRow struct {
@@ -40,6 +47,7 @@ type WorkloadRow struct {
 	CompetenceName string
 	Comment        pgtype.Text
 	Hours          int32
+	Amount         common.Money
 	CreatedAt      pgtype.Timestamp
 	UpdatedAt      pgtype.Timestamp
 }
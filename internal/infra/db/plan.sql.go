@@ -8,12 +8,13 @@ package db
 import (
 	"context"
 
+	common "github.com/celsopires1999/estimation/internal/common"
 	domain "github.com/celsopires1999/estimation/internal/domain"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const deletePlan = `-- name: DeletePlan :one
-DELETE FROM plans WHERE plan_id = $1 RETURNING plan_id, code, name, assumptions, created_at, updated_at
+DELETE FROM plans WHERE plan_id = $1 RETURNING plan_id, code, name, assumptions, created_at, updated_at, inflation_mode
 `
 
 func (q *Queries) DeletePlan(ctx context.Context, planID string) (Plan, error) {
@@ -26,12 +27,13 @@ func (q *Queries) DeletePlan(ctx context.Context, planID string) (Plan, error) {
 		&i.Assumptions,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.InflationMode,
 	)
 	return i, err
 }
 
 const findAllPlans = `-- name: FindAllPlans :many
-SELECT plan_id, code, name, assumptions, created_at, updated_at FROM plans ORDER BY code ASC
+SELECT plan_id, code, name, assumptions, created_at, updated_at, inflation_mode FROM plans ORDER BY code ASC
 `
 
 func (q *Queries) FindAllPlans(ctx context.Context) ([]Plan, error) {
@@ -50,6 +52,217 @@ func (q *Queries) FindAllPlans(ctx context.Context) ([]Plan, error) {
 			&i.Assumptions,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.InflationMode,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findPlanAllocationsGroupedByMonth = `-- name: FindPlanAllocationsGroupedByMonth :many
+SELECT
+    al.year AS year,
+    COALESCE(al.month, 0)::int AS month,
+    SUM(al.budget_amount)::numeric AS budget_amount,
+    SUM(al.labor_amount)::numeric AS labor_amount,
+    SUM(al.budget_amount + al.labor_amount)::numeric AS total_amount,
+    SUM(al.hours)::int AS hours
+FROM (
+        SELECT pb.year, pb.month, pb.amount AS budget_amount, 0 AS labor_amount, 0 AS hours
+        FROM plan_budget_allocations AS pb
+        WHERE
+            pb.plan_id = $1
+        UNION ALL
+        SELECT pw.year, pw.month, 0, pw.amount, pw.hours
+        FROM plan_workload_allocations AS pw
+        WHERE
+            pw.plan_id = $1
+    ) AS al
+GROUP BY
+    GROUPING SETS ((al.year, al.month), (al.year))
+ORDER BY year, month;
+`
+
+type FindPlanAllocationsGroupedByMonthRow struct {
+	Year         int32
+	Month        int32
+	BudgetAmount common.Money
+	LaborAmount  common.Money
+	TotalAmount  common.Money
+	Hours        int32
+}
+
+func (q *Queries) FindPlanAllocationsGroupedByMonth(ctx context.Context, planID string) ([]FindPlanAllocationsGroupedByMonthRow, error) {
+	rows, err := q.db.Query(ctx, findPlanAllocationsGroupedByMonth, planID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FindPlanAllocationsGroupedByMonthRow
+	for rows.Next() {
+		var i FindPlanAllocationsGroupedByMonthRow
+		if err := rows.Scan(
+			&i.Year,
+			&i.Month,
+			&i.BudgetAmount,
+			&i.LaborAmount,
+			&i.TotalAmount,
+			&i.Hours,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findPlanBudgetsGroupedByCostType = `-- name: FindPlanBudgetsGroupedByCostType :many
+SELECT
+    pb.year AS year,
+    COALESCE(pb.month, 0)::int AS month,
+    pb.cost_type AS cost_type,
+    SUM(pb.amount)::numeric AS amount
+FROM plan_budget_allocations AS pb
+WHERE
+    pb.plan_id = $1
+GROUP BY
+    GROUPING SETS (
+        (pb.year, pb.month, pb.cost_type),
+        (pb.year, pb.cost_type)
+    )
+ORDER BY year, month, cost_type;
+`
+
+type FindPlanBudgetsGroupedByCostTypeRow struct {
+	Year     int32
+	Month    int32
+	CostType string
+	Amount   common.Money
+}
+
+func (q *Queries) FindPlanBudgetsGroupedByCostType(ctx context.Context, planID string) ([]FindPlanBudgetsGroupedByCostTypeRow, error) {
+	rows, err := q.db.Query(ctx, findPlanBudgetsGroupedByCostType, planID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FindPlanBudgetsGroupedByCostTypeRow
+	for rows.Next() {
+		var i FindPlanBudgetsGroupedByCostTypeRow
+		if err := rows.Scan(
+			&i.Year,
+			&i.Month,
+			&i.CostType,
+			&i.Amount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findPlanBudgetsGroupedByCurrency = `-- name: FindPlanBudgetsGroupedByCurrency :many
+SELECT
+    pb.year AS year,
+    COALESCE(pb.month, 0)::int AS month,
+    pb.currency AS currency,
+    SUM(pb.amount)::numeric AS amount
+FROM plan_budget_allocations AS pb
+WHERE
+    pb.plan_id = $1
+GROUP BY
+    GROUPING SETS (
+        (pb.year, pb.month, pb.currency),
+        (pb.year, pb.currency)
+    )
+ORDER BY year, month, currency;
+`
+
+type FindPlanBudgetsGroupedByCurrencyRow struct {
+	Year     int32
+	Month    int32
+	Currency string
+	Amount   common.Money
+}
+
+func (q *Queries) FindPlanBudgetsGroupedByCurrency(ctx context.Context, planID string) ([]FindPlanBudgetsGroupedByCurrencyRow, error) {
+	rows, err := q.db.Query(ctx, findPlanBudgetsGroupedByCurrency, planID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FindPlanBudgetsGroupedByCurrencyRow
+	for rows.Next() {
+		var i FindPlanBudgetsGroupedByCurrencyRow
+		if err := rows.Scan(
+			&i.Year,
+			&i.Month,
+			&i.Currency,
+			&i.Amount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findPlanBudgetsGroupedByManager = `-- name: FindPlanBudgetsGroupedByManager :many
+SELECT
+    pb.year AS year,
+    COALESCE(pb.month, 0)::int AS month,
+    pb.manager_id AS manager_id,
+    pb.manager AS manager,
+    SUM(pb.amount)::numeric AS amount
+FROM plan_budget_allocations AS pb
+WHERE
+    pb.plan_id = $1
+GROUP BY
+    GROUPING SETS (
+        (pb.year, pb.month, pb.manager_id, pb.manager),
+        (pb.year, pb.manager_id, pb.manager)
+    )
+ORDER BY year, month, manager;
+`
+
+type FindPlanBudgetsGroupedByManagerRow struct {
+	Year      int32
+	Month     int32
+	ManagerID string
+	Manager   string
+	Amount    common.Money
+}
+
+func (q *Queries) FindPlanBudgetsGroupedByManager(ctx context.Context, planID string) ([]FindPlanBudgetsGroupedByManagerRow, error) {
+	rows, err := q.db.Query(ctx, findPlanBudgetsGroupedByManager, planID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FindPlanBudgetsGroupedByManagerRow
+	for rows.Next() {
+		var i FindPlanBudgetsGroupedByManagerRow
+		if err := rows.Scan(
+			&i.Year,
+			&i.Month,
+			&i.ManagerID,
+			&i.Manager,
+			&i.Amount,
 		); err != nil {
 			return nil, err
 		}
@@ -62,7 +275,7 @@ func (q *Queries) FindAllPlans(ctx context.Context) ([]Plan, error) {
 }
 
 const findPlanByCode = `-- name: FindPlanByCode :one
-SELECT plan_id, code, name, assumptions, created_at, updated_at FROM plans WHERE code = $1
+SELECT plan_id, code, name, assumptions, created_at, updated_at, inflation_mode FROM plans WHERE code = $1
 `
 
 func (q *Queries) FindPlanByCode(ctx context.Context, code string) (Plan, error) {
@@ -75,12 +288,13 @@ func (q *Queries) FindPlanByCode(ctx context.Context, code string) (Plan, error)
 		&i.Assumptions,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.InflationMode,
 	)
 	return i, err
 }
 
 const findPlanById = `-- name: FindPlanById :one
-SELECT plan_id, code, name, assumptions, created_at, updated_at FROM plans WHERE plan_id = $1
+SELECT plan_id, code, name, assumptions, created_at, updated_at, inflation_mode FROM plans WHERE plan_id = $1
 `
 
 func (q *Queries) FindPlanById(ctx context.Context, planID string) (Plan, error) {
@@ -93,10 +307,69 @@ func (q *Queries) FindPlanById(ctx context.Context, planID string) (Plan, error)
 		&i.Assumptions,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.InflationMode,
 	)
 	return i, err
 }
 
+const findPlanWorkloadsGroupedByCompetence = `-- name: FindPlanWorkloadsGroupedByCompetence :many
+SELECT
+    pw.year AS year,
+    COALESCE(pw.month, 0)::int AS month,
+    pw.competence_id AS competence_id,
+    pw.competence_code AS competence_code,
+    pw.competence_name AS competence_name,
+    SUM(pw.hours)::int AS hours,
+    SUM(pw.amount)::numeric AS amount
+FROM plan_workload_allocations AS pw
+WHERE
+    pw.plan_id = $1
+GROUP BY
+    GROUPING SETS (
+        (pw.year, pw.month, pw.competence_id, pw.competence_code, pw.competence_name),
+        (pw.year, pw.competence_id, pw.competence_code, pw.competence_name)
+    )
+ORDER BY year, month, competence_code;
+`
+
+type FindPlanWorkloadsGroupedByCompetenceRow struct {
+	Year           int32
+	Month          int32
+	CompetenceID   string
+	CompetenceCode string
+	CompetenceName string
+	Hours          int32
+	Amount         common.Money
+}
+
+func (q *Queries) FindPlanWorkloadsGroupedByCompetence(ctx context.Context, planID string) ([]FindPlanWorkloadsGroupedByCompetenceRow, error) {
+	rows, err := q.db.Query(ctx, findPlanWorkloadsGroupedByCompetence, planID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FindPlanWorkloadsGroupedByCompetenceRow
+	for rows.Next() {
+		var i FindPlanWorkloadsGroupedByCompetenceRow
+		if err := rows.Scan(
+			&i.Year,
+			&i.Month,
+			&i.CompetenceID,
+			&i.CompetenceCode,
+			&i.CompetenceName,
+			&i.Hours,
+			&i.Amount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const insertPlan = `-- name: InsertPlan :exec
 INSERT INTO
     plans (
@@ -104,17 +377,19 @@ INSERT INTO
         code,
         name,
         assumptions,
+        inflation_mode,
         created_at
     )
-VALUES ($1, $2, $3, $4, $5)
+VALUES ($1, $2, $3, $4, $5, $6)
 `
 
 type InsertPlanParams struct {
-	PlanID      string
-	Code        string
-	Name        string
-	Assumptions domain.Assumptions
-	CreatedAt   pgtype.Timestamp
+	PlanID        string
+	Code          string
+	Name          string
+	Assumptions   domain.Assumptions
+	InflationMode string
+	CreatedAt     pgtype.Timestamp
 }
 
 func (q *Queries) InsertPlan(ctx context.Context, arg InsertPlanParams) error {
@@ -123,6 +398,7 @@ func (q *Queries) InsertPlan(ctx context.Context, arg InsertPlanParams) error {
 		arg.Code,
 		arg.Name,
 		arg.Assumptions,
+		arg.InflationMode,
 		arg.CreatedAt,
 	)
 	return err
@@ -134,19 +410,21 @@ SET
     code = $2,
     name = $3,
     assumptions = $4,
-    updated_at = $5
+    inflation_mode = $5,
+    updated_at = $6
 WHERE
     plan_id = $1
 RETURNING
-    plan_id, code, name, assumptions, created_at, updated_at
+    plan_id, code, name, assumptions, created_at, updated_at, inflation_mode
 `
 
 type UpdatePlanParams struct {
-	PlanID      string
-	Code        string
-	Name        string
-	Assumptions domain.Assumptions
-	UpdatedAt   pgtype.Timestamp
+	PlanID        string
+	Code          string
+	Name          string
+	Assumptions   domain.Assumptions
+	InflationMode string
+	UpdatedAt     pgtype.Timestamp
 }
 
 func (q *Queries) UpdatePlan(ctx context.Context, arg UpdatePlanParams) (Plan, error) {
@@ -155,6 +433,7 @@ func (q *Queries) UpdatePlan(ctx context.Context, arg UpdatePlanParams) (Plan, e
 		arg.Code,
 		arg.Name,
 		arg.Assumptions,
+		arg.InflationMode,
 		arg.UpdatedAt,
 	)
 	var i Plan
@@ -165,6 +444,7 @@ func (q *Queries) UpdatePlan(ctx context.Context, arg UpdatePlanParams) (Plan, e
 		&i.Assumptions,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.InflationMode,
 	)
 	return i, err
 }
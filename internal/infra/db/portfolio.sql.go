@@ -213,6 +213,37 @@ func (q *Queries) FindPortfolioById(ctx context.Context, portfolioID string) (Po
 	return i, err
 }
 
+const findPortfoliosByPlanId = `-- name: FindPortfoliosByPlanId :many
+SELECT portfolio_id, baseline_id, plan_id, start_date, created_at, updated_at FROM portfolios WHERE plan_id = $1 ORDER BY start_date
+`
+
+func (q *Queries) FindPortfoliosByPlanId(ctx context.Context, planID string) ([]Portfolio, error) {
+	rows, err := q.db.Query(ctx, findPortfoliosByPlanId, planID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Portfolio
+	for rows.Next() {
+		var i Portfolio
+		if err := rows.Scan(
+			&i.PortfolioID,
+			&i.BaselineID,
+			&i.PlanID,
+			&i.StartDate,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const findPortfolioByIdWithRelations = `-- name: FindPortfolioByIdWithRelations :one
 SELECT
     pf.portfolio_id AS portfolio_id,
@@ -8,6 +8,7 @@ package db
 import (
 	"context"
 
+	common "github.com/celsopires1999/estimation/internal/common"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
@@ -18,6 +19,7 @@ INSERT INTO
         portfolio_id,
         effort_id,
         hours,
+        amount,
         created_at
     )
 VALUES (
@@ -25,7 +27,8 @@ VALUES (
         unnest($2::text []),
         unnest($3::text []),
         unnest($4::int[]),
-        unnest($5::timestamp[])
+        unnest($5::numeric[]),
+        unnest($6::timestamp[])
     )
 `
 
@@ -34,7 +37,8 @@ type BulkInsertWorkloadParams struct {
 	Column2 []string
 	Column3 []string
 	Column4 []int32
-	Column5 []pgtype.Timestamp
+	Column5 []common.Money
+	Column6 []pgtype.Timestamp
 }
 
 func (q *Queries) BulkInsertWorkload(ctx context.Context, arg BulkInsertWorkloadParams) error {
@@ -44,6 +48,7 @@ func (q *Queries) BulkInsertWorkload(ctx context.Context, arg BulkInsertWorkload
 		arg.Column3,
 		arg.Column4,
 		arg.Column5,
+		arg.Column6,
 	)
 	return err
 }
@@ -55,6 +60,7 @@ INSERT INTO
         workload_id,
         allocation_date,
         hours,
+        amount,
         created_at
     )
 VALUES (
@@ -62,7 +68,8 @@ VALUES (
         unnest($2::text []),
         unnest($3::date[]),
         unnest($4::int[]),
-        unnest($5::timestamp[])
+        unnest($5::numeric[]),
+        unnest($6::timestamp[])
     )
 `
 
@@ -71,7 +78,8 @@ type BulkInsertWorkloadAllocationParams struct {
 	Column2 []string
 	Column3 []pgtype.Date
 	Column4 []int32
-	Column5 []pgtype.Timestamp
+	Column5 []common.Money
+	Column6 []pgtype.Timestamp
 }
 
 func (q *Queries) BulkInsertWorkloadAllocation(ctx context.Context, arg BulkInsertWorkloadAllocationParams) error {
@@ -81,12 +89,13 @@ func (q *Queries) BulkInsertWorkloadAllocation(ctx context.Context, arg BulkInse
 		arg.Column3,
 		arg.Column4,
 		arg.Column5,
+		arg.Column6,
 	)
 	return err
 }
 
 const deleteWorkload = `-- name: DeleteWorkload :execrows
-DELETE FROM workloads WHERE workload_id = $1 RETURNING workload_id, portfolio_id, effort_id, hours, created_at, updated_at
+DELETE FROM workloads WHERE workload_id = $1 RETURNING workload_id, portfolio_id, effort_id, hours, created_at, updated_at, amount
 `
 
 func (q *Queries) DeleteWorkload(ctx context.Context, workloadID string) (int64, error) {
@@ -122,7 +131,7 @@ func (q *Queries) DeleteWorkloadsByPortfolioId(ctx context.Context, portfolioID
 }
 
 const findWorkloadAllocations = `-- name: FindWorkloadAllocations :many
-SELECT workload_allocation_id, workload_id, allocation_date, hours, created_at, updated_at
+SELECT workload_allocation_id, workload_id, allocation_date, hours, created_at, updated_at, amount
 FROM workload_allocations
 WHERE
     workload_id = $1
@@ -145,6 +154,7 @@ func (q *Queries) FindWorkloadAllocations(ctx context.Context, workloadID string
 			&i.Hours,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Amount,
 		); err != nil {
 			return nil, err
 		}
@@ -195,7 +205,7 @@ func (q *Queries) FindWorkloadAllocationsGroupedByYear(ctx context.Context, work
 }
 
 const findWorkloadById = `-- name: FindWorkloadById :one
-SELECT workload_id, portfolio_id, effort_id, hours, created_at, updated_at FROM workloads WHERE workload_id = $1
+SELECT workload_id, portfolio_id, effort_id, hours, created_at, updated_at, amount FROM workloads WHERE workload_id = $1
 `
 
 func (q *Queries) FindWorkloadById(ctx context.Context, workloadID string) (Workload, error) {
@@ -208,12 +218,13 @@ func (q *Queries) FindWorkloadById(ctx context.Context, workloadID string) (Work
 		&i.Hours,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Amount,
 	)
 	return i, err
 }
 
 const findWorkloadsByPortfolioId = `-- name: FindWorkloadsByPortfolioId :many
-SELECT workload_id, portfolio_id, effort_id, hours, created_at, updated_at FROM workloads WHERE portfolio_id = $1
+SELECT workload_id, portfolio_id, effort_id, hours, created_at, updated_at, amount FROM workloads WHERE portfolio_id = $1
 `
 
 func (q *Queries) FindWorkloadsByPortfolioId(ctx context.Context, portfolioID string) ([]Workload, error) {
@@ -232,6 +243,7 @@ func (q *Queries) FindWorkloadsByPortfolioId(ctx context.Context, portfolioID st
 			&i.Hours,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Amount,
 		); err != nil {
 			return nil, err
 		}
@@ -251,6 +263,7 @@ SELECT
     c.name AS competence_name,
     e.comment AS comment,
     w.hours AS hours,
+    w.amount AS amount,
     w.created_at AS created_at,
     w.updated_at AS updated_at
 FROM
@@ -269,6 +282,7 @@ type FindWorkloadsByPortfolioIdWithRelationsRow struct {
 	CompetenceName string
 	Comment        pgtype.Text
 	Hours          int32
+	Amount         common.Money
 	CreatedAt      pgtype.Timestamp
 	UpdatedAt      pgtype.Timestamp
 }
@@ -289,6 +303,7 @@ func (q *Queries) FindWorkloadsByPortfolioIdWithRelations(ctx context.Context, p
 			&i.CompetenceName,
 			&i.Comment,
 			&i.Hours,
+			&i.Amount,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 		); err != nil {
@@ -309,9 +324,10 @@ INSERT INTO
         portfolio_id,
         effort_id,
         hours,
+        amount,
         created_at
     )
-VALUES ($1, $2, $3, $4, $5)
+VALUES ($1, $2, $3, $4, $5, $6)
 `
 
 type InsertWorkloadParams struct {
@@ -319,6 +335,7 @@ type InsertWorkloadParams struct {
 	PortfolioID string
 	EffortID    string
 	Hours       int32
+	Amount      common.Money
 	CreatedAt   pgtype.Timestamp
 }
 
@@ -328,6 +345,7 @@ func (q *Queries) InsertWorkload(ctx context.Context, arg InsertWorkloadParams)
 		arg.PortfolioID,
 		arg.EffortID,
 		arg.Hours,
+		arg.Amount,
 		arg.CreatedAt,
 	)
 	return err
@@ -339,16 +357,18 @@ INSERT INTO
         workload_allocation_id,
         workload_id,
         hours,
+        amount,
         allocation_date,
         created_at
     )
-VALUES ($1, $2, $3, $4, $5)
+VALUES ($1, $2, $3, $4, $5, $6)
 `
 
 type InsertWorkloadAllocationParams struct {
 	WorkloadAllocationID string
 	WorkloadID           string
 	Hours                int32
+	Amount               common.Money
 	AllocationDate       pgtype.Date
 	CreatedAt            pgtype.Timestamp
 }
@@ -358,6 +378,7 @@ func (q *Queries) InsertWorkloadAllocation(ctx context.Context, arg InsertWorklo
 		arg.WorkloadAllocationID,
 		arg.WorkloadID,
 		arg.Hours,
+		arg.Amount,
 		arg.AllocationDate,
 		arg.CreatedAt,
 	)
@@ -370,7 +391,8 @@ SET
     portfolio_id = $2,
     effort_id = $3,
     hours = $4,
-    updated_at = $5
+    amount = $5,
+    updated_at = $6
 WHERE
     workload_id = $1
 `
@@ -380,6 +402,7 @@ type UpdateWorkloadParams struct {
 	PortfolioID string
 	EffortID    string
 	Hours       int32
+	Amount      common.Money
 	UpdatedAt   pgtype.Timestamp
 }
 
@@ -389,6 +412,7 @@ func (q *Queries) UpdateWorkload(ctx context.Context, arg UpdateWorkloadParams)
 		arg.PortfolioID,
 		arg.EffortID,
 		arg.Hours,
+		arg.Amount,
 		arg.UpdatedAt,
 	)
 	return err
@@ -0,0 +1,170 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/celsopires1999/estimation/internal/service"
+	"github.com/celsopires1999/estimation/internal/usecase"
+)
+
+type actualsHandler struct {
+	recordActualUseCase      *usecase.RecordActualUseCase
+	importActualsUseCase     *usecase.ImportActualsUseCase
+	deleteActualUseCase      *usecase.DeleteActualUseCase
+	getBudgetVsActualUseCase *usecase.GetBudgetVsActualUseCase
+	service                  *service.EstimationService
+}
+
+func newActualsHandler(
+	recordActualUseCase *usecase.RecordActualUseCase,
+	importActualsUseCase *usecase.ImportActualsUseCase,
+	deleteActualUseCase *usecase.DeleteActualUseCase,
+	getBudgetVsActualUseCase *usecase.GetBudgetVsActualUseCase,
+	service *service.EstimationService,
+) *actualsHandler {
+	return &actualsHandler{recordActualUseCase, importActualsUseCase, deleteActualUseCase, getBudgetVsActualUseCase, service}
+}
+
+func (h *actualsHandler) recordActual(w http.ResponseWriter, r *http.Request) {
+	var input usecase.RecordActualInputDTO
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	input.PortfolioID = r.PathValue("portfolioID")
+
+	if errors := common.ValidatePayload(input); errors != nil {
+		writeValidationError(w, errors)
+		return
+	}
+
+	output, err := h.recordActualUseCase.Execute(r.Context(), input)
+	if err != nil {
+		writeDomainError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, output)
+}
+
+// importActuals records the lines of a CSV file with the columns cost_id, year, month, amount and,
+// optionally, comment
+func (h *actualsHandler) importActuals(w http.ResponseWriter, r *http.Request) {
+	file, err := uploadedCSV(w, r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	defer file.Close()
+
+	records, err := readCSV(file, "cost_id", "year", "month", "amount")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	input := usecase.ImportActualsInputDTO{
+		PortfolioID: r.PathValue("portfolioID"),
+		Actuals:     make([]usecase.ActualInputDTO, len(records)),
+	}
+	for i, record := range records {
+		input.Actuals[i], err = actualFromCSV(record)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+	}
+
+	if errors := common.ValidatePayload(input); errors != nil {
+		writeValidationError(w, errors)
+		return
+	}
+
+	output, err := h.importActualsUseCase.Execute(r.Context(), input)
+	if err != nil {
+		writeDomainError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, output)
+}
+
+func (h *actualsHandler) deleteActual(w http.ResponseWriter, r *http.Request) {
+	input := usecase.DeleteActualInputDTO{
+		ActualID:    r.PathValue("actualID"),
+		PortfolioID: r.PathValue("portfolioID"),
+	}
+
+	if errors := common.ValidatePayload(input); errors != nil {
+		writeValidationError(w, errors)
+		return
+	}
+
+	output, err := h.deleteActualUseCase.Execute(r.Context(), input)
+	if err != nil {
+		writeDomainError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusNoContent, output)
+}
+
+func (h *actualsHandler) listActuals(w http.ResponseWriter, r *http.Request) {
+	input := service.ListActualsInputDTO{
+		PortfolioID: r.PathValue("portfolioID"),
+	}
+	output, err := h.service.ListActualsByPortfolioID(r.Context(), input)
+	if err != nil {
+		writeDomainError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, output)
+}
+
+func (h *actualsHandler) getBudgetVsActual(w http.ResponseWriter, r *http.Request) {
+	input := usecase.GetBudgetVsActualInputDTO{
+		PortfolioID: r.PathValue("portfolioID"),
+	}
+
+	if errors := common.ValidatePayload(input); errors != nil {
+		writeValidationError(w, errors)
+		return
+	}
+
+	output, err := h.getBudgetVsActualUseCase.Execute(r.Context(), input)
+	if err != nil {
+		writeDomainError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, output)
+}
+
+func actualFromCSV(record csvRecord) (usecase.ActualInputDTO, error) {
+	year, err := strconv.Atoi(record.values["year"])
+	if err != nil {
+		return usecase.ActualInputDTO{}, record.errorf("invalid year %q", record.values["year"])
+	}
+
+	month, err := strconv.Atoi(record.values["month"])
+	if err != nil {
+		return usecase.ActualInputDTO{}, record.errorf("invalid month %q", record.values["month"])
+	}
+
+	amount, err := common.NewMoneyFromString(record.values["amount"])
+	if err != nil {
+		return usecase.ActualInputDTO{}, record.errorf("invalid amount %q", record.values["amount"])
+	}
+
+	return usecase.ActualInputDTO{
+		CostID:  record.values["cost_id"],
+		Year:    year,
+		Month:   month,
+		Amount:  amount,
+		Comment: record.values["comment"],
+	}, nil
+}
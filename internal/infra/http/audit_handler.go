@@ -0,0 +1,104 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/celsopires1999/estimation/internal/service"
+)
+
+type auditHandler struct {
+	service *service.EstimationService
+}
+
+func newAuditHandler(
+	service *service.EstimationService,
+) *auditHandler {
+	return &auditHandler{service}
+}
+
+// listAuditEntries filters the audit log with the entity, id and since query parameters
+// and returns the page of the limit and offset query parameters
+func (h *auditHandler) listAuditEntries(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	input := service.ListAuditEntriesInputDTO{
+		Entity:   query.Get("entity"),
+		EntityID: query.Get("id"),
+		Limit:    service.DefaultAuditLimit,
+	}
+
+	if query.Has("limit") {
+		limit, err := queryInt(query, "limit")
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		input.Limit = limit
+	}
+
+	var err error
+	if input.Offset, err = queryInt(query, "offset"); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if value := query.Get("since"); value != "" {
+		since, err := parseSince(value)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		input.Since = &since
+	}
+
+	if errors := common.ValidatePayload(input); errors != nil {
+		writeValidationError(w, errors)
+		return
+	}
+
+	output, err := h.service.ListAuditEntries(r.Context(), input)
+	if err != nil {
+		writeDomainError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, output)
+}
+
+// parseSince accepts a date and time in RFC 3339 or only a date, in the local time the audit log is recorded with
+func parseSince(value string) (time.Time, error) {
+	if since, err := time.Parse(time.RFC3339, value); err == nil {
+		return since.Local(), nil
+	}
+	since, err := time.ParseInLocation(time.DateOnly, value, time.Local)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid since %q", value)
+	}
+	return since, nil
+}
+
+// withActor puts the user ID of the X-User-ID header in the request context,
+// so the changes the request makes are recorded in the audit log with it.
+// The header is required on every request that is not a read, and a header that is not a user ID
+// is rejected before the request changes anything
+func withActor(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		actor := r.Header.Get("X-User-ID")
+		if actor == "" {
+			if r.Method != http.MethodGet && r.Method != http.MethodHead {
+				writeError(w, http.StatusBadRequest, fmt.Errorf("the X-User-ID header is required to change data"))
+				return
+			}
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if err := common.Validate.Var(actor, "uuid4"); err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("invalid X-User-ID header %q", actor))
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(common.WithActor(r.Context(), actor)))
+	})
+}
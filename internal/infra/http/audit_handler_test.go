@@ -0,0 +1,61 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitWithActor(t *testing.T) {
+	var actor string
+	handler := withActor(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		actor = common.Actor(r.Context())
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	serve := func(method string, header string) *httptest.ResponseRecorder {
+		actor = ""
+		request := httptest.NewRequest(method, "/users", nil)
+		if header != "" {
+			request.Header.Set("X-User-ID", header)
+		}
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, request)
+		return recorder
+	}
+
+	t.Run("should put the actor of the header in the context", func(t *testing.T) {
+		userID := uuid.NewString()
+
+		response := serve(http.MethodPost, userID)
+
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.Equal(t, userID, actor)
+	})
+
+	t.Run("should require the header to change data", func(t *testing.T) {
+		for _, method := range []string{http.MethodPost, http.MethodPatch, http.MethodDelete} {
+			response := serve(method, "")
+
+			assert.Equal(t, http.StatusBadRequest, response.Code)
+			assert.Contains(t, response.Body.String(), "X-User-ID header is required")
+		}
+	})
+
+	t.Run("should read without the header", func(t *testing.T) {
+		response := serve(http.MethodGet, "")
+
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.Empty(t, actor)
+	})
+
+	t.Run("should reject a header that is not a user ID", func(t *testing.T) {
+		response := serve(http.MethodGet, "someone")
+
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+	})
+}
@@ -11,22 +11,28 @@ import (
 
 type baselineHandler struct {
 	createBaselineUseCase         *usecase.CreateBaselineUseCase
+	createBaselineReviewUseCase   *usecase.CreateBaselineReviewUseCase
 	updateBaselineUseCase         *usecase.UpdateBaselineUseCase
 	deleteBaselineUseCase         *usecase.DeleteBaselineUseCase
 	getCostsByBaselineIDUseCase   *usecase.GetCostsByBaselineIDUseCase
 	getEffortsByBaselineIDUseCase *usecase.GetEffortsByBaselineIDUseCase
+	getBaselineDiffUseCase        *usecase.GetBaselineDiffUseCase
+	transitionBaselineUseCase     *usecase.TransitionBaselineUseCase
 	service                       *service.EstimationService
 }
 
 func newBaselinesHandler(
 	createBaselineUseCase *usecase.CreateBaselineUseCase,
+	createBaselineReviewUseCase *usecase.CreateBaselineReviewUseCase,
 	updateBaselineUseCase *usecase.UpdateBaselineUseCase,
 	deleteBaselineUseCase *usecase.DeleteBaselineUseCase,
 	getCostsByBaselineIDUseCase *usecase.GetCostsByBaselineIDUseCase,
 	getEffortsByBaselineIDUseCase *usecase.GetEffortsByBaselineIDUseCase,
+	getBaselineDiffUseCase *usecase.GetBaselineDiffUseCase,
+	transitionBaselineUseCase *usecase.TransitionBaselineUseCase,
 	service *service.EstimationService,
 ) *baselineHandler {
-	return &baselineHandler{createBaselineUseCase, updateBaselineUseCase, deleteBaselineUseCase, getCostsByBaselineIDUseCase, getEffortsByBaselineIDUseCase, service}
+	return &baselineHandler{createBaselineUseCase, createBaselineReviewUseCase, updateBaselineUseCase, deleteBaselineUseCase, getCostsByBaselineIDUseCase, getEffortsByBaselineIDUseCase, getBaselineDiffUseCase, transitionBaselineUseCase, service}
 }
 
 func (h *baselineHandler) createBaseline(w http.ResponseWriter, r *http.Request) {
@@ -50,6 +56,28 @@ func (h *baselineHandler) createBaseline(w http.ResponseWriter, r *http.Request)
 	writeJSON(w, http.StatusCreated, output)
 }
 
+func (h *baselineHandler) createBaselineReview(w http.ResponseWriter, r *http.Request) {
+	var input usecase.CreateBaselineReviewInputDTO
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	input.BaselineID = r.PathValue("baselineID")
+
+	if errors := common.ValidatePayload(input); errors != nil {
+		writeValidationError(w, errors)
+		return
+	}
+
+	output, err := h.createBaselineReviewUseCase.Execute(r.Context(), input)
+	if err != nil {
+		writeDomainError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, output)
+}
+
 func (h *baselineHandler) updateBaseline(w http.ResponseWriter, r *http.Request) {
 	var input usecase.UpdateBaselineInputDTO
 	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
@@ -142,3 +170,59 @@ func (h *baselineHandler) getEffortsByBaselineID(w http.ResponseWriter, r *http.
 
 	writeJSON(w, http.StatusOK, output)
 }
+
+func (h *baselineHandler) getBaselineDiff(w http.ResponseWriter, r *http.Request) {
+	input := usecase.GetBaselineDiffInputDTO{
+		BaselineID: r.PathValue("baselineID"),
+		AgainstID:  r.URL.Query().Get("against"),
+	}
+
+	if errors := common.ValidatePayload(input); errors != nil {
+		writeValidationError(w, errors)
+		return
+	}
+
+	output, err := h.getBaselineDiffUseCase.Execute(r.Context(), input)
+	if err != nil {
+		writeDomainError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, output)
+}
+
+func (h *baselineHandler) transitionBaseline(w http.ResponseWriter, r *http.Request) {
+	var input usecase.TransitionBaselineInputDTO
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	input.BaselineID = r.PathValue("baselineID")
+
+	if errors := common.ValidatePayload(input); errors != nil {
+		writeValidationError(w, errors)
+		return
+	}
+
+	output, err := h.transitionBaselineUseCase.Execute(r.Context(), input)
+	if err != nil {
+		writeDomainError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, output)
+}
+
+func (h *baselineHandler) listBaselineTransitions(w http.ResponseWriter, r *http.Request) {
+	input := service.ListBaselineTransitionsInputDTO{
+		BaselineID: r.PathValue("baselineID"),
+	}
+
+	output, err := h.service.ListBaselineTransitions(r.Context(), input)
+	if err != nil {
+		writeDomainError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, output)
+}
@@ -0,0 +1,123 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/celsopires1999/estimation/internal/service"
+	"github.com/celsopires1999/estimation/internal/usecase"
+)
+
+type capacitiesHandler struct {
+	createCapacityUseCase *usecase.CreateCapacityUseCase
+	updateCapacityUseCase *usecase.UpdateCapacityUseCase
+	deleteCapacityUseCase *usecase.DeleteCapacityUseCase
+	service               *service.EstimationService
+}
+
+func newCapacitiesHandler(
+	createCapacityUseCase *usecase.CreateCapacityUseCase,
+	updateCapacityUseCase *usecase.UpdateCapacityUseCase,
+	deleteCapacityUseCase *usecase.DeleteCapacityUseCase,
+	service *service.EstimationService,
+) *capacitiesHandler {
+	return &capacitiesHandler{createCapacityUseCase, updateCapacityUseCase, deleteCapacityUseCase, service}
+}
+
+func (h *capacitiesHandler) createCapacity(w http.ResponseWriter, r *http.Request) {
+	var input usecase.CreateCapacityInputDTO
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	input.PlanID = r.PathValue("planID")
+
+	if errors := common.ValidatePayload(input); errors != nil {
+		writeValidationError(w, errors)
+		return
+	}
+
+	output, err := h.createCapacityUseCase.Execute(r.Context(), input)
+	if err != nil {
+		writeDomainError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, output)
+}
+
+func (h *capacitiesHandler) updateCapacity(w http.ResponseWriter, r *http.Request) {
+	var input usecase.UpdateCapacityInputDTO
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	input.CapacityID = r.PathValue("capacityID")
+	input.PlanID = r.PathValue("planID")
+
+	if errors := common.ValidatePayload(input); errors != nil {
+		writeValidationError(w, errors)
+		return
+	}
+
+	output, err := h.updateCapacityUseCase.Execute(r.Context(), input)
+	if err != nil {
+		writeDomainError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, output)
+}
+
+func (h *capacitiesHandler) deleteCapacity(w http.ResponseWriter, r *http.Request) {
+	input := usecase.DeleteCapacityInputDTO{
+		CapacityID: r.PathValue("capacityID"),
+		PlanID:     r.PathValue("planID"),
+	}
+
+	if errors := common.ValidatePayload(input); errors != nil {
+		writeValidationError(w, errors)
+		return
+	}
+
+	output, err := h.deleteCapacityUseCase.Execute(r.Context(), input)
+	if err != nil {
+		writeDomainError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusNoContent, output)
+}
+
+func (h *capacitiesHandler) listCapacities(w http.ResponseWriter, r *http.Request) {
+	input := service.ListCapacitiesInputDTO{
+		PlanID: r.PathValue("planID"),
+	}
+	output, err := h.service.ListCapacitiesByPlanID(r.Context(), input)
+	if err != nil {
+		writeDomainError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, output)
+}
+
+func (h *capacitiesHandler) getCapacityReport(w http.ResponseWriter, r *http.Request) {
+	input := service.GetCapacityReportInputDTO{
+		PlanID: r.PathValue("planID"),
+	}
+
+	if errors := common.ValidatePayload(input); errors != nil {
+		writeValidationError(w, errors)
+		return
+	}
+
+	output, err := h.service.GetCapacityReport(r.Context(), input)
+	if err != nil {
+		writeDomainError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, output)
+}
@@ -0,0 +1,81 @@
+package http
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+const maxUploadSize = 10 << 20
+
+// csvRecord is a line of a CSV file with its values by column name
+type csvRecord struct {
+	line   int
+	values map[string]string
+}
+
+func (r csvRecord) errorf(format string, a ...any) error {
+	return fmt.Errorf("line %d: %s", r.line, fmt.Sprintf(format, a...))
+}
+
+// uploadedCSV returns the CSV sent as the file field of a multipart form or as the request body
+func uploadedCSV(w http.ResponseWriter, r *http.Request) (io.ReadCloser, error) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxUploadSize)
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType != "multipart/form-data" {
+		return r.Body, nil
+	}
+
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		return nil, fmt.Errorf("missing csv file: %w", err)
+	}
+	return file, nil
+}
+
+// readCSV reads the lines of a CSV file whose header has at least the required columns
+func readCSV(r io.Reader, required ...string) ([]csvRecord, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("the csv file is empty")
+		}
+		return nil, err
+	}
+	for i, column := range header {
+		header[i] = strings.ToLower(strings.TrimSpace(column))
+	}
+	for _, column := range required {
+		if !slices.Contains(header, column) {
+			return nil, fmt.Errorf("the csv header has no %s column", column)
+		}
+	}
+
+	records := make([]csvRecord, 0)
+	for line := 2; ; line++ {
+		fields, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		values := make(map[string]string, len(header))
+		for i, column := range header {
+			values[column] = strings.TrimSpace(fields[i])
+		}
+		records = append(records, csvRecord{line, values})
+	}
+
+	return records, nil
+}
@@ -0,0 +1,114 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/celsopires1999/estimation/internal/service"
+	"github.com/celsopires1999/estimation/internal/usecase"
+)
+
+type currenciesHandler struct {
+	createCurrencyUseCase *usecase.CreateCurrencyUseCase
+	updateCurrencyUseCase *usecase.UpdateCurrencyUseCase
+	deleteCurrencyUseCase *usecase.DeleteCurrencyUseCase
+	getCurrencyUseCase    *usecase.GetCurrencyUseCase
+	service               *service.EstimationService
+}
+
+func newCurrenciesHandler(
+	createCurrencyUseCase *usecase.CreateCurrencyUseCase,
+	updateCurrencyUseCase *usecase.UpdateCurrencyUseCase,
+	deleteCurrencyUseCase *usecase.DeleteCurrencyUseCase,
+	getCurrencyUseCase *usecase.GetCurrencyUseCase,
+	service *service.EstimationService,
+) *currenciesHandler {
+	return &currenciesHandler{createCurrencyUseCase, updateCurrencyUseCase, deleteCurrencyUseCase, getCurrencyUseCase, service}
+}
+
+func (h *currenciesHandler) createCurrency(w http.ResponseWriter, r *http.Request) {
+	var input usecase.CreateCurrencyInputDTO
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	if errors := common.ValidatePayload(input); errors != nil {
+		writeValidationError(w, errors)
+		return
+	}
+
+	output, err := h.createCurrencyUseCase.Execute(r.Context(), input)
+	if err != nil {
+		writeDomainError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, output)
+}
+
+func (h *currenciesHandler) updateCurrency(w http.ResponseWriter, r *http.Request) {
+	var input usecase.UpdateCurrencyInputDTO
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	input.CurrencyID = r.PathValue("currencyID")
+
+	if errors := common.ValidatePayload(input); errors != nil {
+		writeValidationError(w, errors)
+		return
+	}
+
+	output, err := h.updateCurrencyUseCase.Execute(r.Context(), input)
+	if err != nil {
+		writeDomainError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, output)
+}
+
+func (h *currenciesHandler) deleteCurrency(w http.ResponseWriter, r *http.Request) {
+	input := usecase.DeleteCurrencyInputDTO{
+		CurrencyID: r.PathValue("currencyID"),
+	}
+
+	if errors := common.ValidatePayload(input); errors != nil {
+		writeValidationError(w, errors)
+		return
+	}
+
+	output, err := h.deleteCurrencyUseCase.Execute(r.Context(), input)
+	if err != nil {
+		writeDomainError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusNoContent, output)
+}
+
+func (h *currenciesHandler) getCurrency(w http.ResponseWriter, r *http.Request) {
+	input := usecase.GetCurrencyInputDTO{
+		CurrencyID: r.PathValue("currencyID"),
+	}
+	output, err := h.getCurrencyUseCase.Execute(r.Context(), input)
+	if err != nil {
+		writeDomainError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, output)
+}
+
+func (h *currenciesHandler) listCurrencies(w http.ResponseWriter, r *http.Request) {
+	input := service.ListCurrenciesInputDTO{}
+	output, err := h.service.ListCurrencies(r.Context(), input)
+	if err != nil {
+		writeDomainError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, output)
+}
@@ -0,0 +1,151 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/celsopires1999/estimation/internal/service"
+	"github.com/celsopires1999/estimation/internal/usecase"
+)
+
+type earnedValueHandler struct {
+	recordProgressUseCase *usecase.RecordProgressUseCase
+	deleteProgressUseCase *usecase.DeleteProgressUseCase
+	getEarnedValueUseCase *usecase.GetEarnedValueUseCase
+	getForecastUseCase    *usecase.GetForecastUseCase
+	service               *service.EstimationService
+}
+
+func newEarnedValueHandler(
+	recordProgressUseCase *usecase.RecordProgressUseCase,
+	deleteProgressUseCase *usecase.DeleteProgressUseCase,
+	getEarnedValueUseCase *usecase.GetEarnedValueUseCase,
+	getForecastUseCase *usecase.GetForecastUseCase,
+	service *service.EstimationService,
+) *earnedValueHandler {
+	return &earnedValueHandler{recordProgressUseCase, deleteProgressUseCase, getEarnedValueUseCase, getForecastUseCase, service}
+}
+
+func (h *earnedValueHandler) recordProgress(w http.ResponseWriter, r *http.Request) {
+	var input usecase.RecordProgressInputDTO
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	input.PortfolioID = r.PathValue("portfolioID")
+
+	if errors := common.ValidatePayload(input); errors != nil {
+		writeValidationError(w, errors)
+		return
+	}
+
+	output, err := h.recordProgressUseCase.Execute(r.Context(), input)
+	if err != nil {
+		writeDomainError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, output)
+}
+
+func (h *earnedValueHandler) deleteProgress(w http.ResponseWriter, r *http.Request) {
+	input := usecase.DeleteProgressInputDTO{
+		ProgressID:  r.PathValue("progressID"),
+		PortfolioID: r.PathValue("portfolioID"),
+	}
+
+	if errors := common.ValidatePayload(input); errors != nil {
+		writeValidationError(w, errors)
+		return
+	}
+
+	output, err := h.deleteProgressUseCase.Execute(r.Context(), input)
+	if err != nil {
+		writeDomainError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusNoContent, output)
+}
+
+func (h *earnedValueHandler) listProgress(w http.ResponseWriter, r *http.Request) {
+	input := service.ListProgressInputDTO{
+		PortfolioID: r.PathValue("portfolioID"),
+	}
+	output, err := h.service.ListProgressByPortfolioID(r.Context(), input)
+	if err != nil {
+		writeDomainError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, output)
+}
+
+func (h *earnedValueHandler) getEarnedValue(w http.ResponseWriter, r *http.Request) {
+	input := usecase.GetEarnedValueInputDTO{
+		PortfolioID: r.PathValue("portfolioID"),
+	}
+
+	if errors := common.ValidatePayload(input); errors != nil {
+		writeValidationError(w, errors)
+		return
+	}
+
+	output, err := h.getEarnedValueUseCase.Execute(r.Context(), input)
+	if err != nil {
+		writeDomainError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, output)
+}
+
+// getForecast re-phases the remaining budget with the strategy query parameter, as of the year and month
+// query parameters when they are given
+func (h *earnedValueHandler) getForecast(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	input := usecase.GetForecastInputDTO{
+		PortfolioID: r.PathValue("portfolioID"),
+		Strategy:    query.Get("strategy"),
+	}
+
+	var err error
+	if input.Year, err = queryInt(query, "year"); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if input.Month, err = queryInt(query, "month"); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if errors := common.ValidatePayload(input); errors != nil {
+		writeValidationError(w, errors)
+		return
+	}
+
+	output, err := h.getForecastUseCase.Execute(r.Context(), input)
+	if err != nil {
+		writeDomainError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, output)
+}
+
+// queryInt returns the integer value of the query parameter, or zero when it is not given
+func queryInt(query url.Values, name string) (int, error) {
+	value := query.Get(name)
+	if value == "" {
+		return 0, nil
+	}
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q", name, value)
+	}
+	return number, nil
+}
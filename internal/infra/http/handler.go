@@ -23,46 +23,94 @@ func Handler(ctx context.Context, dbpool *pgxpool.Pool) *http.ServeMux {
 	service := service.NewEstimationService(dbpool)
 
 	// UseCases
-	createUserUseCase := usecase.NewCreateUserUseCase(repository)
+	createUserUseCase := usecase.NewCreateUserUseCase(txm)
 	getUserUseCase := usecase.NewGetUserUseCase(repository)
-	updateUserUseCase := usecase.NewUpdateUserUseCase(repository)
-	deleteUserUseCase := usecase.NewDeleteUserUseCase(repository)
+	updateUserUseCase := usecase.NewUpdateUserUseCase(txm)
+	deleteUserUseCase := usecase.NewDeleteUserUseCase(txm)
 
-	createPlanUseCase := usecase.NewCreatePlanUseCase(repository)
+	createPlanUseCase := usecase.NewCreatePlanUseCase(txm)
 	getPlanUseCase := usecase.NewGetPlanUseCase(repository)
-	updatePlanUseCase := usecase.NewUpdatePlanUseCase(repository)
-	deletePlanUseCase := usecase.NewDeletePlanUseCase(repository)
-
-	createBaselineUseCase := usecase.NewCreateBaselineUseCase(repository)
-	updateBaselineUseCase := usecase.NewUpdateBaselineUseCase(repository)
-	deleteBaselineUseCase := usecase.NewDeleteBaselineUseCase(repository)
+	updatePlanUseCase := usecase.NewUpdatePlanUseCase(txm)
+	deletePlanUseCase := usecase.NewDeletePlanUseCase(txm)
+	getPlanVarianceUseCase := usecase.NewGetPlanVarianceUseCase(repository)
+	clonePlanUseCase := usecase.NewClonePlanUseCase(txm)
+
+	createBaselineUseCase := usecase.NewCreateBaselineUseCase(txm)
+	createBaselineReviewUseCase := usecase.NewCreateBaselineReviewUseCase(txm)
+	updateBaselineUseCase := usecase.NewUpdateBaselineUseCase(txm)
+	deleteBaselineUseCase := usecase.NewDeleteBaselineUseCase(txm)
+	getBaselineDiffUseCase := usecase.NewGetBaselineDiffUseCase(repository)
+	transitionBaselineUseCase := usecase.NewTransitionBaselineUseCase(txm)
 
 	createCostUsecase := usecase.NewCreateCostUseCase(txm)
 	updateCostUseCase := usecase.NewUpdateCostUseCase(txm)
 	deleteCostUseCase := usecase.NewDeleteCostUseCase(txm)
 	getCostsByBaselineIDUseCase := usecase.NewGetCostsByBaselineIDUseCase(repository)
 
-	createCompetenceUseCase := usecase.NewCreateCompetenceUseCase(repository)
-	updateCompetenceUseCase := usecase.NewUpdateCompetenceUseCase(repository)
-	deleteCompetenceUseCase := usecase.NewDeleteCompetenceUseCase(repository)
+	createCompetenceUseCase := usecase.NewCreateCompetenceUseCase(txm)
+	updateCompetenceUseCase := usecase.NewUpdateCompetenceUseCase(txm)
+	deleteCompetenceUseCase := usecase.NewDeleteCompetenceUseCase(txm)
 	getCompetenceUseCase := usecase.NewGetCompetenceUseCase(repository)
 
+	createCurrencyUseCase := usecase.NewCreateCurrencyUseCase(repository)
+	updateCurrencyUseCase := usecase.NewUpdateCurrencyUseCase(repository)
+	deleteCurrencyUseCase := usecase.NewDeleteCurrencyUseCase(repository)
+	getCurrencyUseCase := usecase.NewGetCurrencyUseCase(repository)
+
+	createTaxProfileUseCase := usecase.NewCreateTaxProfileUseCase(repository)
+	updateTaxProfileUseCase := usecase.NewUpdateTaxProfileUseCase(repository)
+	deleteTaxProfileUseCase := usecase.NewDeleteTaxProfileUseCase(repository)
+	getTaxProfileUseCase := usecase.NewGetTaxProfileUseCase(repository)
+
 	createEffortUseCase := usecase.NewCreateEffortUseCase(txm)
 	updateEffortUseCase := usecase.NewUpdateEfforttUseCase(txm)
 	deleteEffortUseCase := usecase.NewDeleteEffortUseCase(txm)
 	getEffortsByBaselineIDUseCase := usecase.NewGetEffortsByBaselineIDUseCase(repository)
 
+	createRateCardUseCase := usecase.NewCreateRateCardUseCase(repository)
+	updateRateCardUseCase := usecase.NewUpdateRateCardUseCase(repository)
+	deleteRateCardUseCase := usecase.NewDeleteRateCardUseCase(repository)
+
+	createCapacityUseCase := usecase.NewCreateCapacityUseCase(repository)
+	updateCapacityUseCase := usecase.NewUpdateCapacityUseCase(repository)
+	deleteCapacityUseCase := usecase.NewDeleteCapacityUseCase(repository)
+
 	createPortfolioUseCase := usecase.NewCreatePortfolioUseCase(txm)
+	updatePortfolioUseCase := usecase.NewUpdatePortfolioUseCase(txm)
 	deletePortfolioUseCase := usecase.NewDeletePortfolioUseCase(txm)
+	recalculatePortfolioUseCase := usecase.NewRecalculatePortfolioUseCase(txm)
+
+	recordActualUseCase := usecase.NewRecordActualUseCase(repository)
+	importActualsUseCase := usecase.NewImportActualsUseCase(txm)
+	deleteActualUseCase := usecase.NewDeleteActualUseCase(repository)
+	getBudgetVsActualUseCase := usecase.NewGetBudgetVsActualUseCase(repository)
+
+	recordProgressUseCase := usecase.NewRecordProgressUseCase(repository)
+	deleteProgressUseCase := usecase.NewDeleteProgressUseCase(repository)
+	getEarnedValueUseCase := usecase.NewGetEarnedValueUseCase(repository)
+	getForecastUseCase := usecase.NewGetForecastUseCase(repository)
+
+	importTimesheetsUseCase := usecase.NewImportTimesheetsUseCase(txm)
+
+	simulatePortfolioUseCase := usecase.NewSimulatePortfolioUseCase(repository)
 
 	// Handlers
 	usersHandler := newUsersHandler(createUserUseCase, updateUserUseCase, getUserUseCase, deleteUserUseCase, service)
-	plansHandler := newPlansHandler(createPlanUseCase, getPlanUseCase, updatePlanUseCase, deletePlanUseCase, service)
-	baselinesHandler := newBaselinesHandler(createBaselineUseCase, updateBaselineUseCase, deleteBaselineUseCase, getCostsByBaselineIDUseCase, getEffortsByBaselineIDUseCase, service)
+	plansHandler := newPlansHandler(createPlanUseCase, getPlanUseCase, updatePlanUseCase, deletePlanUseCase, getPlanVarianceUseCase, clonePlanUseCase, service)
+	baselinesHandler := newBaselinesHandler(createBaselineUseCase, createBaselineReviewUseCase, updateBaselineUseCase, deleteBaselineUseCase, getCostsByBaselineIDUseCase, getEffortsByBaselineIDUseCase, getBaselineDiffUseCase, transitionBaselineUseCase, service)
 	costsHandler := newCostsHandler(createCostUsecase, updateCostUseCase, deleteCostUseCase)
 	competencesHandler := newCompetencesHandler(createCompetenceUseCase, updateCompetenceUseCase, deleteCompetenceUseCase, getCompetenceUseCase, service)
+	currenciesHandler := newCurrenciesHandler(createCurrencyUseCase, updateCurrencyUseCase, deleteCurrencyUseCase, getCurrencyUseCase, service)
+	taxProfilesHandler := newTaxProfilesHandler(createTaxProfileUseCase, updateTaxProfileUseCase, deleteTaxProfileUseCase, getTaxProfileUseCase, service)
 	effortsHandler := newEffortsHandler(createEffortUseCase, updateEffortUseCase, deleteEffortUseCase)
-	portfoliosHandler := newPortfoliosHandler(createPortfolioUseCase, deletePortfolioUseCase, service)
+	rateCardsHandler := newRateCardsHandler(createRateCardUseCase, updateRateCardUseCase, deleteRateCardUseCase, service)
+	capacitiesHandler := newCapacitiesHandler(createCapacityUseCase, updateCapacityUseCase, deleteCapacityUseCase, service)
+	portfoliosHandler := newPortfoliosHandler(createPortfolioUseCase, updatePortfolioUseCase, deletePortfolioUseCase, recalculatePortfolioUseCase, service)
+	actualsHandler := newActualsHandler(recordActualUseCase, importActualsUseCase, deleteActualUseCase, getBudgetVsActualUseCase, service)
+	earnedValueHandler := newEarnedValueHandler(recordProgressUseCase, deleteProgressUseCase, getEarnedValueUseCase, getForecastUseCase, service)
+	timesheetsHandler := newTimesheetsHandler(importTimesheetsUseCase, service)
+	simulationsHandler := newSimulationsHandler(simulatePortfolioUseCase)
+	auditHandler := newAuditHandler(service)
 
 	// Routes
 	r := http.NewServeMux()
@@ -74,16 +122,45 @@ func Handler(ctx context.Context, dbpool *pgxpool.Pool) *http.ServeMux {
 
 	r.HandleFunc("POST /plans", plansHandler.createPlan)
 	r.HandleFunc("PATCH /plans/{planID}", plansHandler.updatePlan)
+	r.HandleFunc("POST /plans/{planID}/clone", plansHandler.clonePlan)
 	r.HandleFunc("DELETE /plans/{planID}", plansHandler.deletePlan)
 	r.HandleFunc("GET /plans/{planID}", plansHandler.getPlan)
+	r.HandleFunc("GET /plans/{planID}/variance", plansHandler.getPlanVariance)
+	r.HandleFunc("GET /plans/{planID}/summary", plansHandler.getPlanSummary)
 	r.HandleFunc("GET /plans", plansHandler.listPlans)
 
+	r.HandleFunc("POST /plans/{planID}/rate-cards", rateCardsHandler.createRateCard)
+	r.HandleFunc("PATCH /plans/{planID}/rate-cards/{rateCardID}", rateCardsHandler.updateRateCard)
+	r.HandleFunc("DELETE /plans/{planID}/rate-cards/{rateCardID}", rateCardsHandler.deleteRateCard)
+	r.HandleFunc("GET /plans/{planID}/rate-cards", rateCardsHandler.listRateCards)
+
+	r.HandleFunc("POST /plans/{planID}/capacities", capacitiesHandler.createCapacity)
+	r.HandleFunc("PATCH /plans/{planID}/capacities/{capacityID}", capacitiesHandler.updateCapacity)
+	r.HandleFunc("DELETE /plans/{planID}/capacities/{capacityID}", capacitiesHandler.deleteCapacity)
+	r.HandleFunc("GET /plans/{planID}/capacities/report", capacitiesHandler.getCapacityReport)
+	r.HandleFunc("GET /plans/{planID}/capacities", capacitiesHandler.listCapacities)
+
+	r.HandleFunc("POST /plans/{planID}/timesheets/import", timesheetsHandler.importTimesheets)
+	r.HandleFunc("GET /plans/{planID}/timesheets/report", timesheetsHandler.getTimesheetReport)
+
 	r.HandleFunc("POST /competences", competencesHandler.createCompetence)
 	r.HandleFunc("PATCH /competences/{competenceID}", competencesHandler.updateCompetence)
 	r.HandleFunc("DELETE /competences/{competenceID}", competencesHandler.deleteCompetence)
 	r.HandleFunc("GET /competences/{competenceID}", competencesHandler.getCompetence)
 	r.HandleFunc("GET /competences", competencesHandler.listCompetences)
 
+	r.HandleFunc("POST /currencies", currenciesHandler.createCurrency)
+	r.HandleFunc("PATCH /currencies/{currencyID}", currenciesHandler.updateCurrency)
+	r.HandleFunc("DELETE /currencies/{currencyID}", currenciesHandler.deleteCurrency)
+	r.HandleFunc("GET /currencies/{currencyID}", currenciesHandler.getCurrency)
+	r.HandleFunc("GET /currencies", currenciesHandler.listCurrencies)
+
+	r.HandleFunc("POST /tax-profiles", taxProfilesHandler.createTaxProfile)
+	r.HandleFunc("PATCH /tax-profiles/{taxProfileID}", taxProfilesHandler.updateTaxProfile)
+	r.HandleFunc("DELETE /tax-profiles/{taxProfileID}", taxProfilesHandler.deleteTaxProfile)
+	r.HandleFunc("GET /tax-profiles/{taxProfileID}", taxProfilesHandler.getTaxProfile)
+	r.HandleFunc("GET /tax-profiles", taxProfilesHandler.listTaxProfiles)
+
 	r.HandleFunc("POST /baselines", baselinesHandler.createBaseline)
 	r.HandleFunc("PATCH /baselines/{baselineID}", baselinesHandler.updateBaseline)
 	r.HandleFunc("DELETE /baselines/{baselineID}", baselinesHandler.deleteBaseline)
@@ -91,6 +168,10 @@ func Handler(ctx context.Context, dbpool *pgxpool.Pool) *http.ServeMux {
 	r.HandleFunc("GET /baselines", baselinesHandler.listBaselines)
 	r.HandleFunc("GET /baselines/{baselineID}/costs", baselinesHandler.getCostsByBaselineID)
 	r.HandleFunc("GET /baselines/{baselineID}/efforts", baselinesHandler.getEffortsByBaselineID)
+	r.HandleFunc("POST /baselines/{baselineID}/reviews", baselinesHandler.createBaselineReview)
+	r.HandleFunc("GET /baselines/{baselineID}/diff", baselinesHandler.getBaselineDiff)
+	r.HandleFunc("POST /baselines/{baselineID}/transitions", baselinesHandler.transitionBaseline)
+	r.HandleFunc("GET /baselines/{baselineID}/transitions", baselinesHandler.listBaselineTransitions)
 
 	r.HandleFunc("POST /baselines/{baselineID}/costs", costsHandler.createCost)
 	r.HandleFunc("PATCH /baselines/{baselineID}/costs/{costID}", costsHandler.updateCost)
@@ -101,11 +182,29 @@ func Handler(ctx context.Context, dbpool *pgxpool.Pool) *http.ServeMux {
 	r.HandleFunc("DELETE /baselines/{baselineID}/efforts/{effortID}", effortsHandler.deleteEffort)
 
 	r.HandleFunc("POST /portfolios", portfoliosHandler.createPortfolio)
+	r.HandleFunc("PATCH /portfolios/{portfolioID}", portfoliosHandler.updatePortfolio)
 	r.HandleFunc("DELETE /portfolios/{portfolioID}", portfoliosHandler.deletePortfolio)
+	r.HandleFunc("POST /portfolios/{portfolioID}/recalculate", portfoliosHandler.recalculatePortfolio)
 	r.HandleFunc("GET /portfolios/{portfolioID}", portfoliosHandler.getPortfolioById)
 	r.HandleFunc("GET /portfolios", portfoliosHandler.listPortfolios)
 
+	r.HandleFunc("POST /portfolios/{portfolioID}/actuals", actualsHandler.recordActual)
+	r.HandleFunc("POST /portfolios/{portfolioID}/actuals/import", actualsHandler.importActuals)
+	r.HandleFunc("DELETE /portfolios/{portfolioID}/actuals/{actualID}", actualsHandler.deleteActual)
+	r.HandleFunc("GET /portfolios/{portfolioID}/actuals", actualsHandler.listActuals)
+	r.HandleFunc("GET /portfolios/{portfolioID}/budget-vs-actual", actualsHandler.getBudgetVsActual)
+
+	r.HandleFunc("POST /portfolios/{portfolioID}/progress", earnedValueHandler.recordProgress)
+	r.HandleFunc("DELETE /portfolios/{portfolioID}/progress/{progressID}", earnedValueHandler.deleteProgress)
+	r.HandleFunc("GET /portfolios/{portfolioID}/progress", earnedValueHandler.listProgress)
+	r.HandleFunc("GET /portfolios/{portfolioID}/earned-value", earnedValueHandler.getEarnedValue)
+	r.HandleFunc("GET /portfolios/{portfolioID}/forecast", earnedValueHandler.getForecast)
+
+	r.HandleFunc("POST /simulations/portfolio", simulationsHandler.simulatePortfolio)
+
+	r.HandleFunc("GET /audit", auditHandler.listAuditEntries)
+
 	v1 := http.NewServeMux()
-	v1.Handle("/api/v1/", http.StripPrefix("/api/v1", r))
+	v1.Handle("/api/v1/", http.StripPrefix("/api/v1", withActor(r)))
 	return v1
 }
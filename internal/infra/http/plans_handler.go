@@ -10,11 +10,13 @@ import (
 )
 
 type plansHandler struct {
-	createPlanUseCase *usecase.CreatePlanUseCase
-	getPlanUseCase    *usecase.GetPlanUseCase
-	updatePlanUseCase *usecase.UpdatePlanUseCase
-	deletePlanUseCase *usecase.DeletePlanUseCase
-	service           *service.EstimationService
+	createPlanUseCase      *usecase.CreatePlanUseCase
+	getPlanUseCase         *usecase.GetPlanUseCase
+	updatePlanUseCase      *usecase.UpdatePlanUseCase
+	deletePlanUseCase      *usecase.DeletePlanUseCase
+	getPlanVarianceUseCase *usecase.GetPlanVarianceUseCase
+	clonePlanUseCase       *usecase.ClonePlanUseCase
+	service                *service.EstimationService
 }
 
 func newPlansHandler(
@@ -22,9 +24,11 @@ func newPlansHandler(
 	getPlanUseCase *usecase.GetPlanUseCase,
 	updatePlanUseCase *usecase.UpdatePlanUseCase,
 	deletePlanUseCase *usecase.DeletePlanUseCase,
+	getPlanVarianceUseCase *usecase.GetPlanVarianceUseCase,
+	clonePlanUseCase *usecase.ClonePlanUseCase,
 	service *service.EstimationService,
 ) *plansHandler {
-	return &plansHandler{createPlanUseCase, getPlanUseCase, updatePlanUseCase, deletePlanUseCase, service}
+	return &plansHandler{createPlanUseCase, getPlanUseCase, updatePlanUseCase, deletePlanUseCase, getPlanVarianceUseCase, clonePlanUseCase, service}
 }
 
 func (h *plansHandler) createPlan(w http.ResponseWriter, r *http.Request) {
@@ -85,6 +89,67 @@ func (h *plansHandler) getPlan(w http.ResponseWriter, r *http.Request) {
 	writeJSON(w, http.StatusOK, output)
 }
 
+func (h *plansHandler) clonePlan(w http.ResponseWriter, r *http.Request) {
+	var input usecase.ClonePlanInputDTO
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	input.PlanID = r.PathValue("planID")
+
+	if errors := common.ValidatePayload(input); errors != nil {
+		writeValidationError(w, errors)
+		return
+	}
+
+	output, err := h.clonePlanUseCase.Execute(r.Context(), input)
+	if err != nil {
+		writeDomainError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, output)
+}
+
+func (h *plansHandler) getPlanVariance(w http.ResponseWriter, r *http.Request) {
+	input := usecase.GetPlanVarianceInputDTO{
+		PlanID:        r.PathValue("planID"),
+		AgainstPlanID: r.URL.Query().Get("against"),
+	}
+
+	if errors := common.ValidatePayload(input); errors != nil {
+		writeValidationError(w, errors)
+		return
+	}
+
+	output, err := h.getPlanVarianceUseCase.Execute(r.Context(), input)
+	if err != nil {
+		writeDomainError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, output)
+}
+
+func (h *plansHandler) getPlanSummary(w http.ResponseWriter, r *http.Request) {
+	input := service.GetPlanSummaryInputDTO{
+		PlanID: r.PathValue("planID"),
+	}
+
+	if errors := common.ValidatePayload(input); errors != nil {
+		writeValidationError(w, errors)
+		return
+	}
+
+	output, err := h.service.GetPlanSummary(r.Context(), input)
+	if err != nil {
+		writeDomainError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, output)
+}
+
 func (h *plansHandler) deletePlan(w http.ResponseWriter, r *http.Request) {
 	input := usecase.DeletePlanInputDTO{
 		PlanID: r.PathValue("planID"),
@@ -10,19 +10,25 @@ import (
 )
 
 type portfoliosHandler struct {
-	createPortfolioUseCase *usecase.CreatePortfolioUseCase
-	deletePortfolioUseCase *usecase.DeletePortfolioUseCase
-	service                *service.EstimationService
+	createPortfolioUseCase      *usecase.CreatePortfolioUseCase
+	updatePortfolioUseCase      *usecase.UpdatePortfolioUseCase
+	deletePortfolioUseCase      *usecase.DeletePortfolioUseCase
+	recalculatePortfolioUseCase *usecase.RecalculatePortfolioUseCase
+	service                     *service.EstimationService
 }
 
 func newPortfoliosHandler(
 	createPortfolioUseCase *usecase.CreatePortfolioUseCase,
+	updatePortfolioUseCase *usecase.UpdatePortfolioUseCase,
 	deletePortfolioUseCase *usecase.DeletePortfolioUseCase,
+	recalculatePortfolioUseCase *usecase.RecalculatePortfolioUseCase,
 	service *service.EstimationService,
 ) *portfoliosHandler {
 	return &portfoliosHandler{
 		createPortfolioUseCase,
+		updatePortfolioUseCase,
 		deletePortfolioUseCase,
+		recalculatePortfolioUseCase,
 		service,
 	}
 }
@@ -48,6 +54,28 @@ func (h *portfoliosHandler) createPortfolio(w http.ResponseWriter, r *http.Reque
 	writeJSON(w, http.StatusCreated, output)
 }
 
+func (h *portfoliosHandler) updatePortfolio(w http.ResponseWriter, r *http.Request) {
+	var input usecase.UpdatePortfolioInputDTO
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	input.PortfolioID = r.PathValue("portfolioID")
+
+	if errors := common.ValidatePayload(input); errors != nil {
+		writeValidationError(w, errors)
+		return
+	}
+
+	output, err := h.updatePortfolioUseCase.Execute(r.Context(), input)
+	if err != nil {
+		writeDomainError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, output)
+}
+
 func (h *portfoliosHandler) deletePortfolio(w http.ResponseWriter, r *http.Request) {
 	input := usecase.DeletePortfolioInputDTO{
 		PortfolioID: r.PathValue("portfolioID")}
@@ -61,6 +89,25 @@ func (h *portfoliosHandler) deletePortfolio(w http.ResponseWriter, r *http.Reque
 	writeJSON(w, http.StatusNoContent, output)
 }
 
+func (h *portfoliosHandler) recalculatePortfolio(w http.ResponseWriter, r *http.Request) {
+	input := usecase.RecalculatePortfolioInputDTO{
+		PortfolioID: r.PathValue("portfolioID"),
+	}
+
+	if errors := common.ValidatePayload(input); errors != nil {
+		writeValidationError(w, errors)
+		return
+	}
+
+	output, err := h.recalculatePortfolioUseCase.Execute(r.Context(), input)
+	if err != nil {
+		writeDomainError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, output)
+}
+
 func (h *portfoliosHandler) getPortfolioById(w http.ResponseWriter, r *http.Request) {
 	input := service.GetPortfolioInputDTO{
 		PortfolioID: r.PathValue("portfolioID"),
@@ -0,0 +1,104 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/celsopires1999/estimation/internal/service"
+	"github.com/celsopires1999/estimation/internal/usecase"
+)
+
+type rateCardsHandler struct {
+	createRateCardUseCase *usecase.CreateRateCardUseCase
+	updateRateCardUseCase *usecase.UpdateRateCardUseCase
+	deleteRateCardUseCase *usecase.DeleteRateCardUseCase
+	service               *service.EstimationService
+}
+
+func newRateCardsHandler(
+	createRateCardUseCase *usecase.CreateRateCardUseCase,
+	updateRateCardUseCase *usecase.UpdateRateCardUseCase,
+	deleteRateCardUseCase *usecase.DeleteRateCardUseCase,
+	service *service.EstimationService,
+) *rateCardsHandler {
+	return &rateCardsHandler{createRateCardUseCase, updateRateCardUseCase, deleteRateCardUseCase, service}
+}
+
+func (h *rateCardsHandler) createRateCard(w http.ResponseWriter, r *http.Request) {
+	var input usecase.CreateRateCardInputDTO
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	input.PlanID = r.PathValue("planID")
+
+	if errors := common.ValidatePayload(input); errors != nil {
+		writeValidationError(w, errors)
+		return
+	}
+
+	output, err := h.createRateCardUseCase.Execute(r.Context(), input)
+	if err != nil {
+		writeDomainError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, output)
+}
+
+func (h *rateCardsHandler) updateRateCard(w http.ResponseWriter, r *http.Request) {
+	var input usecase.UpdateRateCardInputDTO
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	input.RateCardID = r.PathValue("rateCardID")
+	input.PlanID = r.PathValue("planID")
+
+	if errors := common.ValidatePayload(input); errors != nil {
+		writeValidationError(w, errors)
+		return
+	}
+
+	output, err := h.updateRateCardUseCase.Execute(r.Context(), input)
+	if err != nil {
+		writeDomainError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, output)
+}
+
+func (h *rateCardsHandler) deleteRateCard(w http.ResponseWriter, r *http.Request) {
+	input := usecase.DeleteRateCardInputDTO{
+		RateCardID: r.PathValue("rateCardID"),
+		PlanID:     r.PathValue("planID"),
+	}
+
+	if errors := common.ValidatePayload(input); errors != nil {
+		writeValidationError(w, errors)
+		return
+	}
+
+	output, err := h.deleteRateCardUseCase.Execute(r.Context(), input)
+	if err != nil {
+		writeDomainError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusNoContent, output)
+}
+
+func (h *rateCardsHandler) listRateCards(w http.ResponseWriter, r *http.Request) {
+	input := service.ListRateCardsInputDTO{
+		PlanID: r.PathValue("planID"),
+	}
+	output, err := h.service.ListRateCardsByPlanID(r.Context(), input)
+	if err != nil {
+		writeDomainError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, output)
+}
@@ -0,0 +1,40 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/celsopires1999/estimation/internal/usecase"
+)
+
+type simulationsHandler struct {
+	simulatePortfolioUseCase *usecase.SimulatePortfolioUseCase
+}
+
+func newSimulationsHandler(
+	simulatePortfolioUseCase *usecase.SimulatePortfolioUseCase,
+) *simulationsHandler {
+	return &simulationsHandler{simulatePortfolioUseCase}
+}
+
+func (h *simulationsHandler) simulatePortfolio(w http.ResponseWriter, r *http.Request) {
+	var input usecase.SimulatePortfolioInputDTO
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if errors := common.ValidatePayload(input); errors != nil {
+		writeValidationError(w, errors)
+		return
+	}
+
+	output, err := h.simulatePortfolioUseCase.Execute(r.Context(), input)
+	if err != nil {
+		writeDomainError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, output)
+}
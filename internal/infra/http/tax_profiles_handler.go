@@ -0,0 +1,114 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/celsopires1999/estimation/internal/service"
+	"github.com/celsopires1999/estimation/internal/usecase"
+)
+
+type taxProfilesHandler struct {
+	createTaxProfileUseCase *usecase.CreateTaxProfileUseCase
+	updateTaxProfileUseCase *usecase.UpdateTaxProfileUseCase
+	deleteTaxProfileUseCase *usecase.DeleteTaxProfileUseCase
+	getTaxProfileUseCase    *usecase.GetTaxProfileUseCase
+	service                 *service.EstimationService
+}
+
+func newTaxProfilesHandler(
+	createTaxProfileUseCase *usecase.CreateTaxProfileUseCase,
+	updateTaxProfileUseCase *usecase.UpdateTaxProfileUseCase,
+	deleteTaxProfileUseCase *usecase.DeleteTaxProfileUseCase,
+	getTaxProfileUseCase *usecase.GetTaxProfileUseCase,
+	service *service.EstimationService,
+) *taxProfilesHandler {
+	return &taxProfilesHandler{createTaxProfileUseCase, updateTaxProfileUseCase, deleteTaxProfileUseCase, getTaxProfileUseCase, service}
+}
+
+func (h *taxProfilesHandler) createTaxProfile(w http.ResponseWriter, r *http.Request) {
+	var input usecase.CreateTaxProfileInputDTO
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	if errors := common.ValidatePayload(input); errors != nil {
+		writeValidationError(w, errors)
+		return
+	}
+
+	output, err := h.createTaxProfileUseCase.Execute(r.Context(), input)
+	if err != nil {
+		writeDomainError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, output)
+}
+
+func (h *taxProfilesHandler) updateTaxProfile(w http.ResponseWriter, r *http.Request) {
+	var input usecase.UpdateTaxProfileInputDTO
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	input.TaxProfileID = r.PathValue("taxProfileID")
+
+	if errors := common.ValidatePayload(input); errors != nil {
+		writeValidationError(w, errors)
+		return
+	}
+
+	output, err := h.updateTaxProfileUseCase.Execute(r.Context(), input)
+	if err != nil {
+		writeDomainError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, output)
+}
+
+func (h *taxProfilesHandler) deleteTaxProfile(w http.ResponseWriter, r *http.Request) {
+	input := usecase.DeleteTaxProfileInputDTO{
+		TaxProfileID: r.PathValue("taxProfileID"),
+	}
+
+	if errors := common.ValidatePayload(input); errors != nil {
+		writeValidationError(w, errors)
+		return
+	}
+
+	output, err := h.deleteTaxProfileUseCase.Execute(r.Context(), input)
+	if err != nil {
+		writeDomainError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusNoContent, output)
+}
+
+func (h *taxProfilesHandler) getTaxProfile(w http.ResponseWriter, r *http.Request) {
+	input := usecase.GetTaxProfileInputDTO{
+		TaxProfileID: r.PathValue("taxProfileID"),
+	}
+	output, err := h.getTaxProfileUseCase.Execute(r.Context(), input)
+	if err != nil {
+		writeDomainError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, output)
+}
+
+func (h *taxProfilesHandler) listTaxProfiles(w http.ResponseWriter, r *http.Request) {
+	input := service.ListTaxProfilesInputDTO{}
+	output, err := h.service.ListTaxProfiles(r.Context(), input)
+	if err != nil {
+		writeDomainError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, output)
+}
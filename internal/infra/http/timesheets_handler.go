@@ -0,0 +1,137 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/celsopires1999/estimation/internal/service"
+	"github.com/celsopires1999/estimation/internal/usecase"
+)
+
+type timesheetsHandler struct {
+	importTimesheetsUseCase *usecase.ImportTimesheetsUseCase
+	service                 *service.EstimationService
+}
+
+func newTimesheetsHandler(
+	importTimesheetsUseCase *usecase.ImportTimesheetsUseCase,
+	service *service.EstimationService,
+) *timesheetsHandler {
+	return &timesheetsHandler{importTimesheetsUseCase, service}
+}
+
+// importTimesheets records the timesheets sent as JSON or as a CSV file with the columns
+// baseline_code, competence_code, year, month and hours
+func (h *timesheetsHandler) importTimesheets(w http.ResponseWriter, r *http.Request) {
+	var input usecase.ImportTimesheetsInputDTO
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType == "application/json" {
+		if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+	} else {
+		timesheets, err := timesheetsFromCSV(w, r)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		input.Timesheets = timesheets
+	}
+	input.PlanID = r.PathValue("planID")
+
+	if errors := common.ValidatePayload(input); errors != nil {
+		writeValidationError(w, errors)
+		return
+	}
+
+	output, err := h.importTimesheetsUseCase.Execute(r.Context(), input)
+	if err != nil {
+		writeDomainError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, output)
+}
+
+// getTimesheetReport flags the competences whose actual hours are over the planned ones by more than
+// the threshold query parameter, a percentage that defaults to zero
+func (h *timesheetsHandler) getTimesheetReport(w http.ResponseWriter, r *http.Request) {
+	input := service.GetTimesheetReportInputDTO{
+		PlanID: r.PathValue("planID"),
+	}
+
+	if threshold := r.URL.Query().Get("threshold"); threshold != "" {
+		value, err := strconv.ParseFloat(threshold, 64)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("invalid threshold %q", threshold))
+			return
+		}
+		input.Threshold = value
+	}
+
+	if errors := common.ValidatePayload(input); errors != nil {
+		writeValidationError(w, errors)
+		return
+	}
+
+	output, err := h.service.GetTimesheetReport(r.Context(), input)
+	if err != nil {
+		writeDomainError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, output)
+}
+
+func timesheetsFromCSV(w http.ResponseWriter, r *http.Request) ([]usecase.TimesheetInputDTO, error) {
+	file, err := uploadedCSV(w, r)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	records, err := readCSV(file, "baseline_code", "competence_code", "year", "month", "hours")
+	if err != nil {
+		return nil, err
+	}
+
+	timesheets := make([]usecase.TimesheetInputDTO, len(records))
+	for i, record := range records {
+		timesheets[i], err = timesheetFromCSV(record)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return timesheets, nil
+}
+
+func timesheetFromCSV(record csvRecord) (usecase.TimesheetInputDTO, error) {
+	year, err := strconv.Atoi(record.values["year"])
+	if err != nil {
+		return usecase.TimesheetInputDTO{}, record.errorf("invalid year %q", record.values["year"])
+	}
+
+	month, err := strconv.Atoi(record.values["month"])
+	if err != nil {
+		return usecase.TimesheetInputDTO{}, record.errorf("invalid month %q", record.values["month"])
+	}
+
+	hours, err := strconv.Atoi(record.values["hours"])
+	if err != nil {
+		return usecase.TimesheetInputDTO{}, record.errorf("invalid hours %q", record.values["hours"])
+	}
+
+	return usecase.TimesheetInputDTO{
+		BaselineCode:   record.values["baseline_code"],
+		CompetenceCode: record.values["competence_code"],
+		Year:           year,
+		Month:          month,
+		Hours:          hours,
+	}, nil
+}
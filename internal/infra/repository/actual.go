@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/celsopires1999/estimation/internal/domain"
+	"github.com/celsopires1999/estimation/internal/infra/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func (r *estimationRepositoryPostgres) CreateActual(ctx context.Context, actual *domain.Actual) error {
+	err := r.queries.InsertActual(ctx, db.InsertActualParams{
+		ActualID:    actual.ActualID,
+		PortfolioID: actual.PortfolioID,
+		CostID:      actual.CostID,
+		SpendDate:   pgtype.Date{Time: actual.SpendDate, Valid: true},
+		Amount:      actual.Amount,
+		Comment:     pgtype.Text{String: actual.Comment, Valid: true},
+		CreatedAt:   pgtype.Timestamp{Time: time.Now(), Valid: true},
+	})
+
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			if pgErr.Code == "23505" {
+				return common.NewConflictError(fmt.Errorf("actual for cost %s in %s already exists", actual.CostID, actual.SpendDate.Format("2006-01")))
+			}
+			return common.NewConflictError(err)
+		}
+	}
+
+	return err
+}
+
+func (r *estimationRepositoryPostgres) GetActual(ctx context.Context, actualID string) (*domain.Actual, error) {
+	actualModel, err := r.queries.FindActualById(ctx, actualID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, common.NewNotFoundError(fmt.Errorf("actual with id %s not found", actualID))
+		}
+		return nil, err
+	}
+
+	actual := restoreActual(actualModel)
+	err = actual.Validate()
+	if err != nil {
+		return nil, err
+	}
+	return actual, nil
+}
+
+func (r *estimationRepositoryPostgres) UpdateActual(ctx context.Context, actual *domain.Actual) error {
+	err := r.queries.UpdateActual(ctx, db.UpdateActualParams{
+		ActualID:  actual.ActualID,
+		Amount:    actual.Amount,
+		Comment:   pgtype.Text{String: actual.Comment, Valid: true},
+		UpdatedAt: pgtype.Timestamp{Time: time.Now(), Valid: true},
+	})
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return common.NewNotFoundError(fmt.Errorf("actual with id %s not found", actual.ActualID))
+		}
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			return common.NewConflictError(err)
+		}
+	}
+
+	return err
+}
+
+func (r *estimationRepositoryPostgres) DeleteActual(ctx context.Context, actualID string) error {
+	rows, err := r.queries.DeleteActual(ctx, actualID)
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return common.NewNotFoundError(fmt.Errorf("actual with id %s not found", actualID))
+	}
+	return nil
+}
+
+func (r *estimationRepositoryPostgres) GetActualManyByPortfolioID(ctx context.Context, portfolioID string) ([]*domain.Actual, error) {
+	actualModels, err := r.queries.FindActualsByPortfolioId(ctx, portfolioID)
+	if err != nil {
+		return nil, err
+	}
+
+	actuals := make([]*domain.Actual, len(actualModels))
+	for i, actualModel := range actualModels {
+		actuals[i] = restoreActual(actualModel)
+		err = actuals[i].Validate()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return actuals, nil
+}
+
+func restoreActual(actualModel db.Actual) *domain.Actual {
+	return domain.RestoreActual(domain.RestoreActualProps{
+		ActualID:    actualModel.ActualID,
+		PortfolioID: actualModel.PortfolioID,
+		CostID:      actualModel.CostID,
+		SpendDate:   actualModel.SpendDate.Time,
+		Amount:      actualModel.Amount,
+		Comment:     actualModel.Comment.String,
+		CreatedAt:   actualModel.CreatedAt.Time,
+		UpdatedAt:   actualModel.UpdatedAt.Time,
+	})
+}
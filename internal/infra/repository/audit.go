@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/celsopires1999/estimation/internal/domain"
+	"github.com/celsopires1999/estimation/internal/infra/db"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func (r *estimationRepositoryPostgres) CreateAuditEntry(ctx context.Context, entry *domain.AuditEntry) error {
+	return r.queries.InsertAuditEntry(ctx, db.InsertAuditEntryParams{
+		AuditID:   entry.AuditID,
+		Actor:     pgtype.Text{String: entry.Actor, Valid: entry.Actor != ""},
+		Action:    entry.Action.String(),
+		Entity:    entry.Entity.String(),
+		EntityID:  entry.EntityID,
+		Before:    entry.Before,
+		After:     entry.After,
+		CreatedAt: pgtype.Timestamp{Time: time.Now(), Valid: true},
+	})
+}
@@ -25,6 +25,7 @@ func (r *estimationRepositoryPostgres) CreateBaseline(ctx context.Context, basel
 		Duration:    baseline.Duration,
 		ManagerID:   baseline.ManagerID,
 		EstimatorID: baseline.EstimatorID,
+		Status:      baseline.Status.String(),
 		CreatedAt:   pgtype.Timestamp{Time: time.Now(), Valid: true},
 	})
 
@@ -44,6 +45,28 @@ func (r *estimationRepositoryPostgres) GetBaseline(ctx context.Context, baseline
 		return nil, err
 	}
 
+	return restoreBaseline(baselineModel)
+}
+
+func (r *estimationRepositoryPostgres) GetBaselineManyByCode(ctx context.Context, code string) ([]*domain.Baseline, error) {
+	baselineModels, err := r.queries.FindBaselinesByCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	baselines := make([]*domain.Baseline, len(baselineModels))
+	for i, baselineModel := range baselineModels {
+		baseline, err := restoreBaseline(baselineModel)
+		if err != nil {
+			return nil, err
+		}
+		baselines[i] = baseline
+	}
+
+	return baselines, nil
+}
+
+func restoreBaseline(baselineModel db.Baseline) (*domain.Baseline, error) {
 	props := domain.RestoreBaselineProps{
 		BaselineID:  baselineModel.BaselineID,
 		Code:        baselineModel.Code,
@@ -54,12 +77,13 @@ func (r *estimationRepositoryPostgres) GetBaseline(ctx context.Context, baseline
 		Duration:    baselineModel.Duration,
 		ManagerID:   baselineModel.ManagerID,
 		EstimatorID: baselineModel.EstimatorID,
+		Status:      domain.BaselineStatus(baselineModel.Status),
 		CreatedAt:   baselineModel.CreatedAt.Time,
 		UpdatedAt:   baselineModel.UpdatedAt.Time,
 	}
 
 	baseline := domain.RestoreBaseline(props)
-	err = baseline.Validate()
+	err := baseline.Validate()
 	if err != nil {
 		return nil, err
 	}
@@ -88,6 +112,36 @@ func (r *estimationRepositoryPostgres) UpdateBaseline(ctx context.Context, basel
 	return err
 }
 
+func (r *estimationRepositoryPostgres) UpdateBaselineStatus(ctx context.Context, baseline *domain.Baseline) error {
+	return r.queries.UpdateBaselineStatus(ctx, db.UpdateBaselineStatusParams{
+		BaselineID: baseline.BaselineID,
+		Status:     baseline.Status.String(),
+		UpdatedAt:  pgtype.Timestamp{Time: time.Now(), Valid: true},
+	})
+}
+
+func (r *estimationRepositoryPostgres) CreateBaselineTransition(ctx context.Context, transition *domain.BaselineTransition) error {
+	err := r.queries.InsertBaselineTransition(ctx, db.InsertBaselineTransitionParams{
+		TransitionID: transition.TransitionID,
+		BaselineID:   transition.BaselineID,
+		FromStatus:   transition.FromStatus.String(),
+		ToStatus:     transition.ToStatus.String(),
+		UserID:       transition.UserID,
+		Comment:      pgtype.Text{String: transition.Comment, Valid: transition.Comment != ""},
+		CreatedAt:    pgtype.Timestamp{Time: time.Now(), Valid: true},
+	})
+
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return common.NewConflictError(fmt.Errorf("user id %s does not exist", transition.UserID))
+		}
+		return err
+	}
+
+	return nil
+}
+
 func (r *estimationRepositoryPostgres) DeleteBaseline(ctx context.Context, baselineID string) error {
 	_, err := r.queries.DeleteBaseline(ctx, baselineID)
 	if err != nil {
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
@@ -18,6 +19,7 @@ func (r *estimationRepositoryPostgres) CreateBudget(ctx context.Context, budget
 		CostID:      budget.CostID,
 		Amount:      budget.Amount,
 		CreatedAt:   pgtype.Timestamp{Time: time.Now(), Valid: true},
+		Taxes:       budget.Taxes,
 	})
 
 	if err != nil {
@@ -46,11 +48,16 @@ func (r *estimationRepositoryPostgres) CreateBudgetMany(ctx context.Context, bud
 	budgetAllocations := db.BulkInsertBudgetAllocationParams{}
 
 	for _, budget := range budgets {
+		taxes, err := json.Marshal(budget.Taxes)
+		if err != nil {
+			return err
+		}
 		budgetsParams.Column1 = append(budgetsParams.Column1, budget.BudgetID)
 		budgetsParams.Column2 = append(budgetsParams.Column2, budget.PortfolioID)
 		budgetsParams.Column3 = append(budgetsParams.Column3, budget.CostID)
 		budgetsParams.Column4 = append(budgetsParams.Column4, budget.Amount)
 		budgetsParams.Column5 = append(budgetsParams.Column5, pgtype.Timestamp{Time: time.Now(), Valid: true})
+		budgetsParams.Column6 = append(budgetsParams.Column6, taxes)
 		for _, allocation := range budget.BudgetAllocations {
 			budgetAllocations.Column1 = append(budgetAllocations.Column1, uuid.New().String())
 			budgetAllocations.Column2 = append(budgetAllocations.Column2, budget.BudgetID)
@@ -95,6 +102,7 @@ func (r *estimationRepositoryPostgres) GetBudget(ctx context.Context, budgetID s
 		PortfolioID:       budgetModel.PortfolioID,
 		CostID:            budgetModel.CostID,
 		Amount:            budgetModel.Amount,
+		Taxes:             budgetModel.Taxes,
 		BudgetAllocations: allocations,
 		CreatedAt:         budgetModel.CreatedAt.Time,
 		UpdatedAt:         budgetModel.UpdatedAt.Time,
@@ -116,13 +124,14 @@ func (r *estimationRepositoryPostgres) UpdateBudget(ctx context.Context, budget
 		CostID:      budget.CostID,
 		Amount:      budget.Amount,
 		UpdatedAt:   pgtype.Timestamp{Time: time.Now(), Valid: true},
+		Taxes:       budget.Taxes,
 	})
 
 	if err != nil {
 		return err
 	}
 
-	_, err = r.queries.DeleteBudgetAllocations(ctx, budget.CostID)
+	_, err = r.queries.DeleteBudgetAllocations(ctx, budget.BudgetID)
 
 	if err != nil {
 		return err
@@ -131,7 +140,7 @@ func (r *estimationRepositoryPostgres) UpdateBudget(ctx context.Context, budget
 	budgetAllocations := db.BulkInsertBudgetAllocationParams{}
 	for _, allocation := range budget.BudgetAllocations {
 		budgetAllocations.Column1 = append(budgetAllocations.Column1, uuid.New().String())
-		budgetAllocations.Column2 = append(budgetAllocations.Column2, budget.CostID)
+		budgetAllocations.Column2 = append(budgetAllocations.Column2, budget.BudgetID)
 		budgetAllocations.Column3 = append(budgetAllocations.Column3, pgtype.Date{Time: allocation.AllocationDate, Valid: true})
 		budgetAllocations.Column4 = append(budgetAllocations.Column4, allocation.Amount)
 		budgetAllocations.Column5 = append(budgetAllocations.Column5, pgtype.Timestamp{Time: time.Now(), Valid: true})
@@ -197,6 +206,7 @@ func (r *estimationRepositoryPostgres) GetBudgetManyByPortfolioID(ctx context.Co
 			PortfolioID:       budgetModel.PortfolioID,
 			CostID:            budgetModel.CostID,
 			Amount:            budgetModel.Amount,
+			Taxes:             budgetModel.Taxes,
 			BudgetAllocations: allocs,
 			CreatedAt:         budgetModel.CreatedAt.Time,
 			UpdatedAt:         budgetModel.UpdatedAt.Time,
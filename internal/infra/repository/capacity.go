@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/celsopires1999/estimation/internal/domain"
+	"github.com/celsopires1999/estimation/internal/infra/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func (r *estimationRepositoryPostgres) CreateCapacity(ctx context.Context, capacity *domain.Capacity) error {
+	err := r.queries.InsertCapacity(ctx, db.InsertCapacityParams{
+		CapacityID:   capacity.CapacityID,
+		PlanID:       capacity.PlanID,
+		CompetenceID: capacity.CompetenceID,
+		Year:         int32(capacity.Year),
+		Month:        int32(capacity.Month),
+		Hours:        int32(capacity.Hours),
+		CreatedAt:    pgtype.Timestamp{Time: time.Now(), Valid: true},
+	})
+
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			if pgErr.Code == "23505" {
+				return common.NewConflictError(fmt.Errorf("capacity for competence %s in %d-%02d already exists", capacity.CompetenceID, capacity.Year, capacity.Month))
+			}
+			return common.NewConflictError(err)
+		}
+	}
+
+	return err
+}
+
+func (r *estimationRepositoryPostgres) GetCapacity(ctx context.Context, capacityID string) (*domain.Capacity, error) {
+	capacityModel, err := r.queries.FindCapacityById(ctx, capacityID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, common.NewNotFoundError(fmt.Errorf("capacity with id %s not found", capacityID))
+		}
+		return nil, err
+	}
+
+	capacity := restoreCapacity(capacityModel)
+	err = capacity.Validate()
+	if err != nil {
+		return nil, err
+	}
+	return capacity, nil
+}
+
+func (r *estimationRepositoryPostgres) UpdateCapacity(ctx context.Context, capacity *domain.Capacity) error {
+	err := r.queries.UpdateCapacity(ctx, db.UpdateCapacityParams{
+		CapacityID: capacity.CapacityID,
+		Year:       int32(capacity.Year),
+		Month:      int32(capacity.Month),
+		Hours:      int32(capacity.Hours),
+		UpdatedAt:  pgtype.Timestamp{Time: time.Now(), Valid: true},
+	})
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return common.NewNotFoundError(fmt.Errorf("capacity with id %s not found", capacity.CapacityID))
+		}
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			if pgErr.Code == "23505" {
+				return common.NewConflictError(fmt.Errorf("capacity for competence %s in %d-%02d already exists", capacity.CompetenceID, capacity.Year, capacity.Month))
+			}
+			return common.NewConflictError(err)
+		}
+	}
+
+	return err
+}
+
+func (r *estimationRepositoryPostgres) DeleteCapacity(ctx context.Context, capacityID string) error {
+	rows, err := r.queries.DeleteCapacity(ctx, capacityID)
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return common.NewNotFoundError(fmt.Errorf("capacity with id %s not found", capacityID))
+	}
+	return nil
+}
+
+func restoreCapacity(capacityModel db.Capacity) *domain.Capacity {
+	return domain.RestoreCapacity(domain.RestoreCapacityProps{
+		CapacityID:   capacityModel.CapacityID,
+		PlanID:       capacityModel.PlanID,
+		CompetenceID: capacityModel.CompetenceID,
+		Year:         int(capacityModel.Year),
+		Month:        time.Month(capacityModel.Month),
+		Hours:        int(capacityModel.Hours),
+		CreatedAt:    capacityModel.CreatedAt.Time,
+		UpdatedAt:    capacityModel.UpdatedAt.Time,
+	})
+}
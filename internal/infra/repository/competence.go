@@ -60,6 +60,29 @@ func (r *estimationRepositoryPostgres) GetCompetence(ctx context.Context, compet
 	return competence, nil
 }
 
+func (r *estimationRepositoryPostgres) GetCompetenceByCode(ctx context.Context, code string) (*domain.Competence, error) {
+	competenceModel, err := r.queries.FindCompetenceByCode(ctx, code)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, common.NewNotFoundError(fmt.Errorf("competence with code %s not found", code))
+		}
+		return nil, err
+	}
+
+	competence := domain.RestoreCompetence(domain.RestoreCompetenceProps{
+		CompetenceID: competenceModel.CompetenceID,
+		Code:         competenceModel.Code,
+		Name:         competenceModel.Name,
+		CreatedAt:    competenceModel.CreatedAt.Time,
+		UpdatedAt:    competenceModel.UpdatedAt.Time,
+	})
+	err = competence.Validate()
+	if err != nil {
+		return nil, err
+	}
+	return competence, nil
+}
+
 func (r *estimationRepositoryPostgres) UpdateCompetence(ctx context.Context, competence *domain.Competence) error {
 	err := r.queries.UpdateCompetence(ctx, db.UpdateCompetenceParams{
 		CompetenceID: competence.CompetenceID,
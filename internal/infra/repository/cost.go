@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
@@ -28,6 +29,8 @@ func (r *estimationRepositoryPostgres) CreateCost(ctx context.Context, cost *dom
 		Tax:            cost.Tax,
 		ApplyInflation: cost.ApplyInflation,
 		CreatedAt:      pgtype.Timestamp{Time: time.Now(), Valid: true},
+		TaxProfileID:   pgtype.Text{String: cost.TaxProfileID, Valid: cost.TaxProfileID != ""},
+		Recurrence:     cost.Recurrence,
 	})
 
 	if err != nil {
@@ -56,6 +59,14 @@ func (r *estimationRepositoryPostgres) CreateCostMany(ctx context.Context, costs
 	costAllocations := db.BulkInsertCostAllocationParams{}
 
 	for _, cost := range costs {
+		var recurrence []byte
+		if cost.Recurrence != nil {
+			var err error
+			recurrence, err = json.Marshal(cost.Recurrence)
+			if err != nil {
+				return err
+			}
+		}
 		costsParams.Column1 = append(costsParams.Column1, cost.CostID)
 		costsParams.Column2 = append(costsParams.Column2, cost.BaselineID)
 		costsParams.Column3 = append(costsParams.Column3, cost.CostType.String())
@@ -66,6 +77,8 @@ func (r *estimationRepositoryPostgres) CreateCostMany(ctx context.Context, costs
 		costsParams.Column8 = append(costsParams.Column8, cost.Tax)
 		costsParams.Column9 = append(costsParams.Column9, cost.ApplyInflation)
 		costsParams.Column10 = append(costsParams.Column10, pgtype.Timestamp{Time: time.Now(), Valid: true})
+		costsParams.Column11 = append(costsParams.Column11, cost.TaxProfileID)
+		costsParams.Column12 = append(costsParams.Column12, recurrence)
 
 		for _, allocation := range cost.CostAllocations {
 			costAllocations.Column1 = append(costAllocations.Column1, uuid.New().String())
@@ -129,7 +142,9 @@ func (r *estimationRepositoryPostgres) GetCost(ctx context.Context, costID strin
 		Amount:          costModel.Amount,
 		Currency:        domain.Currency(costModel.Currency),
 		Tax:             costModel.Tax,
+		TaxProfileID:    costModel.TaxProfileID.String,
 		ApplyInflation:  costModel.ApplyInflation,
+		Recurrence:      costModel.Recurrence,
 		CostAllocations: allocations,
 		CreatedAt:       costModel.CreatedAt.Time,
 		UpdatedAt:       costModel.UpdatedAt.Time,
@@ -156,6 +171,8 @@ func (r *estimationRepositoryPostgres) UpdateCost(ctx context.Context, cost *dom
 		Tax:            cost.Tax,
 		ApplyInflation: cost.ApplyInflation,
 		UpdatedAt:      pgtype.Timestamp{Time: time.Now(), Valid: true},
+		TaxProfileID:   pgtype.Text{String: cost.TaxProfileID, Valid: cost.TaxProfileID != ""},
+		Recurrence:     cost.Recurrence,
 	})
 
 	if err != nil {
@@ -230,7 +247,9 @@ func (r *estimationRepositoryPostgres) GetCostManyByBaselineID(ctx context.Conte
 			Amount:          costModel.Amount,
 			Currency:        domain.Currency(costModel.Currency),
 			Tax:             costModel.Tax,
+			TaxProfileID:    costModel.TaxProfileID.String,
 			ApplyInflation:  costModel.ApplyInflation,
+			Recurrence:      costModel.Recurrence,
 			CostAllocations: allocs,
 			CreatedAt:       costModel.CreatedAt.Time,
 			UpdatedAt:       costModel.UpdatedAt.Time,
@@ -73,7 +73,7 @@ func (s *CostRepositoryTestSuite) TestIntegrationCreateCost() {
 		costType        domain.CostType
 		description     string
 		comment         string
-		amount          float64
+		amount          common.Money
 		currency        domain.Currency
 		applyInflation  bool
 		costAllocations []domain.CostAllocationProps
@@ -84,12 +84,12 @@ func (s *CostRepositoryTestSuite) TestIntegrationCreateCost() {
 			costType:       domain.OneTimeCost,
 			description:    "Mão de obra do PMO",
 			comment:        "estimativa do Ferraz",
-			amount:         100.0,
+			amount:         common.NewMoney(100.0),
 			currency:       domain.EUR,
 			applyInflation: true,
 			costAllocations: []domain.CostAllocationProps{
-				{Year: 2020, Month: time.January, Amount: 60.},
-				{Year: 2020, Month: time.August, Amount: 40.},
+				{Year: 2020, Month: time.January, Amount: common.NewMoney(60.)},
+				{Year: 2020, Month: time.August, Amount: common.NewMoney(40.)},
 			},
 		},
 		{
@@ -97,12 +97,12 @@ func (s *CostRepositoryTestSuite) TestIntegrationCreateCost() {
 			costType:       domain.RunningCost,
 			description:    "aluguel de espaço de armazenamento",
 			comment:        "preço mensal",
-			amount:         1000.30,
+			amount:         common.NewMoney(1000.30),
 			currency:       domain.USD,
 			applyInflation: false,
 			costAllocations: []domain.CostAllocationProps{
-				{Year: 2020, Month: time.January, Amount: 600.30},
-				{Year: 2020, Month: time.August, Amount: 400.},
+				{Year: 2020, Month: time.January, Amount: common.NewMoney(600.30)},
+				{Year: 2020, Month: time.August, Amount: common.NewMoney(400.)},
 			},
 		},
 	}
@@ -150,7 +150,7 @@ func (s *CostRepositoryTestSuite) TestIntegrationCreateCost() {
 				s.Equal(cost.CostType, model.CostType)
 				s.Equal(cost.Description, model.Description)
 				s.Equal(cost.Comment, model.Comment)
-				s.Equal(cost.Amount, model.Amount)
+				s.Equal(cost.Amount.String(), model.Amount.String())
 				s.Equal(cost.Currency, model.Currency)
 				s.Equal(cost.ApplyInflation, model.ApplyInflation)
 				return err
@@ -166,7 +166,7 @@ func (s *CostRepositoryTestSuite) TestIntegrationCreateCostError() {
 		costType        domain.CostType
 		description     string
 		comment         string
-		amount          float64
+		amount          common.Money
 		currency        domain.Currency
 		applyInflation  bool
 		costAllocations []domain.CostAllocationProps
@@ -177,12 +177,12 @@ func (s *CostRepositoryTestSuite) TestIntegrationCreateCostError() {
 			costType:       domain.OneTimeCost,
 			description:    "Mão de obra do PMO",
 			comment:        "estimativa do Ferraz",
-			amount:         100.0,
+			amount:         common.NewMoney(100.0),
 			currency:       domain.EUR,
 			applyInflation: false,
 			costAllocations: []domain.CostAllocationProps{
-				{Year: 2020, Month: time.January, Amount: 60.},
-				{Year: 2020, Month: time.August, Amount: 40.},
+				{Year: 2020, Month: time.January, Amount: common.NewMoney(60.)},
+				{Year: 2020, Month: time.August, Amount: common.NewMoney(40.)},
 			},
 		},
 	}
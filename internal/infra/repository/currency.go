@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/celsopires1999/estimation/internal/domain"
+	"github.com/celsopires1999/estimation/internal/infra/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func (r *estimationRepositoryPostgres) CreateCurrency(ctx context.Context, currency *domain.CurrencyEntry) error {
+	err := r.queries.InsertCurrency(ctx, db.InsertCurrencyParams{
+		CurrencyID: currency.CurrencyID,
+		Code:       currency.Code.String(),
+		Name:       currency.Name,
+		CreatedAt:  pgtype.Timestamp{Time: time.Now(), Valid: true},
+	})
+
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			if pgErr.Code == "23505" {
+				return common.NewConflictError(fmt.Errorf("currency code %s already exists", currency.Code))
+			}
+			return common.NewConflictError(err)
+		}
+	}
+
+	return err
+}
+
+func (r *estimationRepositoryPostgres) GetCurrency(ctx context.Context, currencyID string) (*domain.CurrencyEntry, error) {
+	currencyModel, err := r.queries.FindCurrencyById(ctx, currencyID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, common.NewNotFoundError(fmt.Errorf("currency with id %s not found", currencyID))
+		}
+		return nil, err
+	}
+
+	props := domain.RestoreCurrencyEntryProps{
+		CurrencyID: currencyModel.CurrencyID,
+		Code:       domain.Currency(currencyModel.Code),
+		Name:       currencyModel.Name,
+		CreatedAt:  currencyModel.CreatedAt.Time,
+		UpdatedAt:  currencyModel.UpdatedAt.Time,
+	}
+
+	currency := domain.RestoreCurrencyEntry(props)
+	err = currency.Validate()
+	if err != nil {
+		return nil, err
+	}
+	return currency, nil
+}
+
+func (r *estimationRepositoryPostgres) UpdateCurrency(ctx context.Context, currency *domain.CurrencyEntry) error {
+	rows, err := r.queries.UpdateCurrency(ctx, db.UpdateCurrencyParams{
+		CurrencyID: currency.CurrencyID,
+		Name:       currency.Name,
+		UpdatedAt:  pgtype.Timestamp{Time: time.Now(), Valid: true},
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			return common.NewConflictError(err)
+		}
+		return err
+	}
+	if rows == 0 {
+		return common.NewNotFoundError(fmt.Errorf("currency with id %s not found", currency.CurrencyID))
+	}
+	return nil
+}
+
+func (r *estimationRepositoryPostgres) DeleteCurrency(ctx context.Context, currencyID string) error {
+	rows, err := r.queries.DeleteCurrency(ctx, currencyID)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			if pgErr.Code == "23503" {
+				return common.NewConflictError(fmt.Errorf("currency with id %s is used by costs or rate cards", currencyID))
+			}
+			return common.NewConflictError(err)
+		}
+		return err
+	}
+	if rows == 0 {
+		return common.NewNotFoundError(fmt.Errorf("currency with id %s not found", currencyID))
+	}
+	return nil
+}
+
+func (r *estimationRepositoryPostgres) ValidateCurrency(ctx context.Context, code domain.Currency) error {
+	_, err := r.queries.FindCurrencyByCode(ctx, code.String())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return common.NewDomainValidationError(fmt.Errorf("currency %s is not registered", code))
+		}
+		return err
+	}
+	return nil
+}
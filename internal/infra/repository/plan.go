@@ -16,11 +16,12 @@ import (
 
 func (r *estimationRepositoryPostgres) CreatePlan(ctx context.Context, plan *domain.Plan) error {
 	err := r.queries.InsertPlan(ctx, db.InsertPlanParams{
-		PlanID:      plan.PlanID,
-		Code:        plan.Code,
-		Name:        plan.Name,
-		Assumptions: plan.Assumptions,
-		CreatedAt:   pgtype.Timestamp{Time: time.Now(), Valid: true},
+		PlanID:        plan.PlanID,
+		Code:          plan.Code,
+		Name:          plan.Name,
+		Assumptions:   plan.Assumptions,
+		InflationMode: plan.InflationMode.String(),
+		CreatedAt:     pgtype.Timestamp{Time: time.Now(), Valid: true},
 	})
 
 	if err != nil {
@@ -46,12 +47,13 @@ func (r *estimationRepositoryPostgres) GetPlan(ctx context.Context, planID strin
 	}
 
 	props := domain.RestorePlanProps{
-		PlanID:      planModel.PlanID,
-		Code:        planModel.Code,
-		Name:        planModel.Name,
-		Assumptions: planModel.Assumptions,
-		CreatedAt:   planModel.CreatedAt.Time,
-		UpdatedAt:   planModel.UpdatedAt.Time,
+		PlanID:        planModel.PlanID,
+		Code:          planModel.Code,
+		Name:          planModel.Name,
+		Assumptions:   planModel.Assumptions,
+		InflationMode: domain.InflationMode(planModel.InflationMode),
+		CreatedAt:     planModel.CreatedAt.Time,
+		UpdatedAt:     planModel.UpdatedAt.Time,
 	}
 
 	plan := domain.RestorePlan(props)
@@ -64,11 +66,12 @@ func (r *estimationRepositoryPostgres) GetPlan(ctx context.Context, planID strin
 
 func (r *estimationRepositoryPostgres) UpdatePlan(ctx context.Context, plan *domain.Plan) error {
 	_, err := r.queries.UpdatePlan(ctx, db.UpdatePlanParams{
-		PlanID:      plan.PlanID,
-		Code:        plan.Code,
-		Name:        plan.Name,
-		Assumptions: plan.Assumptions,
-		UpdatedAt:   pgtype.Timestamp{Time: time.Now(), Valid: true},
+		PlanID:        plan.PlanID,
+		Code:          plan.Code,
+		Name:          plan.Name,
+		Assumptions:   plan.Assumptions,
+		InflationMode: plan.InflationMode.String(),
+		UpdatedAt:     pgtype.Timestamp{Time: time.Now(), Valid: true},
 	})
 
 	if err != nil {
@@ -63,6 +63,57 @@ func (r *estimationRepositoryPostgres) GetPortfolio(ctx context.Context, portfol
 	return portfolio, nil
 }
 
+func (r *estimationRepositoryPostgres) GetPortfolioManyByPlanID(ctx context.Context, planID string) ([]*domain.Portfolio, error) {
+	portfolioModels, err := r.queries.FindPortfoliosByPlanId(ctx, planID)
+	if err != nil {
+		return nil, err
+	}
+
+	portfolios := make([]*domain.Portfolio, len(portfolioModels))
+	for i, portfolioModel := range portfolioModels {
+		portfolios[i] = domain.RestorePortfolio(domain.RestorePortfolioProps{
+			PortfolioID: portfolioModel.PortfolioID,
+			BaselineID:  portfolioModel.BaselineID,
+			PlanID:      portfolioModel.PlanID,
+			StartDate:   portfolioModel.StartDate.Time,
+			CreatedAt:   portfolioModel.CreatedAt.Time,
+			UpdatedAt:   portfolioModel.UpdatedAt.Time,
+		})
+	}
+
+	return portfolios, nil
+}
+
+func (r *estimationRepositoryPostgres) GetPortfolioByPlanIDAndBaselineCode(ctx context.Context, planID string, baselineCode string) (*domain.Portfolio, error) {
+	portfolioModel, err := r.queries.FindPortfolioByPlanIdAndBaselineCode(ctx,
+		db.FindPortfolioByPlanIdAndBaselineCodeParams{
+			PlanID: planID,
+			Code:   baselineCode,
+		},
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, common.NewNotFoundError(fmt.Errorf("portfolio for plan id %s and baseline code %s not found", planID, baselineCode))
+		}
+		return nil, err
+	}
+
+	portfolio := domain.RestorePortfolio(domain.RestorePortfolioProps{
+		PortfolioID: portfolioModel.PortfolioID,
+		BaselineID:  portfolioModel.BaselineID,
+		PlanID:      portfolioModel.PlanID,
+		StartDate:   portfolioModel.StartDate.Time,
+		CreatedAt:   portfolioModel.CreatedAt.Time,
+		UpdatedAt:   portfolioModel.UpdatedAt.Time,
+	})
+	err = portfolio.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return portfolio, nil
+}
+
 func (r *estimationRepositoryPostgres) ValidatePortfolioUniqueBaselineByPlan(ctx context.Context, planID string, baselineCode string) error {
 	_, err := r.queries.FindPortfolioByPlanIdAndBaselineCode(ctx,
 		db.FindPortfolioByPlanIdAndBaselineCodeParams{
@@ -98,6 +149,7 @@ func (r *estimationRepositoryPostgres) UpdatePortfolio(ctx context.Context, port
 			}
 			return common.NewConflictError(err)
 		}
+		return err
 	}
 
 	return nil
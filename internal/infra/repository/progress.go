@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/celsopires1999/estimation/internal/domain"
+	"github.com/celsopires1999/estimation/internal/infra/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func (r *estimationRepositoryPostgres) CreateProgress(ctx context.Context, progress *domain.Progress) error {
+	err := r.queries.InsertProgress(ctx, db.InsertProgressParams{
+		ProgressID:      progress.ProgressID,
+		PortfolioID:     progress.PortfolioID,
+		CostID:          pgtype.Text{String: progress.CostID, Valid: progress.CostID != ""},
+		EffortID:        pgtype.Text{String: progress.EffortID, Valid: progress.EffortID != ""},
+		ProgressDate:    pgtype.Date{Time: progress.ProgressDate, Valid: true},
+		PercentComplete: progress.PercentComplete,
+		CreatedAt:       pgtype.Timestamp{Time: time.Now(), Valid: true},
+	})
+
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			if pgErr.Code == "23505" {
+				if progress.EffortID != "" {
+					return common.NewConflictError(fmt.Errorf("progress for effort %s in %s already exists", progress.EffortID, progress.ProgressDate.Format("2006-01")))
+				}
+				return common.NewConflictError(fmt.Errorf("progress for cost %s in %s already exists", progress.CostID, progress.ProgressDate.Format("2006-01")))
+			}
+			return common.NewConflictError(err)
+		}
+	}
+
+	return err
+}
+
+func (r *estimationRepositoryPostgres) GetProgress(ctx context.Context, progressID string) (*domain.Progress, error) {
+	progressModel, err := r.queries.FindProgressById(ctx, progressID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, common.NewNotFoundError(fmt.Errorf("progress with id %s not found", progressID))
+		}
+		return nil, err
+	}
+
+	progress := restoreProgress(progressModel)
+	err = progress.Validate()
+	if err != nil {
+		return nil, err
+	}
+	return progress, nil
+}
+
+func (r *estimationRepositoryPostgres) UpdateProgress(ctx context.Context, progress *domain.Progress) error {
+	err := r.queries.UpdateProgress(ctx, db.UpdateProgressParams{
+		ProgressID:      progress.ProgressID,
+		PercentComplete: progress.PercentComplete,
+		UpdatedAt:       pgtype.Timestamp{Time: time.Now(), Valid: true},
+	})
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return common.NewNotFoundError(fmt.Errorf("progress with id %s not found", progress.ProgressID))
+		}
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			return common.NewConflictError(err)
+		}
+	}
+
+	return err
+}
+
+func (r *estimationRepositoryPostgres) DeleteProgress(ctx context.Context, progressID string) error {
+	rows, err := r.queries.DeleteProgress(ctx, progressID)
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return common.NewNotFoundError(fmt.Errorf("progress with id %s not found", progressID))
+	}
+	return nil
+}
+
+func (r *estimationRepositoryPostgres) GetProgressManyByPortfolioID(ctx context.Context, portfolioID string) ([]*domain.Progress, error) {
+	progressModels, err := r.queries.FindProgressByPortfolioId(ctx, portfolioID)
+	if err != nil {
+		return nil, err
+	}
+
+	progress := make([]*domain.Progress, len(progressModels))
+	for i, progressModel := range progressModels {
+		progress[i] = restoreProgress(progressModel)
+		err = progress[i].Validate()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return progress, nil
+}
+
+func restoreProgress(progressModel db.Progress) *domain.Progress {
+	return domain.RestoreProgress(domain.RestoreProgressProps{
+		ProgressID:      progressModel.ProgressID,
+		PortfolioID:     progressModel.PortfolioID,
+		CostID:          progressModel.CostID.String,
+		EffortID:        progressModel.EffortID.String,
+		ProgressDate:    progressModel.ProgressDate.Time,
+		PercentComplete: progressModel.PercentComplete,
+		CreatedAt:       progressModel.CreatedAt.Time,
+		UpdatedAt:       progressModel.UpdatedAt.Time,
+	})
+}
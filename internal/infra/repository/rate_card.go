@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/celsopires1999/estimation/internal/domain"
+	"github.com/celsopires1999/estimation/internal/infra/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func (r *estimationRepositoryPostgres) CreateRateCard(ctx context.Context, rateCard *domain.RateCard) error {
+	err := r.queries.InsertRateCard(ctx, db.InsertRateCardParams{
+		RateCardID:   rateCard.RateCardID,
+		PlanID:       rateCard.PlanID,
+		CompetenceID: rateCard.CompetenceID,
+		Year:         int32(rateCard.Year),
+		HourlyRate:   rateCard.HourlyRate,
+		Currency:     rateCard.Currency.String(),
+		CreatedAt:    pgtype.Timestamp{Time: time.Now(), Valid: true},
+	})
+
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			if pgErr.Code == "23505" {
+				return common.NewConflictError(fmt.Errorf("rate card for competence %s in %d already exists", rateCard.CompetenceID, rateCard.Year))
+			}
+			return common.NewConflictError(err)
+		}
+	}
+
+	return err
+}
+
+func (r *estimationRepositoryPostgres) GetRateCard(ctx context.Context, rateCardID string) (*domain.RateCard, error) {
+	rateCardModel, err := r.queries.FindRateCardById(ctx, rateCardID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, common.NewNotFoundError(fmt.Errorf("rate card with id %s not found", rateCardID))
+		}
+		return nil, err
+	}
+
+	rateCard := restoreRateCard(rateCardModel)
+	err = rateCard.Validate()
+	if err != nil {
+		return nil, err
+	}
+	return rateCard, nil
+}
+
+func (r *estimationRepositoryPostgres) UpdateRateCard(ctx context.Context, rateCard *domain.RateCard) error {
+	err := r.queries.UpdateRateCard(ctx, db.UpdateRateCardParams{
+		RateCardID: rateCard.RateCardID,
+		Year:       int32(rateCard.Year),
+		HourlyRate: rateCard.HourlyRate,
+		Currency:   rateCard.Currency.String(),
+		UpdatedAt:  pgtype.Timestamp{Time: time.Now(), Valid: true},
+	})
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return common.NewNotFoundError(fmt.Errorf("rate card with id %s not found", rateCard.RateCardID))
+		}
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			if pgErr.Code == "23505" {
+				return common.NewConflictError(fmt.Errorf("rate card for competence %s in %d already exists", rateCard.CompetenceID, rateCard.Year))
+			}
+			return common.NewConflictError(err)
+		}
+	}
+
+	return err
+}
+
+func (r *estimationRepositoryPostgres) DeleteRateCard(ctx context.Context, rateCardID string) error {
+	rows, err := r.queries.DeleteRateCard(ctx, rateCardID)
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return common.NewNotFoundError(fmt.Errorf("rate card with id %s not found", rateCardID))
+	}
+	return nil
+}
+
+func (r *estimationRepositoryPostgres) GetRateCardManyByPlanID(ctx context.Context, planID string) ([]*domain.RateCard, error) {
+	rateCardModels, err := r.queries.FindRateCardsByPlanId(ctx, planID)
+	if err != nil {
+		return nil, err
+	}
+
+	rateCards := make([]*domain.RateCard, len(rateCardModels))
+	for i, rateCardModel := range rateCardModels {
+		rateCards[i] = restoreRateCard(rateCardModel)
+		err = rateCards[i].Validate()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return rateCards, nil
+}
+
+func restoreRateCard(rateCardModel db.RateCard) *domain.RateCard {
+	return domain.RestoreRateCard(domain.RestoreRateCardProps{
+		RateCardID:   rateCardModel.RateCardID,
+		PlanID:       rateCardModel.PlanID,
+		CompetenceID: rateCardModel.CompetenceID,
+		Year:         int(rateCardModel.Year),
+		HourlyRate:   rateCardModel.HourlyRate,
+		Currency:     domain.Currency(rateCardModel.Currency),
+		CreatedAt:    rateCardModel.CreatedAt.Time,
+		UpdatedAt:    rateCardModel.UpdatedAt.Time,
+	})
+}
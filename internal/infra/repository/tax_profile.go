@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/celsopires1999/estimation/internal/domain"
+	"github.com/celsopires1999/estimation/internal/infra/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func (r *estimationRepositoryPostgres) CreateTaxProfile(ctx context.Context, taxProfile *domain.TaxProfile) error {
+	err := r.queries.InsertTaxProfile(ctx, db.InsertTaxProfileParams{
+		TaxProfileID: taxProfile.TaxProfileID,
+		Name:         taxProfile.Name,
+		Description:  pgtype.Text{String: taxProfile.Description, Valid: true},
+		Components:   taxProfile.Components,
+		CreatedAt:    pgtype.Timestamp{Time: time.Now(), Valid: true},
+	})
+
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			if pgErr.Code == "23505" {
+				return common.NewConflictError(fmt.Errorf("tax profile name %s already exists", taxProfile.Name))
+			}
+			return common.NewConflictError(err)
+		}
+	}
+
+	return err
+}
+
+func (r *estimationRepositoryPostgres) GetTaxProfile(ctx context.Context, taxProfileID string) (*domain.TaxProfile, error) {
+	taxProfileModel, err := r.queries.FindTaxProfileById(ctx, taxProfileID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, common.NewNotFoundError(fmt.Errorf("tax profile with id %s not found", taxProfileID))
+		}
+		return nil, err
+	}
+
+	taxProfile := restoreTaxProfile(taxProfileModel)
+	err = taxProfile.Validate()
+	if err != nil {
+		return nil, err
+	}
+	return taxProfile, nil
+}
+
+func (r *estimationRepositoryPostgres) UpdateTaxProfile(ctx context.Context, taxProfile *domain.TaxProfile) error {
+	err := r.queries.UpdateTaxProfile(ctx, db.UpdateTaxProfileParams{
+		TaxProfileID: taxProfile.TaxProfileID,
+		Name:         taxProfile.Name,
+		Description:  pgtype.Text{String: taxProfile.Description, Valid: true},
+		Components:   taxProfile.Components,
+		UpdatedAt:    pgtype.Timestamp{Time: time.Now(), Valid: true},
+	})
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return common.NewNotFoundError(fmt.Errorf("tax profile with id %s not found", taxProfile.TaxProfileID))
+		}
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			if pgErr.Code == "23505" {
+				return common.NewConflictError(fmt.Errorf("tax profile name %s already exists", taxProfile.Name))
+			}
+			return common.NewConflictError(err)
+		}
+	}
+
+	return err
+}
+
+func (r *estimationRepositoryPostgres) DeleteTaxProfile(ctx context.Context, taxProfileID string) error {
+	rows, err := r.queries.DeleteTaxProfile(ctx, taxProfileID)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			if pgErr.Code == "23503" {
+				return common.NewConflictError(fmt.Errorf("tax profile with id %s is used by costs", taxProfileID))
+			}
+			return common.NewConflictError(err)
+		}
+		return err
+	}
+	if rows == 0 {
+		return common.NewNotFoundError(fmt.Errorf("tax profile with id %s not found", taxProfileID))
+	}
+	return nil
+}
+
+func (r *estimationRepositoryPostgres) GetTaxProfileMany(ctx context.Context) ([]*domain.TaxProfile, error) {
+	taxProfileModels, err := r.queries.FindAllTaxProfiles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	taxProfiles := make([]*domain.TaxProfile, len(taxProfileModels))
+	for i, taxProfileModel := range taxProfileModels {
+		taxProfiles[i] = restoreTaxProfile(taxProfileModel)
+		err = taxProfiles[i].Validate()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return taxProfiles, nil
+}
+
+func restoreTaxProfile(taxProfileModel db.TaxProfile) *domain.TaxProfile {
+	return domain.RestoreTaxProfile(domain.RestoreTaxProfileProps{
+		TaxProfileID: taxProfileModel.TaxProfileID,
+		Name:         taxProfileModel.Name,
+		Description:  taxProfileModel.Description.String,
+		Components:   taxProfileModel.Components,
+		CreatedAt:    taxProfileModel.CreatedAt.Time,
+		UpdatedAt:    taxProfileModel.UpdatedAt.Time,
+	})
+}
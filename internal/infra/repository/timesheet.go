@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/celsopires1999/estimation/internal/domain"
+	"github.com/celsopires1999/estimation/internal/infra/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func (r *estimationRepositoryPostgres) CreateTimesheet(ctx context.Context, timesheet *domain.Timesheet) error {
+	err := r.queries.InsertTimesheet(ctx, db.InsertTimesheetParams{
+		TimesheetID:  timesheet.TimesheetID,
+		PortfolioID:  timesheet.PortfolioID,
+		CompetenceID: timesheet.CompetenceID,
+		WorkDate:     pgtype.Date{Time: timesheet.WorkDate, Valid: true},
+		Hours:        int32(timesheet.Hours),
+		CreatedAt:    pgtype.Timestamp{Time: time.Now(), Valid: true},
+	})
+
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			if pgErr.Code == "23505" {
+				return common.NewConflictError(fmt.Errorf("timesheet for competence %s in %s already exists", timesheet.CompetenceID, timesheet.WorkDate.Format("2006-01")))
+			}
+			return common.NewConflictError(err)
+		}
+	}
+
+	return err
+}
+
+func (r *estimationRepositoryPostgres) GetTimesheet(ctx context.Context, timesheetID string) (*domain.Timesheet, error) {
+	timesheetModel, err := r.queries.FindTimesheetById(ctx, timesheetID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, common.NewNotFoundError(fmt.Errorf("timesheet with id %s not found", timesheetID))
+		}
+		return nil, err
+	}
+
+	timesheet := restoreTimesheet(timesheetModel)
+	err = timesheet.Validate()
+	if err != nil {
+		return nil, err
+	}
+	return timesheet, nil
+}
+
+func (r *estimationRepositoryPostgres) UpdateTimesheet(ctx context.Context, timesheet *domain.Timesheet) error {
+	err := r.queries.UpdateTimesheet(ctx, db.UpdateTimesheetParams{
+		TimesheetID: timesheet.TimesheetID,
+		Hours:       int32(timesheet.Hours),
+		UpdatedAt:   pgtype.Timestamp{Time: time.Now(), Valid: true},
+	})
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return common.NewNotFoundError(fmt.Errorf("timesheet with id %s not found", timesheet.TimesheetID))
+		}
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			return common.NewConflictError(err)
+		}
+	}
+
+	return err
+}
+
+func (r *estimationRepositoryPostgres) GetTimesheetManyByPortfolioID(ctx context.Context, portfolioID string) ([]*domain.Timesheet, error) {
+	timesheetModels, err := r.queries.FindTimesheetsByPortfolioId(ctx, portfolioID)
+	if err != nil {
+		return nil, err
+	}
+
+	timesheets := make([]*domain.Timesheet, len(timesheetModels))
+	for i, timesheetModel := range timesheetModels {
+		timesheets[i] = restoreTimesheet(timesheetModel)
+		err = timesheets[i].Validate()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return timesheets, nil
+}
+
+func restoreTimesheet(timesheetModel db.Timesheet) *domain.Timesheet {
+	return domain.RestoreTimesheet(domain.RestoreTimesheetProps{
+		TimesheetID:  timesheetModel.TimesheetID,
+		PortfolioID:  timesheetModel.PortfolioID,
+		CompetenceID: timesheetModel.CompetenceID,
+		WorkDate:     timesheetModel.WorkDate.Time,
+		Hours:        int(timesheetModel.Hours),
+		CreatedAt:    timesheetModel.CreatedAt.Time,
+		UpdatedAt:    timesheetModel.UpdatedAt.Time,
+	})
+}
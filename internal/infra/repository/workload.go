@@ -17,6 +17,7 @@ func (r *estimationRepositoryPostgres) CreateWorkload(ctx context.Context, workl
 		PortfolioID: workload.PortfolioID,
 		EffortID:    workload.EffortID,
 		Hours:       int32(workload.Hours),
+		Amount:      workload.Amount,
 		CreatedAt:   pgtype.Timestamp{Time: time.Now(), Valid: true},
 	})
 
@@ -30,7 +31,8 @@ func (r *estimationRepositoryPostgres) CreateWorkload(ctx context.Context, workl
 		workloadAllocations.Column2 = append(workloadAllocations.Column2, workload.WorkloadID)
 		workloadAllocations.Column3 = append(workloadAllocations.Column3, pgtype.Date{Time: allocation.AllocationDate, Valid: true})
 		workloadAllocations.Column4 = append(workloadAllocations.Column4, int32(allocation.Hours))
-		workloadAllocations.Column5 = append(workloadAllocations.Column5, pgtype.Timestamp{Time: time.Now(), Valid: true})
+		workloadAllocations.Column5 = append(workloadAllocations.Column5, allocation.Amount)
+		workloadAllocations.Column6 = append(workloadAllocations.Column6, pgtype.Timestamp{Time: time.Now(), Valid: true})
 	}
 	err = r.queries.BulkInsertWorkloadAllocation(ctx, workloadAllocations)
 
@@ -50,13 +52,15 @@ func (r *estimationRepositoryPostgres) CreateWorkloadMany(ctx context.Context, w
 		workloadsParams.Column2 = append(workloadsParams.Column2, workload.PortfolioID)
 		workloadsParams.Column3 = append(workloadsParams.Column3, workload.EffortID)
 		workloadsParams.Column4 = append(workloadsParams.Column4, int32(workload.Hours))
-		workloadsParams.Column5 = append(workloadsParams.Column5, pgtype.Timestamp{Time: time.Now(), Valid: true})
+		workloadsParams.Column5 = append(workloadsParams.Column5, workload.Amount)
+		workloadsParams.Column6 = append(workloadsParams.Column6, pgtype.Timestamp{Time: time.Now(), Valid: true})
 		for _, allocation := range workload.WorkloadAllocations {
 			workloadAllocations.Column1 = append(workloadAllocations.Column1, uuid.NewString())
 			workloadAllocations.Column2 = append(workloadAllocations.Column2, workload.WorkloadID)
 			workloadAllocations.Column3 = append(workloadAllocations.Column3, pgtype.Date{Time: allocation.AllocationDate, Valid: true})
 			workloadAllocations.Column4 = append(workloadAllocations.Column4, int32(allocation.Hours))
-			workloadAllocations.Column5 = append(workloadAllocations.Column5, pgtype.Timestamp{Time: time.Now(), Valid: true})
+			workloadAllocations.Column5 = append(workloadAllocations.Column5, allocation.Amount)
+			workloadAllocations.Column6 = append(workloadAllocations.Column6, pgtype.Timestamp{Time: time.Now(), Valid: true})
 		}
 	}
 	err := r.queries.BulkInsertWorkload(ctx, workloadsParams)
@@ -87,6 +91,7 @@ func (r *estimationRepositoryPostgres) GetWorkload(ctx context.Context, workload
 		allocations[i] = domain.WorkloadAllocation{
 			AllocationDate: allocation.AllocationDate.Time,
 			Hours:          int(allocation.Hours),
+			Amount:         allocation.Amount,
 		}
 	}
 
@@ -95,6 +100,7 @@ func (r *estimationRepositoryPostgres) GetWorkload(ctx context.Context, workload
 		PortfolioID:         workloadModel.PortfolioID,
 		EffortID:            workloadModel.EffortID,
 		Hours:               int(workloadModel.Hours),
+		Amount:              workloadModel.Amount,
 		WorkloadAllocations: allocations,
 		CreatedAt:           workloadModel.CreatedAt.Time,
 		UpdatedAt:           workloadModel.UpdatedAt.Time,
@@ -115,6 +121,7 @@ func (r *estimationRepositoryPostgres) UpdateWorkload(ctx context.Context, workl
 		PortfolioID: workload.PortfolioID,
 		EffortID:    workload.EffortID,
 		Hours:       int32(workload.Hours),
+		Amount:      workload.Amount,
 		UpdatedAt:   pgtype.Timestamp{Time: time.Now(), Valid: true},
 	})
 
@@ -134,7 +141,8 @@ func (r *estimationRepositoryPostgres) UpdateWorkload(ctx context.Context, workl
 		workloadAllocations.Column2 = append(workloadAllocations.Column2, workload.WorkloadID)
 		workloadAllocations.Column3 = append(workloadAllocations.Column3, pgtype.Date{Time: allocation.AllocationDate, Valid: true})
 		workloadAllocations.Column4 = append(workloadAllocations.Column4, int32(allocation.Hours))
-		workloadAllocations.Column5 = append(workloadAllocations.Column5, pgtype.Timestamp{Time: time.Now(), Valid: true})
+		workloadAllocations.Column5 = append(workloadAllocations.Column5, allocation.Amount)
+		workloadAllocations.Column6 = append(workloadAllocations.Column6, pgtype.Timestamp{Time: time.Now(), Valid: true})
 	}
 	err = r.queries.BulkInsertWorkloadAllocation(ctx, workloadAllocations)
 
@@ -189,6 +197,7 @@ func (r *estimationRepositoryPostgres) GetWorkloadManyByPortfolioID(ctx context.
 			allocs[j] = domain.WorkloadAllocation{
 				AllocationDate: allocation.AllocationDate.Time,
 				Hours:          int(allocation.Hours),
+				Amount:         allocation.Amount,
 			}
 		}
 
@@ -197,6 +206,7 @@ func (r *estimationRepositoryPostgres) GetWorkloadManyByPortfolioID(ctx context.
 			PortfolioID:         workloadModel.PortfolioID,
 			EffortID:            workloadModel.EffortID,
 			Hours:               int(workloadModel.Hours),
+			Amount:              workloadModel.Amount,
 			WorkloadAllocations: allocs,
 			CreatedAt:           workloadModel.CreatedAt.Time,
 			UpdatedAt:           workloadModel.UpdatedAt.Time,
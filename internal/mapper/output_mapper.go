@@ -4,8 +4,10 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/celsopires1999/estimation/internal/common"
 	"github.com/celsopires1999/estimation/internal/domain"
 	"github.com/celsopires1999/estimation/internal/infra/db"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type UserOutput struct {
@@ -60,33 +62,36 @@ func (o UserOutput) MarshalJSON() ([]byte, error) {
 }
 
 type PlanOutput struct {
-	PlanID      string             `json:"plan_id"`
-	Code        string             `json:"code"`
-	Name        string             `json:"name"`
-	Assumptions domain.Assumptions `json:"assumptions,omitempty"`
-	CreatedAt   time.Time          `json:"created_at"`
-	UpdatedAt   time.Time          `json:"updated_at"`
+	PlanID        string             `json:"plan_id"`
+	Code          string             `json:"code"`
+	Name          string             `json:"name"`
+	Assumptions   domain.Assumptions `json:"assumptions,omitempty"`
+	InflationMode string             `json:"inflation_mode"`
+	CreatedAt     time.Time          `json:"created_at"`
+	UpdatedAt     time.Time          `json:"updated_at"`
 }
 
 func PlanOutputFromDomain(plan domain.Plan) PlanOutput {
 	return PlanOutput{
-		PlanID:      plan.PlanID,
-		Code:        plan.Code,
-		Name:        plan.Name,
-		Assumptions: plan.Assumptions,
-		CreatedAt:   plan.CreatedAt,
-		UpdatedAt:   plan.UpdatedAt,
+		PlanID:        plan.PlanID,
+		Code:          plan.Code,
+		Name:          plan.Name,
+		Assumptions:   plan.Assumptions,
+		InflationMode: plan.InflationMode.String(),
+		CreatedAt:     plan.CreatedAt,
+		UpdatedAt:     plan.UpdatedAt,
 	}
 }
 
 func PlanOutputFromDb(plan db.Plan) PlanOutput {
 	return PlanOutput{
-		PlanID:      plan.PlanID,
-		Code:        plan.Code,
-		Name:        plan.Name,
-		Assumptions: plan.Assumptions,
-		CreatedAt:   plan.CreatedAt.Time,
-		UpdatedAt:   plan.UpdatedAt.Time,
+		PlanID:        plan.PlanID,
+		Code:          plan.Code,
+		Name:          plan.Name,
+		Assumptions:   plan.Assumptions,
+		InflationMode: plan.InflationMode,
+		CreatedAt:     plan.CreatedAt.Time,
+		UpdatedAt:     plan.UpdatedAt.Time,
 	}
 }
 
@@ -119,6 +124,7 @@ type BaselineOutput struct {
 	Mananger    string    `json:"manager,omitempty"`
 	EstimatorID string    `json:"estimator_id,omitempty"`
 	Estimator   string    `json:"estimator,omitempty"`
+	Status      string    `json:"status"`
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
 }
@@ -134,6 +140,7 @@ func BaselineOutputFromDomain(b domain.Baseline) BaselineOutput {
 		Duration:    b.Duration,
 		ManagerID:   b.ManagerID,
 		EstimatorID: b.EstimatorID,
+		Status:      b.Status.String(),
 		CreatedAt:   b.CreatedAt,
 	}
 }
@@ -151,6 +158,7 @@ func BaselineOutputFromDb(b db.BaselineRow) BaselineOutput {
 		Mananger:    b.Manager,
 		EstimatorID: b.EstimatorID,
 		Estimator:   b.Estimator,
+		Status:      b.Status,
 		CreatedAt:   b.CreatedAt.Time,
 		UpdatedAt:   b.UpdatedAt.Time,
 	}
@@ -175,16 +183,72 @@ func (o BaselineOutput) MarshalJSON() ([]byte, error) {
 	return b, err
 }
 
+type BaselineTransitionOutput struct {
+	TransitionID string    `json:"transition_id"`
+	BaselineID   string    `json:"baseline_id"`
+	FromStatus   string    `json:"from_status"`
+	ToStatus     string    `json:"to_status"`
+	UserID       string    `json:"user_id"`
+	UserName     string    `json:"user_name,omitempty"`
+	UserType     string    `json:"user_type,omitempty"`
+	Comment      string    `json:"comment"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+func BaselineTransitionOutputFromDomain(t domain.BaselineTransition) BaselineTransitionOutput {
+	return BaselineTransitionOutput{
+		TransitionID: t.TransitionID,
+		BaselineID:   t.BaselineID,
+		FromStatus:   t.FromStatus.String(),
+		ToStatus:     t.ToStatus.String(),
+		UserID:       t.UserID,
+		Comment:      t.Comment,
+		CreatedAt:    t.CreatedAt,
+	}
+}
+
+func BaselineTransitionOutputFromDb(t db.FindBaselineTransitionsByBaselineIdRow) BaselineTransitionOutput {
+	return BaselineTransitionOutput{
+		TransitionID: t.TransitionID,
+		BaselineID:   t.BaselineID,
+		FromStatus:   t.FromStatus,
+		ToStatus:     t.ToStatus,
+		UserID:       t.UserID,
+		UserName:     t.UserName,
+		UserType:     t.UserType,
+		Comment:      t.Comment.String,
+		CreatedAt:    t.CreatedAt.Time,
+	}
+}
+
+func (o BaselineTransitionOutput) MarshalJSON() ([]byte, error) {
+	type Dup BaselineTransitionOutput
+
+	tmp := struct {
+		Dup
+		CreatedAt *string `json:"created_at"`
+	}{
+		Dup: (Dup)(o),
+	}
+
+	tmp.CreatedAt, _ = fmtRFC3339Time(o.CreatedAt, time.Time{})
+
+	b, err := json.Marshal(tmp)
+	return b, err
+}
+
 type CostOutput struct {
 	CostID          string                 `json:"cost_id"`
 	BaselineID      string                 `json:"baseline_id"`
 	CostType        string                 `json:"cost_type"`
 	Description     string                 `json:"description"`
 	Comment         string                 `json:"comment"`
-	Amount          float64                `json:"amount"`
+	Amount          common.Money           `json:"amount"`
 	Currency        string                 `json:"currency"`
 	Tax             float64                `json:"tax"`
+	TaxProfileID    string                 `json:"tax_profile_id,omitempty"`
 	ApplyInflation  bool                   `json:"apply_inflation"`
+	Recurrence      *recurrenceOutput      `json:"recurrence,omitempty"`
 	CostAllocations []costAllocationOutput `json:"cost_allocations"`
 	CreatedAt       time.Time              `json:"created_at"`
 	UpdatedAt       time.Time              `json:"updated_at"`
@@ -201,6 +265,19 @@ func CostOutputFromDomain(cost domain.Cost) CostOutput {
 		}
 	}
 
+	var recurrence *recurrenceOutput
+	if cost.Recurrence != nil {
+		recurrence = &recurrenceOutput{
+			Frequency:   cost.Recurrence.Frequency.String(),
+			Amount:      cost.Recurrence.Amount,
+			StartYear:   cost.Recurrence.StartYear,
+			StartMonth:  int(cost.Recurrence.StartMonth),
+			EndYear:     cost.Recurrence.EndYear,
+			EndMonth:    int(cost.Recurrence.EndMonth),
+			Occurrences: cost.Recurrence.Occurrences,
+		}
+	}
+
 	return CostOutput{
 		CostID:          cost.CostID,
 		BaselineID:      cost.BaselineID,
@@ -210,16 +287,28 @@ func CostOutputFromDomain(cost domain.Cost) CostOutput {
 		Amount:          cost.Amount,
 		Currency:        cost.Currency.String(),
 		Tax:             cost.Tax,
+		TaxProfileID:    cost.TaxProfileID,
 		ApplyInflation:  cost.ApplyInflation,
+		Recurrence:      recurrence,
 		CostAllocations: allocs,
 		CreatedAt:       cost.CreatedAt,
 	}
 }
 
+type recurrenceOutput struct {
+	Frequency   string       `json:"frequency"`
+	Amount      common.Money `json:"amount"`
+	StartYear   int          `json:"start_year"`
+	StartMonth  int          `json:"start_month"`
+	EndYear     int          `json:"end_year,omitempty"`
+	EndMonth    int          `json:"end_month,omitempty"`
+	Occurrences int          `json:"occurrences,omitempty"`
+}
+
 type costAllocationOutput struct {
-	Year   int     `json:"year"`
-	Month  int     `json:"month"`
-	Amount float64 `json:"amount"`
+	Year   int          `json:"year"`
+	Month  int          `json:"month"`
+	Amount common.Money `json:"amount"`
 }
 
 func (o CostOutput) MarshalJSON() ([]byte, error) {
@@ -284,6 +373,229 @@ func (o CompetenceOutput) MarshalJSON() ([]byte, error) {
 	return b, err
 }
 
+type CurrencyOutput struct {
+	CurrencyID string    `json:"currency_id"`
+	Code       string    `json:"code"`
+	Name       string    `json:"name"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+func CurrencyOutputFromDomain(c domain.CurrencyEntry) CurrencyOutput {
+	return CurrencyOutput{
+		CurrencyID: c.CurrencyID,
+		Code:       c.Code.String(),
+		Name:       c.Name,
+		CreatedAt:  c.CreatedAt,
+		UpdatedAt:  c.UpdatedAt,
+	}
+}
+
+func CurrencyOutputFromDb(c db.Currency) CurrencyOutput {
+	return CurrencyOutput{
+		CurrencyID: c.CurrencyID,
+		Code:       c.Code,
+		Name:       c.Name,
+		CreatedAt:  c.CreatedAt.Time,
+		UpdatedAt:  c.UpdatedAt.Time,
+	}
+}
+
+func (o CurrencyOutput) MarshalJSON() ([]byte, error) {
+	type Dup CurrencyOutput
+
+	tmp := struct {
+		Dup
+		CreatedAt *string `json:"created_at"`
+		UpdatedAt *string `json:"updated_at"`
+	}{
+		Dup: (Dup)(o),
+	}
+
+	tmp.CreatedAt, tmp.UpdatedAt = fmtRFC3339Time(o.CreatedAt, o.UpdatedAt)
+
+	b, err := json.Marshal(tmp)
+	return b, err
+}
+
+type TaxProfileOutput struct {
+	TaxProfileID string               `json:"tax_profile_id"`
+	Name         string               `json:"name"`
+	Description  string               `json:"description"`
+	Components   []taxComponentOutput `json:"components"`
+	CreatedAt    time.Time            `json:"created_at"`
+	UpdatedAt    time.Time            `json:"updated_at"`
+}
+
+type taxComponentOutput struct {
+	Name     string  `json:"name"`
+	Rate     float64 `json:"rate"`
+	Compound bool    `json:"compound"`
+}
+
+func TaxProfileOutputFromDomain(t domain.TaxProfile) TaxProfileOutput {
+	return TaxProfileOutput{
+		TaxProfileID: t.TaxProfileID,
+		Name:         t.Name,
+		Description:  t.Description,
+		Components:   taxComponentsOutput(t.Components),
+		CreatedAt:    t.CreatedAt,
+		UpdatedAt:    t.UpdatedAt,
+	}
+}
+
+func TaxProfileOutputFromDb(t db.TaxProfile) TaxProfileOutput {
+	return TaxProfileOutput{
+		TaxProfileID: t.TaxProfileID,
+		Name:         t.Name,
+		Description:  t.Description.String,
+		Components:   taxComponentsOutput(t.Components),
+		CreatedAt:    t.CreatedAt.Time,
+		UpdatedAt:    t.UpdatedAt.Time,
+	}
+}
+
+func taxComponentsOutput(components domain.TaxComponents) []taxComponentOutput {
+	output := make([]taxComponentOutput, len(components))
+	for i, c := range components {
+		output[i] = taxComponentOutput(c)
+	}
+	return output
+}
+
+func (o TaxProfileOutput) MarshalJSON() ([]byte, error) {
+	type Dup TaxProfileOutput
+
+	tmp := struct {
+		Dup
+		CreatedAt *string `json:"created_at"`
+		UpdatedAt *string `json:"updated_at"`
+	}{
+		Dup: (Dup)(o),
+	}
+
+	tmp.CreatedAt, tmp.UpdatedAt = fmtRFC3339Time(o.CreatedAt, o.UpdatedAt)
+
+	b, err := json.Marshal(tmp)
+	return b, err
+}
+
+type RateCardOutput struct {
+	RateCardID     string       `json:"rate_card_id"`
+	PlanID         string       `json:"plan_id"`
+	CompetenceID   string       `json:"competence_id"`
+	CompetenceCode string       `json:"competence_code,omitempty"`
+	CompetenceName string       `json:"competence_name,omitempty"`
+	Year           int          `json:"year"`
+	HourlyRate     common.Money `json:"hourly_rate"`
+	Currency       string       `json:"currency"`
+	CreatedAt      time.Time    `json:"created_at"`
+	UpdatedAt      time.Time    `json:"updated_at"`
+}
+
+func RateCardOutputFromDomain(r domain.RateCard) RateCardOutput {
+	return RateCardOutput{
+		RateCardID:   r.RateCardID,
+		PlanID:       r.PlanID,
+		CompetenceID: r.CompetenceID,
+		Year:         r.Year,
+		HourlyRate:   r.HourlyRate,
+		Currency:     r.Currency.String(),
+		CreatedAt:    r.CreatedAt,
+		UpdatedAt:    r.UpdatedAt,
+	}
+}
+
+func RateCardOutputFromDb(r db.FindRateCardsByPlanIdWithRelationsRow) RateCardOutput {
+	return RateCardOutput{
+		RateCardID:     r.RateCardID,
+		PlanID:         r.PlanID,
+		CompetenceID:   r.CompetenceID,
+		CompetenceCode: r.CompetenceCode,
+		CompetenceName: r.CompetenceName,
+		Year:           int(r.Year),
+		HourlyRate:     r.HourlyRate,
+		Currency:       r.Currency,
+		CreatedAt:      r.CreatedAt.Time,
+		UpdatedAt:      r.UpdatedAt.Time,
+	}
+}
+
+func (o RateCardOutput) MarshalJSON() ([]byte, error) {
+	type Dup RateCardOutput
+
+	tmp := struct {
+		Dup
+		CreatedAt *string `json:"created_at"`
+		UpdatedAt *string `json:"updated_at"`
+	}{
+		Dup: (Dup)(o),
+	}
+
+	tmp.CreatedAt, tmp.UpdatedAt = fmtRFC3339Time(o.CreatedAt, o.UpdatedAt)
+
+	b, err := json.Marshal(tmp)
+	return b, err
+}
+
+type CapacityOutput struct {
+	CapacityID     string    `json:"capacity_id"`
+	PlanID         string    `json:"plan_id"`
+	CompetenceID   string    `json:"competence_id"`
+	CompetenceCode string    `json:"competence_code,omitempty"`
+	CompetenceName string    `json:"competence_name,omitempty"`
+	Year           int       `json:"year"`
+	Month          int       `json:"month"`
+	Hours          int       `json:"hours"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+func CapacityOutputFromDomain(c domain.Capacity) CapacityOutput {
+	return CapacityOutput{
+		CapacityID:   c.CapacityID,
+		PlanID:       c.PlanID,
+		CompetenceID: c.CompetenceID,
+		Year:         c.Year,
+		Month:        int(c.Month),
+		Hours:        c.Hours,
+		CreatedAt:    c.CreatedAt,
+		UpdatedAt:    c.UpdatedAt,
+	}
+}
+
+func CapacityOutputFromDb(c db.FindCapacitiesByPlanIdWithRelationsRow) CapacityOutput {
+	return CapacityOutput{
+		CapacityID:     c.CapacityID,
+		PlanID:         c.PlanID,
+		CompetenceID:   c.CompetenceID,
+		CompetenceCode: c.CompetenceCode,
+		CompetenceName: c.CompetenceName,
+		Year:           int(c.Year),
+		Month:          int(c.Month),
+		Hours:          int(c.Hours),
+		CreatedAt:      c.CreatedAt.Time,
+		UpdatedAt:      c.UpdatedAt.Time,
+	}
+}
+
+func (o CapacityOutput) MarshalJSON() ([]byte, error) {
+	type Dup CapacityOutput
+
+	tmp := struct {
+		Dup
+		CreatedAt *string `json:"created_at"`
+		UpdatedAt *string `json:"updated_at"`
+	}{
+		Dup: (Dup)(o),
+	}
+
+	tmp.CreatedAt, tmp.UpdatedAt = fmtRFC3339Time(o.CreatedAt, o.UpdatedAt)
+
+	b, err := json.Marshal(tmp)
+	return b, err
+}
+
 type EffortOutput struct {
 	EffortID          string                   `json:"effort_id"`
 	BaselineID        string                   `json:"baseline_id"`
@@ -356,6 +668,169 @@ type PortfolioOutput struct {
 	UpdatedAt   time.Time        `json:"updated_at"`
 	Budgets     []BudgetOutput   `json:"budgets,omitempty"`
 	Workloads   []WorkloadOutput `json:"workloads,omitempty"`
+	Totals      *TotalsOutput    `json:"totals,omitempty"`
+}
+
+// TotalsOutput adds up the budgets and the labor priced from the workloads.
+// The actual spend and the remaining budget are only known for saved portfolios.
+type TotalsOutput struct {
+	BudgetAmount    common.Money  `json:"budget_amount"`
+	LaborAmount     common.Money  `json:"labor_amount"`
+	TotalAmount     common.Money  `json:"total_amount"`
+	ActualAmount    *common.Money `json:"actual_amount,omitempty"`
+	RemainingAmount *common.Money `json:"remaining_amount,omitempty"`
+}
+
+func TotalsOutputFrom(budgets []BudgetOutput, workloads []WorkloadOutput) *TotalsOutput {
+	budgetAmount := common.ZeroMoney
+	for _, b := range budgets {
+		budgetAmount = budgetAmount.Add(b.Amount)
+	}
+
+	laborAmount := common.ZeroMoney
+	for _, w := range workloads {
+		laborAmount = laborAmount.Add(w.Amount)
+	}
+
+	return &TotalsOutput{
+		BudgetAmount: budgetAmount,
+		LaborAmount:  laborAmount,
+		TotalAmount:  budgetAmount.Add(laborAmount),
+	}
+}
+
+// PortfolioRecordOutput is a portfolio as it is stored, without its baseline, budgets and workloads
+type PortfolioRecordOutput struct {
+	PortfolioID string    `json:"portfolio_id"`
+	BaselineID  string    `json:"baseline_id"`
+	PlanID      string    `json:"plan_id"`
+	StartDate   time.Time `json:"start_date"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+func PortfolioRecordOutputFromDomain(p domain.Portfolio) PortfolioRecordOutput {
+	return PortfolioRecordOutput{
+		PortfolioID: p.PortfolioID,
+		BaselineID:  p.BaselineID,
+		PlanID:      p.PlanID,
+		StartDate:   p.StartDate,
+		CreatedAt:   p.CreatedAt,
+		UpdatedAt:   p.UpdatedAt,
+	}
+}
+
+func (o PortfolioRecordOutput) MarshalJSON() ([]byte, error) {
+	type Dup PortfolioRecordOutput
+
+	tmp := struct {
+		Dup
+		StartDate string  `json:"start_date"`
+		CreatedAt *string `json:"created_at"`
+		UpdatedAt *string `json:"updated_at"`
+	}{
+		Dup:       (Dup)(o),
+		StartDate: o.StartDate.Format("2006-01-02"),
+	}
+
+	tmp.CreatedAt, tmp.UpdatedAt = fmtRFC3339Time(o.CreatedAt, o.UpdatedAt)
+
+	b, err := json.Marshal(tmp)
+	return b, err
+}
+
+// PortfolioDeltaOutput compares the budgets and workloads of a portfolio before and after a recalculation
+type PortfolioDeltaOutput struct {
+	PortfolioID string                `json:"portfolio_id"`
+	Before      TotalsOutput          `json:"before"`
+	After       TotalsOutput          `json:"after"`
+	Delta       TotalsOutput          `json:"delta"`
+	Budgets     []budgetDeltaOutput   `json:"budgets"`
+	Workloads   []workloadDeltaOutput `json:"workloads"`
+}
+
+type budgetDeltaOutput struct {
+	CostID string       `json:"cost_id"`
+	Before common.Money `json:"before"`
+	After  common.Money `json:"after"`
+	Delta  common.Money `json:"delta"`
+}
+
+type workloadDeltaOutput struct {
+	EffortID    string       `json:"effort_id"`
+	BeforeHours int          `json:"before_hours"`
+	AfterHours  int          `json:"after_hours"`
+	Before      common.Money `json:"before"`
+	After       common.Money `json:"after"`
+	Delta       common.Money `json:"delta"`
+}
+
+func PortfolioDeltaOutputFrom(portfolioID string, beforeBudgets, afterBudgets []*domain.Budget, beforeWorkloads, afterWorkloads []*domain.Workload) PortfolioDeltaOutput {
+	output := PortfolioDeltaOutput{
+		PortfolioID: portfolioID,
+		Budgets:     make([]budgetDeltaOutput, 0),
+		Workloads:   make([]workloadDeltaOutput, 0),
+	}
+
+	budgets := make(map[string]*budgetDeltaOutput)
+	costIDs := make([]string, 0)
+	budgetOf := func(costID string) *budgetDeltaOutput {
+		if _, ok := budgets[costID]; !ok {
+			budgets[costID] = &budgetDeltaOutput{CostID: costID}
+			costIDs = append(costIDs, costID)
+		}
+		return budgets[costID]
+	}
+	for _, b := range beforeBudgets {
+		budgetOf(b.CostID).Before = b.Amount
+		output.Before.BudgetAmount = output.Before.BudgetAmount.Add(b.Amount)
+	}
+	for _, b := range afterBudgets {
+		budgetOf(b.CostID).After = b.Amount
+		output.After.BudgetAmount = output.After.BudgetAmount.Add(b.Amount)
+	}
+	for _, costID := range costIDs {
+		d := budgets[costID]
+		d.Delta = d.After.Sub(d.Before)
+		output.Budgets = append(output.Budgets, *d)
+	}
+
+	workloads := make(map[string]*workloadDeltaOutput)
+	effortIDs := make([]string, 0)
+	workloadOf := func(effortID string) *workloadDeltaOutput {
+		if _, ok := workloads[effortID]; !ok {
+			workloads[effortID] = &workloadDeltaOutput{EffortID: effortID}
+			effortIDs = append(effortIDs, effortID)
+		}
+		return workloads[effortID]
+	}
+	for _, w := range beforeWorkloads {
+		d := workloadOf(w.EffortID)
+		d.BeforeHours = w.Hours
+		d.Before = w.Amount
+		output.Before.LaborAmount = output.Before.LaborAmount.Add(w.Amount)
+	}
+	for _, w := range afterWorkloads {
+		d := workloadOf(w.EffortID)
+		d.AfterHours = w.Hours
+		d.After = w.Amount
+		output.After.LaborAmount = output.After.LaborAmount.Add(w.Amount)
+	}
+	for _, effortID := range effortIDs {
+		d := workloads[effortID]
+		d.Delta = d.After.Sub(d.Before)
+		output.Workloads = append(output.Workloads, *d)
+	}
+
+	output.Before.TotalAmount = output.Before.BudgetAmount.Add(output.Before.LaborAmount)
+	output.After.TotalAmount = output.After.BudgetAmount.Add(output.After.LaborAmount)
+	output.Delta = TotalsOutput{
+		BudgetAmount: output.After.BudgetAmount.Sub(output.Before.BudgetAmount),
+		LaborAmount:  output.After.LaborAmount.Sub(output.Before.LaborAmount),
+		TotalAmount:  output.After.TotalAmount.Sub(output.Before.TotalAmount),
+	}
+
+	return output
 }
 
 func PortfolioOutputFromDb(p db.PortfolioRow) PortfolioOutput {
@@ -400,11 +875,13 @@ type BudgetOutput struct {
 	CostType           string                   `json:"cost_type"`
 	Description        string                   `json:"description"`
 	Comment            string                   `json:"comment"`
-	CostAmount         float64                  `json:"cost_amount"`
+	CostAmount         common.Money             `json:"cost_amount"`
 	CostCurrency       string                   `json:"cost_currency"`
 	CostTax            float64                  `json:"cost_tax"`
+	CostTaxProfile     string                   `json:"cost_tax_profile,omitempty"`
 	CostApplyInflation bool                     `json:"cost_apply_inflation"`
-	Amount             float64                  `json:"amount"`
+	Amount             common.Money             `json:"amount"`
+	Taxes              []budgetTaxOutput        `json:"taxes"`
 	BudgetAllocations  []budgetAllocationOutput `json:"budget_allocations"`
 	CreatedAt          time.Time                `json:"created_at"`
 	UpdatedAt          time.Time                `json:"updated_at"`
@@ -420,6 +897,11 @@ func BudgetOutputFromDb(budget db.BudgetRow, allocations []db.BudgetAllocation)
 		}
 	}
 
+	taxes := make([]budgetTaxOutput, len(budget.Taxes))
+	for i, tax := range budget.Taxes {
+		taxes[i] = budgetTaxOutput(tax)
+	}
+
 	return BudgetOutput{
 		BudgetID:           budget.BudgetID,
 		PortfolioID:        budget.PortfolioID,
@@ -429,18 +911,61 @@ func BudgetOutputFromDb(budget db.BudgetRow, allocations []db.BudgetAllocation)
 		CostAmount:         budget.CostAmount,
 		CostCurrency:       budget.CostCurrency,
 		CostTax:            budget.CostTax,
+		CostTaxProfile:     budget.CostTaxProfile.String,
 		CostApplyInflation: budget.CostApplyInflation,
 		Amount:             budget.Amount,
+		Taxes:              taxes,
 		BudgetAllocations:  allocs,
 		CreatedAt:          budget.CreatedAt.Time,
 		UpdatedAt:          budget.UpdatedAt.Time,
 	}
 }
 
+func BudgetOutputFromDomain(budget domain.Budget, cost domain.Cost, taxProfile string) BudgetOutput {
+	allocs := make([]budgetAllocationOutput, len(budget.BudgetAllocations))
+	for i, alloc := range budget.BudgetAllocations {
+		allocs[i] = budgetAllocationOutput{
+			Year:   alloc.AllocationDate.Year(),
+			Month:  int(alloc.AllocationDate.Month()),
+			Amount: alloc.Amount,
+		}
+	}
+
+	taxes := make([]budgetTaxOutput, len(budget.Taxes))
+	for i, tax := range budget.Taxes {
+		taxes[i] = budgetTaxOutput(tax)
+	}
+
+	return BudgetOutput{
+		BudgetID:           budget.BudgetID,
+		PortfolioID:        budget.PortfolioID,
+		CostType:           cost.CostType.String(),
+		Description:        cost.Description,
+		Comment:            cost.Comment,
+		CostAmount:         cost.Amount,
+		CostCurrency:       cost.Currency.String(),
+		CostTax:            cost.Tax,
+		CostTaxProfile:     taxProfile,
+		CostApplyInflation: cost.ApplyInflation,
+		Amount:             budget.Amount,
+		Taxes:              taxes,
+		BudgetAllocations:  allocs,
+		CreatedAt:          budget.CreatedAt,
+		UpdatedAt:          budget.UpdatedAt,
+	}
+}
+
+type budgetTaxOutput struct {
+	Name     string       `json:"name"`
+	Rate     float64      `json:"rate"`
+	Compound bool         `json:"compound"`
+	Amount   common.Money `json:"amount"`
+}
+
 type budgetAllocationOutput struct {
-	Year   int     `json:"year"`
-	Month  int     `json:"month"`
-	Amount float64 `json:"amount"`
+	Year   int          `json:"year"`
+	Month  int          `json:"month"`
+	Amount common.Money `json:"amount"`
 }
 
 func (o BudgetOutput) MarshalJSON() ([]byte, error) {
@@ -467,6 +992,7 @@ type WorkloadOutput struct {
 	CompetenceName      string                     `json:"competence_name"`
 	Comment             string                     `json:"comment"`
 	Hours               int                        `json:"hours"`
+	Amount              common.Money               `json:"amount"`
 	WorkloadAllocations []workloadAllocationOutput `json:"workload_allocations"`
 	CreatedAt           time.Time                  `json:"created_at"`
 	UpdatedAt           time.Time                  `json:"updated_at"`
@@ -476,9 +1002,10 @@ func WorkloadOutputFromDb(workload db.WorkloadRow, allocations []db.WorkloadAllo
 	allocs := make([]workloadAllocationOutput, len(allocations))
 	for i, alloc := range allocations {
 		allocs[i] = workloadAllocationOutput{
-			Year:  alloc.AllocationDate.Time.Year(),
-			Month: int(alloc.AllocationDate.Time.Month()),
-			Hours: int(alloc.Hours),
+			Year:   alloc.AllocationDate.Time.Year(),
+			Month:  int(alloc.AllocationDate.Time.Month()),
+			Hours:  int(alloc.Hours),
+			Amount: alloc.Amount,
 		}
 	}
 
@@ -489,16 +1016,43 @@ func WorkloadOutputFromDb(workload db.WorkloadRow, allocations []db.WorkloadAllo
 		CompetenceName:      workload.CompetenceName,
 		Comment:             workload.Comment.String,
 		Hours:               int(workload.Hours),
+		Amount:              workload.Amount,
 		WorkloadAllocations: allocs,
 		CreatedAt:           workload.CreatedAt.Time,
 		UpdatedAt:           workload.UpdatedAt.Time,
 	}
 }
 
+func WorkloadOutputFromDomain(workload domain.Workload, effort domain.Effort, competence domain.Competence) WorkloadOutput {
+	allocs := make([]workloadAllocationOutput, len(workload.WorkloadAllocations))
+	for i, alloc := range workload.WorkloadAllocations {
+		allocs[i] = workloadAllocationOutput{
+			Year:   alloc.AllocationDate.Year(),
+			Month:  int(alloc.AllocationDate.Month()),
+			Hours:  alloc.Hours,
+			Amount: alloc.Amount,
+		}
+	}
+
+	return WorkloadOutput{
+		WorkloadID:          workload.WorkloadID,
+		PortfolioID:         workload.PortfolioID,
+		CompetenceCode:      competence.Code,
+		CompetenceName:      competence.Name,
+		Comment:             effort.Comment,
+		Hours:               workload.Hours,
+		Amount:              workload.Amount,
+		WorkloadAllocations: allocs,
+		CreatedAt:           workload.CreatedAt,
+		UpdatedAt:           workload.UpdatedAt,
+	}
+}
+
 type workloadAllocationOutput struct {
-	Year  int `json:"year"`
-	Month int `json:"month"`
-	Hours int `json:"hours"`
+	Year   int          `json:"year"`
+	Month  int          `json:"month"`
+	Hours  int          `json:"hours"`
+	Amount common.Money `json:"amount"`
 }
 
 func (o WorkloadOutput) MarshalJSON() ([]byte, error) {
@@ -530,3 +1084,1018 @@ func fmtRFC3339Time(createdAt, updatedAt time.Time) (createdAtStr *string, updat
 	}
 	return
 }
+
+// BaselineDiffOutput has what changed in a baseline compared with the baseline it is diffed against
+type BaselineDiffOutput struct {
+	BaselineID string             `json:"baseline_id"`
+	AgainstID  string             `json:"against_id"`
+	Costs      []costDiffOutput   `json:"costs"`
+	Efforts    []effortDiffOutput `json:"efforts"`
+}
+
+type costDiffOutput struct {
+	Status      string                `json:"status"`
+	CostType    string                `json:"cost_type"`
+	Description string                `json:"description"`
+	Changes     []fieldChangeOutput   `json:"changes"`
+	From        common.Money          `json:"from_amount"`
+	To          common.Money          `json:"to_amount"`
+	Delta       common.Money          `json:"delta"`
+	Months      []costMonthDiffOutput `json:"months"`
+}
+
+type effortDiffOutput struct {
+	Status         string                  `json:"status"`
+	CompetenceID   string                  `json:"competence_id"`
+	CompetenceCode string                  `json:"competence_code"`
+	CompetenceName string                  `json:"competence_name"`
+	Changes        []fieldChangeOutput     `json:"changes"`
+	From           int                     `json:"from_hours"`
+	To             int                     `json:"to_hours"`
+	Delta          int                     `json:"delta"`
+	Months         []effortMonthDiffOutput `json:"months"`
+}
+
+type fieldChangeOutput struct {
+	Field string `json:"field"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
+
+type costMonthDiffOutput struct {
+	Year  int          `json:"year"`
+	Month int          `json:"month"`
+	From  common.Money `json:"from"`
+	To    common.Money `json:"to"`
+	Delta common.Money `json:"delta"`
+}
+
+type effortMonthDiffOutput struct {
+	Year  int `json:"year"`
+	Month int `json:"month"`
+	From  int `json:"from"`
+	To    int `json:"to"`
+	Delta int `json:"delta"`
+}
+
+func BaselineDiffOutputFromDomain(baselineID, againstID string, diff domain.BaselineDiff, competences map[string]*domain.Competence) BaselineDiffOutput {
+	output := BaselineDiffOutput{
+		BaselineID: baselineID,
+		AgainstID:  againstID,
+		Costs:      make([]costDiffOutput, len(diff.Costs)),
+		Efforts:    make([]effortDiffOutput, len(diff.Efforts)),
+	}
+
+	for i, c := range diff.Costs {
+		months := make([]costMonthDiffOutput, len(c.Months))
+		for j, m := range c.Months {
+			months[j] = costMonthDiffOutput{
+				Year:  m.Year,
+				Month: int(m.Month),
+				From:  m.From,
+				To:    m.To,
+				Delta: m.To.Sub(m.From),
+			}
+		}
+		output.Costs[i] = costDiffOutput{
+			Status:      c.Status.String(),
+			CostType:    c.CostType.String(),
+			Description: c.Description,
+			Changes:     fieldChangesOutput(c.Changes),
+			From:        c.From,
+			To:          c.To,
+			Delta:       c.To.Sub(c.From),
+			Months:      months,
+		}
+	}
+
+	for i, e := range diff.Efforts {
+		months := make([]effortMonthDiffOutput, len(e.Months))
+		for j, m := range e.Months {
+			months[j] = effortMonthDiffOutput{
+				Year:  m.Year,
+				Month: int(m.Month),
+				From:  m.From,
+				To:    m.To,
+				Delta: m.To - m.From,
+			}
+		}
+		output.Efforts[i] = effortDiffOutput{
+			Status:       e.Status.String(),
+			CompetenceID: e.CompetenceID,
+			Changes:      fieldChangesOutput(e.Changes),
+			From:         e.From,
+			To:           e.To,
+			Delta:        e.To - e.From,
+			Months:       months,
+		}
+		if competence, ok := competences[e.CompetenceID]; ok {
+			output.Efforts[i].CompetenceCode = competence.Code
+			output.Efforts[i].CompetenceName = competence.Name
+		}
+	}
+
+	return output
+}
+
+func fieldChangesOutput(changes []domain.FieldChange) []fieldChangeOutput {
+	output := make([]fieldChangeOutput, len(changes))
+	for i, c := range changes {
+		output[i] = fieldChangeOutput(c)
+	}
+	return output
+}
+
+// PlanVarianceOutput has the budget and workload deltas of a plan compared with the plan it is compared against
+type PlanVarianceOutput struct {
+	PlanID          string                   `json:"plan_id"`
+	PlanCode        string                   `json:"plan_code"`
+	AgainstPlanID   string                   `json:"against_plan_id"`
+	AgainstPlanCode string                   `json:"against_plan_code"`
+	Months          []periodVarianceOutput   `json:"months"`
+	Years           []periodVarianceOutput   `json:"years"`
+	Baselines       []baselineVarianceOutput `json:"baselines"`
+}
+
+type baselineVarianceOutput struct {
+	Code               string                 `json:"code"`
+	Status             string                 `json:"status"`
+	PortfolioID        string                 `json:"portfolio_id,omitempty"`
+	AgainstPortfolioID string                 `json:"against_portfolio_id,omitempty"`
+	Months             []periodVarianceOutput `json:"months"`
+	Years              []periodVarianceOutput `json:"years"`
+}
+
+type periodVarianceOutput struct {
+	Year      int            `json:"year"`
+	Month     int            `json:"month,omitempty"`
+	Budget    varianceOutput `json:"budget"`
+	Workload  varianceOutput `json:"workload"`
+	FromHours int            `json:"from_hours"`
+	ToHours   int            `json:"to_hours"`
+}
+
+type varianceOutput struct {
+	From      common.Money `json:"from"`
+	To        common.Money `json:"to"`
+	Delta     common.Money `json:"delta"`
+	Shift     common.Money `json:"shift"`
+	Inflation common.Money `json:"inflation"`
+	Exchange  common.Money `json:"exchange"`
+	Other     common.Money `json:"other"`
+}
+
+func PlanVarianceOutputFromDomain(plan, against domain.Plan, variance domain.PlanVariance) PlanVarianceOutput {
+	output := PlanVarianceOutput{
+		PlanID:          plan.PlanID,
+		PlanCode:        plan.Code,
+		AgainstPlanID:   against.PlanID,
+		AgainstPlanCode: against.Code,
+		Months:          periodVariancesOutput(variance.Months()),
+		Years:           periodVariancesOutput(variance.Years()),
+		Baselines:       make([]baselineVarianceOutput, len(variance.Baselines)),
+	}
+
+	for i, b := range variance.Baselines {
+		output.Baselines[i] = baselineVarianceOutput{
+			Code:               b.Code,
+			Status:             b.Status.String(),
+			PortfolioID:        b.ToPortfolioID,
+			AgainstPortfolioID: b.FromPortfolioID,
+			Months:             periodVariancesOutput(b.Months),
+			Years:              periodVariancesOutput(b.Years()),
+		}
+	}
+
+	return output
+}
+
+func periodVariancesOutput(periods []domain.PeriodVariance) []periodVarianceOutput {
+	output := make([]periodVarianceOutput, len(periods))
+	for i, p := range periods {
+		output[i] = periodVarianceOutput{
+			Year:      p.Year,
+			Month:     int(p.Month),
+			Budget:    varianceOutputFrom(p.Budget),
+			Workload:  varianceOutputFrom(p.Workload),
+			FromHours: p.FromHours,
+			ToHours:   p.ToHours,
+		}
+	}
+	return output
+}
+
+func varianceOutputFrom(v domain.Variance) varianceOutput {
+	return varianceOutput{
+		From:      v.From,
+		To:        v.To,
+		Delta:     v.Delta(),
+		Shift:     v.Shift,
+		Inflation: v.Inflation,
+		Exchange:  v.Exchange,
+		Other:     v.Other(),
+	}
+}
+
+// PlanSummaryOutput has the totals of every portfolio of a plan by month and by year
+type PlanSummaryOutput struct {
+	PlanID   string                `json:"plan_id"`
+	PlanCode string                `json:"plan_code"`
+	Months   []periodSummaryOutput `json:"months"`
+	Years    []periodSummaryOutput `json:"years"`
+}
+
+type periodSummaryOutput struct {
+	Year         int                       `json:"year"`
+	Month        int                       `json:"month,omitempty"`
+	BudgetAmount common.Money              `json:"budget_amount"`
+	LaborAmount  common.Money              `json:"labor_amount"`
+	TotalAmount  common.Money              `json:"total_amount"`
+	Hours        int                       `json:"hours"`
+	CostTypes    []costTypeSummaryOutput   `json:"cost_types"`
+	Currencies   []currencySummaryOutput   `json:"currencies"`
+	Managers     []managerSummaryOutput    `json:"managers"`
+	Competences  []competenceSummaryOutput `json:"competences"`
+}
+
+type costTypeSummaryOutput struct {
+	CostType string       `json:"cost_type"`
+	Amount   common.Money `json:"amount"`
+}
+
+type currencySummaryOutput struct {
+	Currency string       `json:"currency"`
+	Amount   common.Money `json:"amount"`
+}
+
+type managerSummaryOutput struct {
+	ManagerID string       `json:"manager_id"`
+	Manager   string       `json:"manager"`
+	Amount    common.Money `json:"amount"`
+}
+
+type competenceSummaryOutput struct {
+	CompetenceID string       `json:"competence_id"`
+	Code         string       `json:"code"`
+	Name         string       `json:"name"`
+	Hours        int          `json:"hours"`
+	Amount       common.Money `json:"amount"`
+}
+
+// PlanSummaryOutputFromDb groups the totals of the plan by period. The totals are summed by the queries,
+// where month zero is the total of the year.
+func PlanSummaryOutputFromDb(
+	plan db.Plan,
+	periods []db.FindPlanAllocationsGroupedByMonthRow,
+	costTypes []db.FindPlanBudgetsGroupedByCostTypeRow,
+	currencies []db.FindPlanBudgetsGroupedByCurrencyRow,
+	managers []db.FindPlanBudgetsGroupedByManagerRow,
+	competences []db.FindPlanWorkloadsGroupedByCompetenceRow,
+) PlanSummaryOutput {
+	type period struct {
+		year  int32
+		month int32
+	}
+
+	summaries := make(map[period]*periodSummaryOutput, len(periods))
+	output := PlanSummaryOutput{
+		PlanID:   plan.PlanID,
+		PlanCode: plan.Code,
+		Months:   make([]periodSummaryOutput, 0),
+		Years:    make([]periodSummaryOutput, 0),
+	}
+	for _, p := range periods {
+		summaries[period{p.Year, p.Month}] = &periodSummaryOutput{
+			Year:         int(p.Year),
+			Month:        int(p.Month),
+			BudgetAmount: p.BudgetAmount,
+			LaborAmount:  p.LaborAmount,
+			TotalAmount:  p.TotalAmount,
+			Hours:        int(p.Hours),
+			CostTypes:    make([]costTypeSummaryOutput, 0),
+			Currencies:   make([]currencySummaryOutput, 0),
+			Managers:     make([]managerSummaryOutput, 0),
+			Competences:  make([]competenceSummaryOutput, 0),
+		}
+	}
+
+	for _, c := range costTypes {
+		s := summaries[period{c.Year, c.Month}]
+		s.CostTypes = append(s.CostTypes, costTypeSummaryOutput{c.CostType, c.Amount})
+	}
+	for _, c := range currencies {
+		s := summaries[period{c.Year, c.Month}]
+		s.Currencies = append(s.Currencies, currencySummaryOutput{c.Currency, c.Amount})
+	}
+	for _, m := range managers {
+		s := summaries[period{m.Year, m.Month}]
+		s.Managers = append(s.Managers, managerSummaryOutput{m.ManagerID, m.Manager, m.Amount})
+	}
+	for _, c := range competences {
+		s := summaries[period{c.Year, c.Month}]
+		s.Competences = append(s.Competences, competenceSummaryOutput{c.CompetenceID, c.CompetenceCode, c.CompetenceName, int(c.Hours), c.Amount})
+	}
+
+	for _, p := range periods {
+		s := summaries[period{p.Year, p.Month}]
+		if p.Month == 0 {
+			output.Years = append(output.Years, *s)
+		} else {
+			output.Months = append(output.Months, *s)
+		}
+	}
+
+	return output
+}
+
+// CapacityReportOutput compares the hours allocated to each competence in a month of the plan with its capacity.
+// A month without a capacity for the competence has no capacity hours and is reported as missing capacity, not over-allocated
+type CapacityReportOutput struct {
+	PlanID   string                `json:"plan_id"`
+	PlanCode string                `json:"plan_code"`
+	Months   []capacityMonthOutput `json:"months"`
+}
+
+type capacityMonthOutput struct {
+	Year            int                      `json:"year"`
+	Month           int                      `json:"month"`
+	CompetenceID    string                   `json:"competence_id"`
+	CompetenceCode  string                   `json:"competence_code"`
+	CompetenceName  string                   `json:"competence_name"`
+	CapacityHours   *int                     `json:"capacity_hours"`
+	AllocatedHours  int                      `json:"allocated_hours"`
+	AvailableHours  *int                     `json:"available_hours"`
+	MissingCapacity bool                     `json:"missing_capacity"`
+	OverAllocated   bool                     `json:"over_allocated"`
+	Baselines       []capacityBaselineOutput `json:"baselines"`
+}
+
+type capacityBaselineOutput struct {
+	BaselineID  string `json:"baseline_id"`
+	Code        string `json:"code"`
+	Review      int    `json:"review"`
+	Title       string `json:"title"`
+	PortfolioID string `json:"portfolio_id"`
+	Hours       int    `json:"hours"`
+}
+
+// CapacityReportOutputFromDb lists the baselines that contribute to each over-allocated month
+func CapacityReportOutputFromDb(plan db.Plan, months []db.FindCapacityAllocationsByPlanIdRow, baselines []db.FindOverAllocatedBaselinesByPlanIdRow) CapacityReportOutput {
+	type competenceMonth struct {
+		competenceID string
+		year         int32
+		month        int32
+	}
+
+	contributions := make(map[competenceMonth][]capacityBaselineOutput)
+	for _, b := range baselines {
+		key := competenceMonth{b.CompetenceID, b.Year, b.Month}
+		contributions[key] = append(contributions[key], capacityBaselineOutput{
+			BaselineID:  b.BaselineID,
+			Code:        b.BaselineCode,
+			Review:      int(b.BaselineReview),
+			Title:       b.BaselineTitle,
+			PortfolioID: b.PortfolioID,
+			Hours:       int(b.Hours),
+		})
+	}
+
+	output := CapacityReportOutput{
+		PlanID:   plan.PlanID,
+		PlanCode: plan.Code,
+		Months:   make([]capacityMonthOutput, len(months)),
+	}
+	for i, m := range months {
+		contributing, ok := contributions[competenceMonth{m.CompetenceID, m.Year, m.Month}]
+		if !ok {
+			contributing = make([]capacityBaselineOutput, 0)
+		}
+		output.Months[i] = capacityMonthOutput{
+			Year:            int(m.Year),
+			Month:           int(m.Month),
+			CompetenceID:    m.CompetenceID,
+			CompetenceCode:  m.CompetenceCode,
+			CompetenceName:  m.CompetenceName,
+			CapacityHours:   fmtInt4(m.CapacityHours),
+			AllocatedHours:  int(m.AllocatedHours),
+			AvailableHours:  fmtInt4(m.AvailableHours),
+			MissingCapacity: m.MissingCapacity,
+			OverAllocated:   m.OverAllocated,
+			Baselines:       contributing,
+		}
+	}
+
+	return output
+}
+
+func fmtInt4(i pgtype.Int4) *int {
+	if !i.Valid {
+		return nil
+	}
+	hours := int(i.Int32)
+	return &hours
+}
+
+type ActualOutput struct {
+	ActualID        string       `json:"actual_id"`
+	PortfolioID     string       `json:"portfolio_id"`
+	CostID          string       `json:"cost_id"`
+	CostType        string       `json:"cost_type,omitempty"`
+	CostDescription string       `json:"cost_description,omitempty"`
+	Year            int          `json:"year"`
+	Month           int          `json:"month"`
+	Amount          common.Money `json:"amount"`
+	Comment         string       `json:"comment"`
+	CreatedAt       time.Time    `json:"created_at"`
+	UpdatedAt       time.Time    `json:"updated_at"`
+}
+
+func ActualOutputFromDomain(a domain.Actual) ActualOutput {
+	return ActualOutput{
+		ActualID:    a.ActualID,
+		PortfolioID: a.PortfolioID,
+		CostID:      a.CostID,
+		Year:        a.SpendDate.Year(),
+		Month:       int(a.SpendDate.Month()),
+		Amount:      a.Amount,
+		Comment:     a.Comment,
+		CreatedAt:   a.CreatedAt,
+		UpdatedAt:   a.UpdatedAt,
+	}
+}
+
+func ActualOutputFromDb(a db.FindActualsByPortfolioIdWithRelationsRow) ActualOutput {
+	return ActualOutput{
+		ActualID:        a.ActualID,
+		PortfolioID:     a.PortfolioID,
+		CostID:          a.CostID,
+		CostType:        a.CostType,
+		CostDescription: a.CostDescription,
+		Year:            a.SpendDate.Time.Year(),
+		Month:           int(a.SpendDate.Time.Month()),
+		Amount:          a.Amount,
+		Comment:         a.Comment.String,
+		CreatedAt:       a.CreatedAt.Time,
+		UpdatedAt:       a.UpdatedAt.Time,
+	}
+}
+
+func (o ActualOutput) MarshalJSON() ([]byte, error) {
+	type Dup ActualOutput
+
+	tmp := struct {
+		Dup
+		CreatedAt *string `json:"created_at"`
+		UpdatedAt *string `json:"updated_at"`
+	}{
+		Dup: (Dup)(o),
+	}
+
+	tmp.CreatedAt, tmp.UpdatedAt = fmtRFC3339Time(o.CreatedAt, o.UpdatedAt)
+
+	b, err := json.Marshal(tmp)
+	return b, err
+}
+
+// BudgetVsActualOutput compares the budget of a portfolio with its actual spend, in total and for each cost
+type BudgetVsActualOutput struct {
+	PortfolioID string              `json:"portfolio_id"`
+	Budget      common.Money        `json:"budget"`
+	Actual      common.Money        `json:"actual"`
+	Remaining   common.Money        `json:"remaining"`
+	Burn        float64             `json:"burn"`
+	Months      []monthActualOutput `json:"months"`
+	Costs       []costActualOutput  `json:"costs"`
+}
+
+type costActualOutput struct {
+	CostID      string              `json:"cost_id"`
+	CostType    string              `json:"cost_type"`
+	Description string              `json:"description"`
+	Budget      common.Money        `json:"budget"`
+	Actual      common.Money        `json:"actual"`
+	Remaining   common.Money        `json:"remaining"`
+	Burn        float64             `json:"burn"`
+	Months      []monthActualOutput `json:"months"`
+}
+
+type monthActualOutput struct {
+	Year             int          `json:"year"`
+	Month            int          `json:"month"`
+	Budget           common.Money `json:"budget"`
+	Actual           common.Money `json:"actual"`
+	Variance         common.Money `json:"variance"`
+	CumulativeBudget common.Money `json:"cumulative_budget"`
+	CumulativeActual common.Money `json:"cumulative_actual"`
+	Remaining        common.Money `json:"remaining"`
+}
+
+func BudgetVsActualOutputFromDomain(portfolioID string, comparison domain.PortfolioActual, costs []*domain.Cost) BudgetVsActualOutput {
+	costsByID := make(map[string]*domain.Cost, len(costs))
+	for _, c := range costs {
+		costsByID[c.CostID] = c
+	}
+
+	output := BudgetVsActualOutput{
+		PortfolioID: portfolioID,
+		Budget:      comparison.Budget,
+		Actual:      comparison.Actual,
+		Remaining:   comparison.Remaining(),
+		Burn:        comparison.Burn(),
+		Months:      monthActualsOutput(comparison.Months),
+		Costs:       make([]costActualOutput, len(comparison.Costs)),
+	}
+
+	for i, c := range comparison.Costs {
+		output.Costs[i] = costActualOutput{
+			CostID:    c.CostID,
+			Budget:    c.Budget,
+			Actual:    c.Actual,
+			Remaining: c.Remaining(),
+			Burn:      c.Burn(),
+			Months:    monthActualsOutput(c.Months),
+		}
+		if cost, ok := costsByID[c.CostID]; ok {
+			output.Costs[i].CostType = cost.CostType.String()
+			output.Costs[i].Description = cost.Description
+		}
+	}
+
+	return output
+}
+
+func monthActualsOutput(months []domain.MonthActual) []monthActualOutput {
+	output := make([]monthActualOutput, len(months))
+	for i, m := range months {
+		output[i] = monthActualOutput{
+			Year:             m.Year,
+			Month:            int(m.Month),
+			Budget:           m.Budget,
+			Actual:           m.Actual,
+			Variance:         m.Variance(),
+			CumulativeBudget: m.CumulativeBudget,
+			CumulativeActual: m.CumulativeActual,
+			Remaining:        m.Remaining,
+		}
+	}
+	return output
+}
+
+type TimesheetOutput struct {
+	TimesheetID    string    `json:"timesheet_id"`
+	PortfolioID    string    `json:"portfolio_id"`
+	BaselineCode   string    `json:"baseline_code,omitempty"`
+	CompetenceID   string    `json:"competence_id"`
+	CompetenceCode string    `json:"competence_code,omitempty"`
+	Year           int       `json:"year"`
+	Month          int       `json:"month"`
+	Hours          int       `json:"hours"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+func TimesheetOutputFromDomain(t domain.Timesheet) TimesheetOutput {
+	return TimesheetOutput{
+		TimesheetID:  t.TimesheetID,
+		PortfolioID:  t.PortfolioID,
+		CompetenceID: t.CompetenceID,
+		Year:         t.WorkDate.Year(),
+		Month:        int(t.WorkDate.Month()),
+		Hours:        t.Hours,
+		CreatedAt:    t.CreatedAt,
+		UpdatedAt:    t.UpdatedAt,
+	}
+}
+
+func (o TimesheetOutput) MarshalJSON() ([]byte, error) {
+	type Dup TimesheetOutput
+
+	tmp := struct {
+		Dup
+		CreatedAt *string `json:"created_at"`
+		UpdatedAt *string `json:"updated_at"`
+	}{
+		Dup: (Dup)(o),
+	}
+
+	tmp.CreatedAt, tmp.UpdatedAt = fmtRFC3339Time(o.CreatedAt, o.UpdatedAt)
+
+	b, err := json.Marshal(tmp)
+	return b, err
+}
+
+// TimesheetReportOutput compares the hours planned for each competence of a portfolio with the hours worked,
+// flagging the ones that are over the plan by more than the threshold percentage
+type TimesheetReportOutput struct {
+	PlanID      string                      `json:"plan_id"`
+	PlanCode    string                      `json:"plan_code"`
+	Threshold   float64                     `json:"threshold"`
+	Competences []timesheetCompetenceOutput `json:"competences"`
+}
+
+type timesheetCompetenceOutput struct {
+	PortfolioID    string                 `json:"portfolio_id"`
+	BaselineCode   string                 `json:"baseline_code"`
+	CompetenceID   string                 `json:"competence_id"`
+	CompetenceCode string                 `json:"competence_code"`
+	CompetenceName string                 `json:"competence_name"`
+	PlannedHours   int                    `json:"planned_hours"`
+	ActualHours    int                    `json:"actual_hours"`
+	DeltaHours     int                    `json:"delta_hours"`
+	OverPercent    *float64               `json:"over_percent"`
+	OverThreshold  bool                   `json:"over_threshold"`
+	Months         []timesheetMonthOutput `json:"months"`
+}
+
+type timesheetMonthOutput struct {
+	Year          int      `json:"year"`
+	Month         int      `json:"month"`
+	PlannedHours  int      `json:"planned_hours"`
+	ActualHours   int      `json:"actual_hours"`
+	DeltaHours    int      `json:"delta_hours"`
+	OverPercent   *float64 `json:"over_percent"`
+	OverThreshold bool     `json:"over_threshold"`
+}
+
+// TimesheetReportOutputFromDb groups the monthly rows under the total row of each portfolio competence,
+// which the query returns with year and month set to zero
+func TimesheetReportOutputFromDb(plan db.Plan, threshold float64, rows []db.FindTimesheetComparisonByPlanIdRow) TimesheetReportOutput {
+	type portfolioCompetence struct {
+		portfolioID  string
+		competenceID string
+	}
+
+	months := make(map[portfolioCompetence][]timesheetMonthOutput)
+	for _, r := range rows {
+		if r.Year == 0 {
+			continue
+		}
+		key := portfolioCompetence{r.PortfolioID, r.CompetenceID}
+		months[key] = append(months[key], timesheetMonthOutput{
+			Year:          int(r.Year),
+			Month:         int(r.Month),
+			PlannedHours:  int(r.PlannedHours),
+			ActualHours:   int(r.ActualHours),
+			DeltaHours:    int(r.DeltaHours),
+			OverPercent:   fmtFloat8(r.OverPercent),
+			OverThreshold: r.OverThreshold,
+		})
+	}
+
+	output := TimesheetReportOutput{
+		PlanID:      plan.PlanID,
+		PlanCode:    plan.Code,
+		Threshold:   threshold,
+		Competences: make([]timesheetCompetenceOutput, 0),
+	}
+	for _, r := range rows {
+		if r.Year != 0 {
+			continue
+		}
+		output.Competences = append(output.Competences, timesheetCompetenceOutput{
+			PortfolioID:    r.PortfolioID,
+			BaselineCode:   r.BaselineCode,
+			CompetenceID:   r.CompetenceID,
+			CompetenceCode: r.CompetenceCode,
+			CompetenceName: r.CompetenceName,
+			PlannedHours:   int(r.PlannedHours),
+			ActualHours:    int(r.ActualHours),
+			DeltaHours:     int(r.DeltaHours),
+			OverPercent:    fmtFloat8(r.OverPercent),
+			OverThreshold:  r.OverThreshold,
+			Months:         months[portfolioCompetence{r.PortfolioID, r.CompetenceID}],
+		})
+	}
+
+	return output
+}
+
+func fmtFloat8(f pgtype.Float8) *float64 {
+	if !f.Valid {
+		return nil
+	}
+	return &f.Float64
+}
+
+type ProgressOutput struct {
+	ProgressID      string    `json:"progress_id"`
+	PortfolioID     string    `json:"portfolio_id"`
+	CostID          string    `json:"cost_id,omitempty"`
+	CostType        string    `json:"cost_type,omitempty"`
+	CostDescription string    `json:"cost_description,omitempty"`
+	EffortID        string    `json:"effort_id,omitempty"`
+	CompetenceCode  string    `json:"competence_code,omitempty"`
+	CompetenceName  string    `json:"competence_name,omitempty"`
+	Year            int       `json:"year"`
+	Month           int       `json:"month"`
+	PercentComplete float64   `json:"percent_complete"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+func ProgressOutputFromDomain(p domain.Progress) ProgressOutput {
+	return ProgressOutput{
+		ProgressID:      p.ProgressID,
+		PortfolioID:     p.PortfolioID,
+		CostID:          p.CostID,
+		EffortID:        p.EffortID,
+		Year:            p.ProgressDate.Year(),
+		Month:           int(p.ProgressDate.Month()),
+		PercentComplete: p.PercentComplete,
+		CreatedAt:       p.CreatedAt,
+		UpdatedAt:       p.UpdatedAt,
+	}
+}
+
+func ProgressOutputFromDb(p db.FindProgressByPortfolioIdWithRelationsRow) ProgressOutput {
+	return ProgressOutput{
+		ProgressID:      p.ProgressID,
+		PortfolioID:     p.PortfolioID,
+		CostID:          p.CostID.String,
+		CostType:        p.CostType.String,
+		CostDescription: p.CostDescription.String,
+		EffortID:        p.EffortID.String,
+		CompetenceCode:  p.CompetenceCode.String,
+		CompetenceName:  p.CompetenceName.String,
+		Year:            p.ProgressDate.Time.Year(),
+		Month:           int(p.ProgressDate.Time.Month()),
+		PercentComplete: p.PercentComplete,
+		CreatedAt:       p.CreatedAt.Time,
+		UpdatedAt:       p.UpdatedAt.Time,
+	}
+}
+
+func (o ProgressOutput) MarshalJSON() ([]byte, error) {
+	type Dup ProgressOutput
+
+	tmp := struct {
+		Dup
+		CreatedAt *string `json:"created_at"`
+		UpdatedAt *string `json:"updated_at"`
+	}{
+		Dup: (Dup)(o),
+	}
+
+	tmp.CreatedAt, tmp.UpdatedAt = fmtRFC3339Time(o.CreatedAt, o.UpdatedAt)
+
+	b, err := json.Marshal(tmp)
+	return b, err
+}
+
+// EarnedValueOutput is the earned value of a portfolio by month, in total and for each cost and effort.
+// The top level values are the ones of the last month.
+type EarnedValueOutput struct {
+	PortfolioID string `json:"portfolio_id"`
+	earnedValueStatusOutput
+	Months  []monthEarnedValueOutput  `json:"months"`
+	Costs   []costEarnedValueOutput   `json:"costs"`
+	Efforts []effortEarnedValueOutput `json:"efforts"`
+}
+
+type costEarnedValueOutput struct {
+	CostID      string `json:"cost_id"`
+	CostType    string `json:"cost_type"`
+	Description string `json:"description"`
+	earnedValueStatusOutput
+	Months []monthEarnedValueOutput `json:"months"`
+}
+
+type effortEarnedValueOutput struct {
+	EffortID     string `json:"effort_id"`
+	CompetenceID string `json:"competence_id"`
+	Comment      string `json:"comment"`
+	earnedValueStatusOutput
+	Months []monthEarnedValueOutput `json:"months"`
+}
+
+type earnedValueStatusOutput struct {
+	BAC             common.Money `json:"bac"`
+	PlannedValue    common.Money `json:"planned_value"`
+	EarnedValue     common.Money `json:"earned_value"`
+	ActualCost      common.Money `json:"actual_cost"`
+	PercentComplete float64      `json:"percent_complete"`
+	CPI             *float64     `json:"cpi"`
+	SPI             *float64     `json:"spi"`
+	EAC             common.Money `json:"eac"`
+	ETC             common.Money `json:"etc"`
+	VAC             common.Money `json:"vac"`
+}
+
+type monthEarnedValueOutput struct {
+	Year            int          `json:"year"`
+	Month           int          `json:"month"`
+	PlannedValue    common.Money `json:"planned_value"`
+	EarnedValue     common.Money `json:"earned_value"`
+	ActualCost      common.Money `json:"actual_cost"`
+	PercentComplete float64      `json:"percent_complete"`
+	CPI             *float64     `json:"cpi"`
+	SPI             *float64     `json:"spi"`
+	EAC             common.Money `json:"eac"`
+	ETC             common.Money `json:"etc"`
+	VAC             common.Money `json:"vac"`
+}
+
+func EarnedValueOutputFromDomain(portfolioID string, earnedValue domain.PortfolioEarnedValue, costs []*domain.Cost, efforts []*domain.Effort) EarnedValueOutput {
+	costsByID := make(map[string]*domain.Cost, len(costs))
+	for _, c := range costs {
+		costsByID[c.CostID] = c
+	}
+	effortsByID := make(map[string]*domain.Effort, len(efforts))
+	for _, e := range efforts {
+		effortsByID[e.EffortID] = e
+	}
+
+	output := EarnedValueOutput{
+		PortfolioID:             portfolioID,
+		earnedValueStatusOutput: earnedValueStatus(earnedValue.EarnedValue),
+		Months:                  monthEarnedValuesOutput(earnedValue.Months),
+		Costs:                   make([]costEarnedValueOutput, len(earnedValue.Costs)),
+		Efforts:                 make([]effortEarnedValueOutput, len(earnedValue.Efforts)),
+	}
+
+	for i, c := range earnedValue.Costs {
+		output.Costs[i] = costEarnedValueOutput{
+			CostID:                  c.CostID,
+			earnedValueStatusOutput: earnedValueStatus(c),
+			Months:                  monthEarnedValuesOutput(c.Months),
+		}
+		if cost, ok := costsByID[c.CostID]; ok {
+			output.Costs[i].CostType = cost.CostType.String()
+			output.Costs[i].Description = cost.Description
+		}
+	}
+
+	for i, e := range earnedValue.Efforts {
+		output.Efforts[i] = effortEarnedValueOutput{
+			EffortID:                e.EffortID,
+			earnedValueStatusOutput: earnedValueStatus(e),
+			Months:                  monthEarnedValuesOutput(e.Months),
+		}
+		if effort, ok := effortsByID[e.EffortID]; ok {
+			output.Efforts[i].CompetenceID = effort.CompetenceID
+			output.Efforts[i].Comment = effort.Comment
+		}
+	}
+
+	return output
+}
+
+func earnedValueStatus(e domain.EarnedValue) earnedValueStatusOutput {
+	current := e.Current()
+	return earnedValueStatusOutput{
+		BAC:             e.BAC,
+		PlannedValue:    current.PlannedValue,
+		EarnedValue:     current.EarnedValue,
+		ActualCost:      current.ActualCost,
+		PercentComplete: current.PercentComplete,
+		CPI:             current.CPI,
+		SPI:             current.SPI,
+		EAC:             current.EAC,
+		ETC:             current.ETC,
+		VAC:             current.VAC,
+	}
+}
+
+func monthEarnedValuesOutput(months []domain.MonthEarnedValue) []monthEarnedValueOutput {
+	output := make([]monthEarnedValueOutput, len(months))
+	for i, m := range months {
+		output[i] = monthEarnedValueOutput{
+			Year:            m.Year,
+			Month:           int(m.Month),
+			PlannedValue:    m.PlannedValue,
+			EarnedValue:     m.EarnedValue,
+			ActualCost:      m.ActualCost,
+			PercentComplete: m.PercentComplete,
+			CPI:             m.CPI,
+			SPI:             m.SPI,
+			EAC:             m.EAC,
+			ETC:             m.ETC,
+			VAC:             m.VAC,
+		}
+	}
+	return output
+}
+
+// ForecastOutput is the budget of a portfolio re-phased after the actual spend, in total and for each cost
+type ForecastOutput struct {
+	PortfolioID string                `json:"portfolio_id"`
+	Strategy    string                `json:"strategy"`
+	AsOfYear    int                   `json:"as_of_year"`
+	AsOfMonth   int                   `json:"as_of_month"`
+	Budget      common.Money          `json:"budget"`
+	Actual      common.Money          `json:"actual"`
+	Remaining   common.Money          `json:"remaining"`
+	Forecast    common.Money          `json:"forecast"`
+	Months      []monthForecastOutput `json:"months"`
+	Costs       []costForecastOutput  `json:"costs"`
+}
+
+type costForecastOutput struct {
+	CostID      string                `json:"cost_id"`
+	CostType    string                `json:"cost_type"`
+	Description string                `json:"description"`
+	Budget      common.Money          `json:"budget"`
+	Actual      common.Money          `json:"actual"`
+	Remaining   common.Money          `json:"remaining"`
+	Forecast    common.Money          `json:"forecast"`
+	Months      []monthForecastOutput `json:"months"`
+}
+
+type monthForecastOutput struct {
+	Year     int          `json:"year"`
+	Month    int          `json:"month"`
+	Budget   common.Money `json:"budget"`
+	Actual   common.Money `json:"actual"`
+	Forecast common.Money `json:"forecast"`
+}
+
+func ForecastOutputFromDomain(portfolioID string, strategy string, forecast domain.PortfolioForecast, costs []*domain.Cost) ForecastOutput {
+	costsByID := make(map[string]*domain.Cost, len(costs))
+	for _, c := range costs {
+		costsByID[c.CostID] = c
+	}
+
+	output := ForecastOutput{
+		PortfolioID: portfolioID,
+		Strategy:    strategy,
+		AsOfYear:    forecast.AsOf.Year(),
+		AsOfMonth:   int(forecast.AsOf.Month()),
+		Budget:      forecast.Budget,
+		Actual:      forecast.Actual,
+		Remaining:   forecast.Remaining,
+		Forecast:    forecast.Forecast(),
+		Months:      monthForecastsOutput(forecast.Months),
+		Costs:       make([]costForecastOutput, len(forecast.Costs)),
+	}
+
+	for i, c := range forecast.Costs {
+		output.Costs[i] = costForecastOutput{
+			CostID:    c.CostID,
+			Budget:    c.Budget,
+			Actual:    c.Actual,
+			Remaining: c.Remaining,
+			Forecast:  c.Forecast(),
+			Months:    monthForecastsOutput(c.Months),
+		}
+		if cost, ok := costsByID[c.CostID]; ok {
+			output.Costs[i].CostType = cost.CostType.String()
+			output.Costs[i].Description = cost.Description
+		}
+	}
+
+	return output
+}
+
+func monthForecastsOutput(months []domain.MonthForecast) []monthForecastOutput {
+	output := make([]monthForecastOutput, len(months))
+	for i, m := range months {
+		output[i] = monthForecastOutput{
+			Year:     m.Year,
+			Month:    int(m.Month),
+			Budget:   m.Budget,
+			Actual:   m.Actual,
+			Forecast: m.Forecast,
+		}
+	}
+	return output
+}
+
+type AuditEntryOutput struct {
+	AuditID   string          `json:"audit_id"`
+	Actor     string          `json:"actor,omitempty"`
+	Action    string          `json:"action"`
+	Entity    string          `json:"entity"`
+	EntityID  string          `json:"entity_id"`
+	Before    json.RawMessage `json:"before"`
+	After     json.RawMessage `json:"after"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+func AuditEntryOutputFromDb(a db.AuditLog) AuditEntryOutput {
+	return AuditEntryOutput{
+		AuditID:   a.AuditID,
+		Actor:     a.Actor.String,
+		Action:    a.Action,
+		Entity:    a.Entity,
+		EntityID:  a.EntityID,
+		Before:    a.Before,
+		After:     a.After,
+		CreatedAt: a.CreatedAt.Time,
+	}
+}
+
+func (o AuditEntryOutput) MarshalJSON() ([]byte, error) {
+	type Dup AuditEntryOutput
+
+	tmp := struct {
+		Dup
+		CreatedAt *string `json:"created_at"`
+	}{
+		Dup: (Dup)(o),
+	}
+
+	tmp.CreatedAt, _ = fmtRFC3339Time(o.CreatedAt, time.Time{})
+
+	b, err := json.Marshal(tmp)
+	return b, err
+}
@@ -0,0 +1,29 @@
+package service
+
+import (
+	"context"
+
+	"github.com/celsopires1999/estimation/internal/mapper"
+)
+
+func (s *EstimationService) ListActualsByPortfolioID(ctx context.Context, input ListActualsInputDTO) (*ListActualsOutputDTO, error) {
+	actuals, err := s.queries.FindActualsByPortfolioIdWithRelations(ctx, input.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+
+	actualsOutput := make([]mapper.ActualOutput, len(actuals))
+	for i, actual := range actuals {
+		actualsOutput[i] = mapper.ActualOutputFromDb(actual)
+	}
+
+	return &ListActualsOutputDTO{Actuals: actualsOutput}, nil
+}
+
+type ListActualsInputDTO struct {
+	PortfolioID string `json:"portfolio_id"`
+}
+
+type ListActualsOutputDTO struct {
+	Actuals []mapper.ActualOutput `json:"actuals"`
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/celsopires1999/estimation/internal/infra/db"
+	"github.com/celsopires1999/estimation/internal/mapper"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func (s *EstimationService) ListAuditEntries(ctx context.Context, input ListAuditEntriesInputDTO) (*ListAuditEntriesOutputDTO, error) {
+	params := db.FindAuditEntriesParams{
+		Entity:     pgtype.Text{String: input.Entity, Valid: input.Entity != ""},
+		EntityID:   pgtype.Text{String: input.EntityID, Valid: input.EntityID != ""},
+		PageLimit:  int32(input.Limit),
+		PageOffset: int32(input.Offset),
+	}
+	if input.Since != nil {
+		params.Since = pgtype.Timestamp{Time: *input.Since, Valid: true}
+	}
+
+	entries, err := s.queries.FindAuditEntries(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	entriesOutput := make([]mapper.AuditEntryOutput, len(entries))
+	for i, entry := range entries {
+		entriesOutput[i] = mapper.AuditEntryOutputFromDb(entry)
+	}
+
+	return &ListAuditEntriesOutputDTO{entriesOutput, input.Limit, input.Offset}, nil
+}
+
+// DefaultAuditLimit is the page size of the audit log when the request does not give one
+const DefaultAuditLimit = 100
+
+// ListAuditEntriesInputDTO filters the audit log by the entity, the entity ID and the time the change was made from;
+// a filter that is not given matches every entry. Limit and Offset page through the entries, oldest first
+type ListAuditEntriesInputDTO struct {
+	Entity   string     `json:"entity" validate:"omitempty,oneof=user plan baseline cost effort competence portfolio"`
+	EntityID string     `json:"id" validate:"omitempty,uuid4"`
+	Since    *time.Time `json:"since"`
+	Limit    int        `json:"limit" validate:"gte=1,lte=500"`
+	Offset   int        `json:"offset" validate:"gte=0"`
+}
+
+type ListAuditEntriesOutputDTO struct {
+	Entries []mapper.AuditEntryOutput `json:"entries"`
+	Limit   int                       `json:"limit"`
+	Offset  int                       `json:"offset"`
+}
@@ -51,3 +51,32 @@ type ListBaselinesInputDTO struct{}
 type ListBaselinesOutputDTO struct {
 	Baselines []mapper.BaselineOutput `json:"baselines"`
 }
+
+func (s *EstimationService) ListBaselineTransitions(ctx context.Context, input ListBaselineTransitionsInputDTO) (*ListBaselineTransitionsOutputDTO, error) {
+	if _, err := s.queries.FindBaselineById(ctx, input.BaselineID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, common.NewNotFoundError(fmt.Errorf("baseline with id %s not found", input.BaselineID))
+		}
+		return nil, err
+	}
+
+	transitions, err := s.queries.FindBaselineTransitionsByBaselineId(ctx, input.BaselineID)
+	if err != nil {
+		return nil, err
+	}
+
+	transitionsOutput := make([]mapper.BaselineTransitionOutput, len(transitions))
+	for i, transition := range transitions {
+		transitionsOutput[i] = mapper.BaselineTransitionOutputFromDb(transition)
+	}
+
+	return &ListBaselineTransitionsOutputDTO{transitionsOutput}, nil
+}
+
+type ListBaselineTransitionsInputDTO struct {
+	BaselineID string
+}
+
+type ListBaselineTransitionsOutputDTO struct {
+	Transitions []mapper.BaselineTransitionOutput `json:"transitions"`
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/celsopires1999/estimation/internal/mapper"
+	"github.com/jackc/pgx/v5"
+)
+
+func (s *EstimationService) ListCapacitiesByPlanID(ctx context.Context, input ListCapacitiesInputDTO) (*ListCapacitiesOutputDTO, error) {
+	capacities, err := s.queries.FindCapacitiesByPlanIdWithRelations(ctx, input.PlanID)
+	if err != nil {
+		return nil, err
+	}
+
+	capacitiesOutput := make([]mapper.CapacityOutput, len(capacities))
+	for i, capacity := range capacities {
+		capacitiesOutput[i] = mapper.CapacityOutputFromDb(capacity)
+	}
+
+	return &ListCapacitiesOutputDTO{Capacities: capacitiesOutput}, nil
+}
+
+type ListCapacitiesInputDTO struct {
+	PlanID string `json:"plan_id"`
+}
+
+type ListCapacitiesOutputDTO struct {
+	Capacities []mapper.CapacityOutput `json:"capacities"`
+}
+
+// GetCapacityReport compares the workload allocations of every portfolio in the plan with the capacity
+// of each competence by month. A month without capacity has no hours available.
+func (s *EstimationService) GetCapacityReport(ctx context.Context, input GetCapacityReportInputDTO) (*GetCapacityReportOutputDTO, error) {
+	plan, err := s.queries.FindPlanById(ctx, input.PlanID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, common.NewNotFoundError(fmt.Errorf("plan with id %s not found", input.PlanID))
+		}
+		return nil, err
+	}
+
+	months, err := s.queries.FindCapacityAllocationsByPlanId(ctx, input.PlanID)
+	if err != nil {
+		return nil, err
+	}
+
+	baselines, err := s.queries.FindOverAllocatedBaselinesByPlanId(ctx, input.PlanID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := mapper.CapacityReportOutputFromDb(plan, months, baselines)
+
+	return &GetCapacityReportOutputDTO{output}, nil
+}
+
+type GetCapacityReportInputDTO struct {
+	PlanID string `json:"plan_id" validate:"required,uuid4"`
+}
+
+type GetCapacityReportOutputDTO struct {
+	mapper.CapacityReportOutput
+}
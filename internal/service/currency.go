@@ -0,0 +1,26 @@
+package service
+
+import (
+	"context"
+
+	"github.com/celsopires1999/estimation/internal/mapper"
+)
+
+func (s *EstimationService) ListCurrencies(ctx context.Context, input ListCurrenciesInputDTO) (*ListCurrenciesOutputDTO, error) {
+	currencies, err := s.queries.FindAllCurrencies(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	currenciesOutput := make([]mapper.CurrencyOutput, len(currencies))
+	for i, currency := range currencies {
+		currenciesOutput[i] = mapper.CurrencyOutputFromDb(currency)
+	}
+
+	return &ListCurrenciesOutputDTO{Currencies: currenciesOutput}, nil
+}
+
+type ListCurrenciesInputDTO struct{}
+type ListCurrenciesOutputDTO struct {
+	Currencies []mapper.CurrencyOutput `json:"currencies"`
+}
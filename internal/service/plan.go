@@ -2,8 +2,12 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
+	"github.com/celsopires1999/estimation/internal/common"
 	"github.com/celsopires1999/estimation/internal/mapper"
+	"github.com/jackc/pgx/v5"
 )
 
 func (s *EstimationService) ListPlans(ctx context.Context, input ListPlansInputDTO) (*ListPlansOutputDTO, error) {
@@ -25,3 +29,50 @@ type ListPlansInputDTO struct{}
 type ListPlansOutputDTO struct {
 	Plans []mapper.PlanOutput `json:"plans"`
 }
+
+func (s *EstimationService) GetPlanSummary(ctx context.Context, input GetPlanSummaryInputDTO) (*GetPlanSummaryOutputDTO, error) {
+	plan, err := s.queries.FindPlanById(ctx, input.PlanID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, common.NewNotFoundError(fmt.Errorf("plan with id %s not found", input.PlanID))
+		}
+		return nil, err
+	}
+
+	periods, err := s.queries.FindPlanAllocationsGroupedByMonth(ctx, input.PlanID)
+	if err != nil {
+		return nil, err
+	}
+
+	costTypes, err := s.queries.FindPlanBudgetsGroupedByCostType(ctx, input.PlanID)
+	if err != nil {
+		return nil, err
+	}
+
+	currencies, err := s.queries.FindPlanBudgetsGroupedByCurrency(ctx, input.PlanID)
+	if err != nil {
+		return nil, err
+	}
+
+	managers, err := s.queries.FindPlanBudgetsGroupedByManager(ctx, input.PlanID)
+	if err != nil {
+		return nil, err
+	}
+
+	competences, err := s.queries.FindPlanWorkloadsGroupedByCompetence(ctx, input.PlanID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := mapper.PlanSummaryOutputFromDb(plan, periods, costTypes, currencies, managers, competences)
+
+	return &GetPlanSummaryOutputDTO{output}, nil
+}
+
+type GetPlanSummaryInputDTO struct {
+	PlanID string `json:"plan_id" validate:"required,uuid4"`
+}
+
+type GetPlanSummaryOutputDTO struct {
+	mapper.PlanSummaryOutput
+}
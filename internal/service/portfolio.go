@@ -55,6 +55,15 @@ func (s *EstimationService) GetPortfolio(ctx context.Context, input GetPortfolio
 		workloadsOutput[i] = mapper.WorkloadOutputFromDb(db.WorkloadRow(workload), allocations)
 	}
 	portfolioOutput.Workloads = workloadsOutput
+	portfolioOutput.Totals = mapper.TotalsOutputFrom(budgetsOutput, workloadsOutput)
+
+	actualAmount, err := s.queries.SumActualsByPortfolioId(ctx, input.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+	remainingAmount := portfolioOutput.Totals.BudgetAmount.Sub(actualAmount)
+	portfolioOutput.Totals.ActualAmount = &actualAmount
+	portfolioOutput.Totals.RemainingAmount = &remainingAmount
 
 	return &GetPortfolioOutputDTO{portfolioOutput}, nil
 }
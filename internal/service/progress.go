@@ -0,0 +1,29 @@
+package service
+
+import (
+	"context"
+
+	"github.com/celsopires1999/estimation/internal/mapper"
+)
+
+func (s *EstimationService) ListProgressByPortfolioID(ctx context.Context, input ListProgressInputDTO) (*ListProgressOutputDTO, error) {
+	progressMany, err := s.queries.FindProgressByPortfolioIdWithRelations(ctx, input.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+
+	progressOutput := make([]mapper.ProgressOutput, len(progressMany))
+	for i, progress := range progressMany {
+		progressOutput[i] = mapper.ProgressOutputFromDb(progress)
+	}
+
+	return &ListProgressOutputDTO{Progress: progressOutput}, nil
+}
+
+type ListProgressInputDTO struct {
+	PortfolioID string `json:"portfolio_id"`
+}
+
+type ListProgressOutputDTO struct {
+	Progress []mapper.ProgressOutput `json:"progress"`
+}
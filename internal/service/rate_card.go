@@ -0,0 +1,29 @@
+package service
+
+import (
+	"context"
+
+	"github.com/celsopires1999/estimation/internal/mapper"
+)
+
+func (s *EstimationService) ListRateCardsByPlanID(ctx context.Context, input ListRateCardsInputDTO) (*ListRateCardsOutputDTO, error) {
+	rateCards, err := s.queries.FindRateCardsByPlanIdWithRelations(ctx, input.PlanID)
+	if err != nil {
+		return nil, err
+	}
+
+	rateCardsOutput := make([]mapper.RateCardOutput, len(rateCards))
+	for i, rateCard := range rateCards {
+		rateCardsOutput[i] = mapper.RateCardOutputFromDb(rateCard)
+	}
+
+	return &ListRateCardsOutputDTO{RateCards: rateCardsOutput}, nil
+}
+
+type ListRateCardsInputDTO struct {
+	PlanID string `json:"plan_id"`
+}
+
+type ListRateCardsOutputDTO struct {
+	RateCards []mapper.RateCardOutput `json:"rate_cards"`
+}
@@ -0,0 +1,26 @@
+package service
+
+import (
+	"context"
+
+	"github.com/celsopires1999/estimation/internal/mapper"
+)
+
+func (s *EstimationService) ListTaxProfiles(ctx context.Context, input ListTaxProfilesInputDTO) (*ListTaxProfilesOutputDTO, error) {
+	taxProfiles, err := s.queries.FindAllTaxProfiles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	taxProfilesOutput := make([]mapper.TaxProfileOutput, len(taxProfiles))
+	for i, taxProfile := range taxProfiles {
+		taxProfilesOutput[i] = mapper.TaxProfileOutputFromDb(taxProfile)
+	}
+
+	return &ListTaxProfilesOutputDTO{TaxProfiles: taxProfilesOutput}, nil
+}
+
+type ListTaxProfilesInputDTO struct{}
+type ListTaxProfilesOutputDTO struct {
+	TaxProfiles []mapper.TaxProfileOutput `json:"tax_profiles"`
+}
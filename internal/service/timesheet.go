@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/celsopires1999/estimation/internal/infra/db"
+	"github.com/celsopires1999/estimation/internal/mapper"
+	"github.com/jackc/pgx/v5"
+)
+
+// GetTimesheetReport compares the workload allocations of every portfolio in the plan with the hours worked
+// by each competence. A competence is over the threshold when its actual hours exceed the planned ones by
+// more than the threshold percentage.
+func (s *EstimationService) GetTimesheetReport(ctx context.Context, input GetTimesheetReportInputDTO) (*GetTimesheetReportOutputDTO, error) {
+	plan, err := s.queries.FindPlanById(ctx, input.PlanID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, common.NewNotFoundError(fmt.Errorf("plan with id %s not found", input.PlanID))
+		}
+		return nil, err
+	}
+
+	rows, err := s.queries.FindTimesheetComparisonByPlanId(ctx, db.FindTimesheetComparisonByPlanIdParams{
+		PlanID:    input.PlanID,
+		Threshold: input.Threshold,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	output := mapper.TimesheetReportOutputFromDb(plan, input.Threshold, rows)
+
+	return &GetTimesheetReportOutputDTO{output}, nil
+}
+
+type GetTimesheetReportInputDTO struct {
+	PlanID    string  `json:"plan_id" validate:"required,uuid4"`
+	Threshold float64 `json:"threshold" validate:"gte=0"`
+}
+
+type GetTimesheetReportOutputDTO struct {
+	mapper.TimesheetReportOutput
+}
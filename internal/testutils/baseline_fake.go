@@ -18,6 +18,7 @@ type BaselineFakeBuilder struct {
 	Duration    int32
 	ManagerID   string
 	EstimatorID string
+	Status      domain.BaselineStatus
 	CreatedAt   time.Time
 	updatedAt   time.Time
 }
@@ -33,6 +34,7 @@ func NewBaselineFakeBuilder() *BaselineFakeBuilder {
 		Duration:    int32(randomdata.Number(1, 60)),
 		ManagerID:   uuid.New().String(),
 		EstimatorID: uuid.New().String(),
+		Status:      domain.DraftBaseline,
 		CreatedAt:   time.Now(),
 		updatedAt:   time.Now(),
 	}
@@ -83,6 +85,11 @@ func (b *BaselineFakeBuilder) WithEstimatorID(estimatorID string) *BaselineFakeB
 	return b
 }
 
+func (b *BaselineFakeBuilder) WithStatus(status domain.BaselineStatus) *BaselineFakeBuilder {
+	b.Status = status
+	return b
+}
+
 func (b *BaselineFakeBuilder) WithCreatedAt(createdAt time.Time) *BaselineFakeBuilder {
 	b.CreatedAt = createdAt
 	return b
@@ -105,6 +112,7 @@ func (b *BaselineFakeBuilder) Build() *domain.Baseline {
 	props.Duration = b.Duration
 	props.ManagerID = b.ManagerID
 	props.EstimatorID = b.EstimatorID
+	props.Status = b.Status
 	props.CreatedAt = b.CreatedAt
 	props.UpdatedAt = b.updatedAt
 
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/Pallinder/go-randomdata"
+	"github.com/celsopires1999/estimation/internal/common"
 	"github.com/celsopires1999/estimation/internal/domain"
 	"github.com/google/uuid"
 )
@@ -14,10 +15,12 @@ type CostFakeBuilder struct {
 	CostType            domain.CostType
 	Description         string
 	Comment             string
-	Amount              float64
+	Amount              common.Money
 	Currency            domain.Currency
 	Tax                 float64
+	TaxProfileID        string
 	ApplyInflation      bool
+	Recurrence          *domain.Recurrence
 	CostAllocationProps []domain.CostAllocationProps
 	CreatedAt           time.Time
 	UpdatedAt           time.Time
@@ -30,13 +33,13 @@ func NewCostFakeBuilder() *CostFakeBuilder {
 		CostType:       domain.CostType(randomdata.StringSample("one_time", "running", "investment")),
 		Description:    randomdata.Paragraph(),
 		Comment:        randomdata.SillyName(),
-		Amount:         100.0,
+		Amount:         common.NewMoney(100.0),
 		Currency:       domain.Currency(randomdata.StringSample("BRL", "USD", "EUR")),
 		Tax:            randomdata.Decimal(0.00, 45.00),
 		ApplyInflation: randomdata.Boolean(),
 		CostAllocationProps: []domain.CostAllocationProps{
-			{Year: 2020, Month: time.January, Amount: 60.00},
-			{Year: 2020, Month: time.August, Amount: 40.00},
+			{Year: 2020, Month: time.January, Amount: common.NewMoney(60.00)},
+			{Year: 2020, Month: time.August, Amount: common.NewMoney(40.00)},
 		},
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
@@ -68,7 +71,7 @@ func (b *CostFakeBuilder) WithComment(comment string) *CostFakeBuilder {
 	return b
 }
 
-func (b *CostFakeBuilder) WithAmount(amount float64) *CostFakeBuilder {
+func (b *CostFakeBuilder) WithAmount(amount common.Money) *CostFakeBuilder {
 	b.Amount = amount
 	return b
 }
@@ -83,11 +86,23 @@ func (b *CostFakeBuilder) WithTax(tax float64) *CostFakeBuilder {
 	return b
 }
 
+func (b *CostFakeBuilder) WithTaxProfileID(taxProfileID string) *CostFakeBuilder {
+	b.TaxProfileID = taxProfileID
+	return b
+}
+
 func (b *CostFakeBuilder) WithApplyInflation(applyInflation bool) *CostFakeBuilder {
 	b.ApplyInflation = applyInflation
 	return b
 }
 
+// WithRecurrence makes a running cost with the allocations expanded from the recurrence
+func (b *CostFakeBuilder) WithRecurrence(recurrence *domain.Recurrence) *CostFakeBuilder {
+	b.CostType = domain.RunningCost
+	b.Recurrence = recurrence
+	return b
+}
+
 func (b *CostFakeBuilder) WithCostAllocationProps(allocations []domain.CostAllocationProps) *CostFakeBuilder {
 	b.CostAllocationProps = allocations
 	return b
@@ -123,11 +138,13 @@ func (b *CostFakeBuilder) Build() *domain.Cost {
 		Amount:          b.Amount,
 		Currency:        b.Currency,
 		Tax:             b.Tax,
+		TaxProfileID:    b.TaxProfileID,
 		ApplyInflation:  b.ApplyInflation,
 		CostAllocations: allocations,
 	}
 
 	cost := domain.RestoreCost(props)
+	cost.ChangeRecurrence(b.Recurrence)
 	err := cost.Validate()
 	if err != nil {
 		panic(err)
@@ -136,7 +153,7 @@ func (b *CostFakeBuilder) Build() *domain.Cost {
 	return cost
 }
 
-func newCostAllocation(year int, month time.Month, amount float64) domain.CostAllocation {
+func newCostAllocation(year int, month time.Month, amount common.Money) domain.CostAllocation {
 	date := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
 	return domain.CostAllocation{
 		AllocationDate: date,
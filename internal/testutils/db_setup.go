@@ -51,6 +51,11 @@ func TruncateTables(dbpool *pgxpool.Pool) error {
 	}
 	defer tx.Rollback(ctx)
 
+	_, err = tx.Exec(ctx, "TRUNCATE TABLE audit_log CASCADE;")
+	if err != nil {
+		return err
+	}
+
 	_, err = tx.Exec(ctx, "TRUNCATE TABLE budget_allocations CASCADE;")
 	if err != nil {
 		return err
@@ -61,6 +66,21 @@ func TruncateTables(dbpool *pgxpool.Pool) error {
 		return err
 	}
 
+	_, err = tx.Exec(ctx, "TRUNCATE TABLE actuals CASCADE;")
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, "TRUNCATE TABLE progress CASCADE;")
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, "TRUNCATE TABLE timesheets CASCADE;")
+	if err != nil {
+		return err
+	}
+
 	_, err = tx.Exec(ctx, "TRUNCATE TABLE portfolios CASCADE;")
 	if err != nil {
 		return err
@@ -96,11 +116,31 @@ func TruncateTables(dbpool *pgxpool.Pool) error {
 		return err
 	}
 
+	_, err = tx.Exec(ctx, "TRUNCATE TABLE tax_profiles CASCADE;")
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, "TRUNCATE TABLE rate_cards CASCADE;")
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, "TRUNCATE TABLE capacities CASCADE;")
+	if err != nil {
+		return err
+	}
+
 	_, err = tx.Exec(ctx, "TRUNCATE TABLE competences CASCADE;")
 	if err != nil {
 		return err
 	}
 
+	_, err = tx.Exec(ctx, "TRUNCATE TABLE baseline_transitions CASCADE;")
+	if err != nil {
+		return err
+	}
+
 	_, err = tx.Exec(ctx, "TRUNCATE TABLE baselines CASCADE;")
 	if err != nil {
 		return err
@@ -116,6 +156,11 @@ func TruncateTables(dbpool *pgxpool.Pool) error {
 		return err
 	}
 
+	_, err = tx.Exec(ctx, "DELETE FROM currencies WHERE code NOT IN ('BRL', 'USD', 'EUR');")
+	if err != nil {
+		return err
+	}
+
 	err = tx.Commit(ctx)
 	if err != nil {
 		return err
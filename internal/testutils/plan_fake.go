@@ -8,11 +8,12 @@ import (
 )
 
 type PlanFakeBuilder struct {
-	Code        string
-	Name        string
-	assumptions domain.Assumptions
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	Code          string
+	Name          string
+	assumptions   domain.Assumptions
+	InflationMode domain.InflationMode
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
 }
 
 func NewPlanFakeBuilder() *PlanFakeBuilder {
@@ -86,22 +87,29 @@ func NewPlanFakeBuilder() *PlanFakeBuilder {
 	}
 
 	return &PlanFakeBuilder{
-		Code:        "BP 2026",
-		Name:        "Business Plan 2026",
-		assumptions: assumptions,
-		CreatedAt:   time.Now(),
-		UpdatedAt:   time.Now(),
+		Code:          "BP 2026",
+		Name:          "Business Plan 2026",
+		assumptions:   assumptions,
+		InflationMode: domain.AnnualInflation,
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
 	}
 }
 
+func (b *PlanFakeBuilder) WithInflationMode(inflationMode domain.InflationMode) *PlanFakeBuilder {
+	b.InflationMode = inflationMode
+	return b
+}
+
 func (b *PlanFakeBuilder) Build() *domain.Plan {
 	plan := &domain.Plan{
-		PlanID:      uuid.New().String(),
-		Code:        b.Code,
-		Name:        b.Name,
-		Assumptions: b.assumptions,
-		CreatedAt:   b.CreatedAt,
-		UpdatedAt:   b.UpdatedAt,
+		PlanID:        uuid.New().String(),
+		Code:          b.Code,
+		Name:          b.Name,
+		Assumptions:   b.assumptions,
+		InflationMode: b.InflationMode,
+		CreatedAt:     b.CreatedAt,
+		UpdatedAt:     b.UpdatedAt,
 	}
 
 	err := plan.Validate()
@@ -0,0 +1,251 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/celsopires1999/estimation/internal/domain"
+	"github.com/celsopires1999/estimation/internal/infra/db"
+	"github.com/celsopires1999/estimation/internal/mapper"
+)
+
+var ErrActualPortfolioMismatch = errors.New("actual portfolio mismatch")
+
+// ActualInputDTO is the spend of a cost in a month. Recording the same cost and month again replaces its amount.
+type ActualInputDTO struct {
+	CostID  string       `json:"cost_id" validate:"required,uuid4"`
+	Year    int          `json:"year" validate:"required"`
+	Month   int          `json:"month" validate:"required,gte=1,lte=12"`
+	Amount  common.Money `json:"amount" validate:"gte=0,twodecimals"`
+	Comment string       `json:"comment" validate:"max=255"`
+}
+
+type RecordActualUseCase struct {
+	repository domain.EstimationRepository
+}
+
+type RecordActualInputDTO struct {
+	PortfolioID string `json:"portfolio_id" validate:"required,uuid4"`
+	ActualInputDTO
+}
+
+type RecordActualOutputDTO struct {
+	mapper.ActualOutput
+}
+
+func NewRecordActualUseCase(repository domain.EstimationRepository) *RecordActualUseCase {
+	return &RecordActualUseCase{repository}
+}
+
+func (uc *RecordActualUseCase) Execute(ctx context.Context, input RecordActualInputDTO) (*RecordActualOutputDTO, error) {
+	if _, err := uc.repository.GetPortfolio(ctx, input.PortfolioID); err != nil {
+		return nil, err
+	}
+
+	budgets, err := uc.repository.GetBudgetManyByPortfolioID(ctx, input.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+
+	actuals, err := uc.repository.GetActualManyByPortfolioID(ctx, input.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+
+	actual, err := recordActual(ctx, uc.repository, input.PortfolioID, budgets, actuals, input.ActualInputDTO)
+	if err != nil {
+		return nil, err
+	}
+
+	output := mapper.ActualOutputFromDomain(*actual)
+
+	return &RecordActualOutputDTO{output}, nil
+}
+
+// ImportActualsUseCase records many actuals of a portfolio at once, such as the lines of a CSV file.
+// Either every actual is recorded or none is.
+type ImportActualsUseCase struct {
+	txm db.TransactionManagerInterface
+}
+
+type ImportActualsInputDTO struct {
+	PortfolioID string           `json:"portfolio_id" validate:"required,uuid4"`
+	Actuals     []ActualInputDTO `json:"actuals" validate:"required,min=1,dive"`
+}
+
+type ImportActualsOutputDTO struct {
+	PortfolioID string                `json:"portfolio_id"`
+	Actuals     []mapper.ActualOutput `json:"actuals"`
+}
+
+func NewImportActualsUseCase(txm db.TransactionManagerInterface) *ImportActualsUseCase {
+	return &ImportActualsUseCase{txm}
+}
+
+func (uc *ImportActualsUseCase) Execute(ctx context.Context, input ImportActualsInputDTO) (*ImportActualsOutputDTO, error) {
+	output := &ImportActualsOutputDTO{
+		PortfolioID: input.PortfolioID,
+		Actuals:     make([]mapper.ActualOutput, 0, len(input.Actuals)),
+	}
+
+	err := uc.txm.Do(ctx, func(ctx context.Context, tx db.TransactionInterface) error {
+		repository, err := db.GetAs[domain.EstimationRepository](tx, "EstimationRepository")
+		if err != nil {
+			return err
+		}
+
+		if _, err := repository.GetPortfolio(ctx, input.PortfolioID); err != nil {
+			return err
+		}
+
+		budgets, err := repository.GetBudgetManyByPortfolioID(ctx, input.PortfolioID)
+		if err != nil {
+			return err
+		}
+
+		actuals, err := repository.GetActualManyByPortfolioID(ctx, input.PortfolioID)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range input.Actuals {
+			actual, err := recordActual(ctx, repository, input.PortfolioID, budgets, actuals, item)
+			if err != nil {
+				return err
+			}
+			if !slices.ContainsFunc(actuals, func(a *domain.Actual) bool { return a.ActualID == actual.ActualID }) {
+				actuals = append(actuals, actual)
+			}
+			output.Actuals = append(output.Actuals, mapper.ActualOutputFromDomain(*actual))
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+type DeleteActualUseCase struct {
+	repository domain.EstimationRepository
+}
+
+type DeleteActualInputDTO struct {
+	ActualID    string `json:"actual_id" validate:"required"`
+	PortfolioID string `json:"portfolio_id" validate:"required"`
+}
+
+type DeleteActualOutputDTO struct{}
+
+func NewDeleteActualUseCase(repository domain.EstimationRepository) *DeleteActualUseCase {
+	return &DeleteActualUseCase{repository}
+}
+
+func (uc *DeleteActualUseCase) Execute(ctx context.Context, input DeleteActualInputDTO) (*DeleteActualOutputDTO, error) {
+	actual, err := uc.repository.GetActual(ctx, input.ActualID)
+	if err != nil {
+		return nil, err
+	}
+
+	if actual.PortfolioID != input.PortfolioID {
+		return nil, ErrActualPortfolioMismatch
+	}
+
+	err = uc.repository.DeleteActual(ctx, input.ActualID)
+	if err != nil {
+		return nil, err
+	}
+	return &DeleteActualOutputDTO{}, nil
+}
+
+// GetBudgetVsActualUseCase compares the budgets of a portfolio with their actual spend
+type GetBudgetVsActualUseCase struct {
+	repository domain.EstimationRepository
+}
+
+type GetBudgetVsActualInputDTO struct {
+	PortfolioID string `json:"portfolio_id" validate:"required,uuid4"`
+}
+
+type GetBudgetVsActualOutputDTO struct {
+	mapper.BudgetVsActualOutput
+}
+
+func NewGetBudgetVsActualUseCase(repository domain.EstimationRepository) *GetBudgetVsActualUseCase {
+	return &GetBudgetVsActualUseCase{repository}
+}
+
+func (uc *GetBudgetVsActualUseCase) Execute(ctx context.Context, input GetBudgetVsActualInputDTO) (*GetBudgetVsActualOutputDTO, error) {
+	portfolio, err := uc.repository.GetPortfolio(ctx, input.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+
+	budgets, err := uc.repository.GetBudgetManyByPortfolioID(ctx, portfolio.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+
+	actuals, err := uc.repository.GetActualManyByPortfolioID(ctx, portfolio.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+
+	costs, err := uc.repository.GetCostManyByBaselineID(ctx, portfolio.BaselineID)
+	if err != nil {
+		return nil, err
+	}
+
+	comparison := domain.CompareBudgetsWithActuals(budgets, actuals)
+	output := mapper.BudgetVsActualOutputFromDomain(portfolio.PortfolioID, *comparison, costs)
+
+	return &GetBudgetVsActualOutputDTO{output}, nil
+}
+
+// recordActual creates the actual of the cost in the month, or changes the one already recorded
+func recordActual(ctx context.Context, repository domain.EstimationRepository, portfolioID string, budgets []*domain.Budget, actuals []*domain.Actual, input ActualInputDTO) (*domain.Actual, error) {
+	for _, actual := range actuals {
+		if !actual.IsFor(input.CostID, input.Year, time.Month(input.Month)) {
+			continue
+		}
+
+		actual.ChangeAmount(input.Amount)
+		actual.ChangeComment(input.Comment)
+		if err := actual.Validate(); err != nil {
+			return nil, err
+		}
+		if err := repository.UpdateActual(ctx, actual); err != nil {
+			return nil, err
+		}
+		return repository.GetActual(ctx, actual.ActualID)
+	}
+
+	actual := domain.NewActual(domain.NewActualProps{
+		PortfolioID: portfolioID,
+		CostID:      input.CostID,
+		Year:        input.Year,
+		Month:       time.Month(input.Month),
+		Amount:      input.Amount,
+		Comment:     input.Comment,
+	})
+
+	if err := actual.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := actual.ValidateBudget(budgets); err != nil {
+		return nil, err
+	}
+
+	if err := repository.CreateActual(ctx, actual); err != nil {
+		return nil, err
+	}
+
+	return repository.GetActual(ctx, actual.ActualID)
+}
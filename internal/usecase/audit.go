@@ -0,0 +1,30 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/celsopires1999/estimation/internal/domain"
+)
+
+// recordAudit writes to the audit log the change of an entity made by the actor of the context.
+// It has to run in the transaction of the change, with before nil for a create and after nil for a delete.
+func recordAudit(ctx context.Context, repository domain.EstimationRepository, action domain.AuditAction, entity domain.AuditEntity, entityID string, before, after any) error {
+	entry, err := domain.NewAuditEntry(domain.NewAuditEntryProps{
+		Actor:    common.Actor(ctx),
+		Action:   action,
+		Entity:   entity,
+		EntityID: entityID,
+		Before:   before,
+		After:    after,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := entry.Validate(); err != nil {
+		return err
+	}
+
+	return repository.CreateAuditEntry(ctx, entry)
+}
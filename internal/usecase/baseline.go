@@ -2,17 +2,19 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/celsopires1999/estimation/internal/common"
 	"github.com/celsopires1999/estimation/internal/domain"
+	"github.com/celsopires1999/estimation/internal/infra/db"
 	"github.com/celsopires1999/estimation/internal/mapper"
 )
 
 // CreateBaselineUseCase is responsible for creating a new baseline in the system
 type CreateBaselineUseCase struct {
-	repository domain.EstimationRepository
+	txm db.TransactionManagerInterface
 }
 
 type CreateBaselineInputDTO struct {
@@ -31,8 +33,8 @@ type CreateBaselineOutputDTO struct {
 	mapper.BaselineOutput
 }
 
-func NewCreateBaselineUseCase(repo domain.EstimationRepository) *CreateBaselineUseCase {
-	return &CreateBaselineUseCase{repo}
+func NewCreateBaselineUseCase(txm db.TransactionManagerInterface) *CreateBaselineUseCase {
+	return &CreateBaselineUseCase{txm}
 }
 
 func (uc *CreateBaselineUseCase) Execute(ctx context.Context, input CreateBaselineInputDTO) (*CreateBaselineOutputDTO, error) {
@@ -53,11 +55,27 @@ func (uc *CreateBaselineUseCase) Execute(ctx context.Context, input CreateBaseli
 		input.ManagerID,
 		input.EstimatorID,
 	)
-	if err := uc.repository.CreateBaseline(ctx, baseline); err != nil {
-		return nil, err
-	}
 
-	createdBaseline, err := uc.repository.GetBaseline(ctx, baseline.BaselineID)
+	var createdBaseline *domain.Baseline
+
+	err := uc.txm.Do(ctx, func(ctx context.Context, tx db.TransactionInterface) error {
+		repository, err := db.GetAs[domain.EstimationRepository](tx, "EstimationRepository")
+		if err != nil {
+			return err
+		}
+
+		if err := repository.CreateBaseline(ctx, baseline); err != nil {
+			return err
+		}
+
+		createdBaseline, err = repository.GetBaseline(ctx, baseline.BaselineID)
+		if err != nil {
+			return err
+		}
+
+		return recordAudit(ctx, repository, domain.CreateAction, domain.BaselineEntity, baseline.BaselineID, nil, mapper.BaselineOutputFromDomain(*createdBaseline))
+	})
+
 	if err != nil {
 		return nil, err
 	}
@@ -69,7 +87,7 @@ func (uc *CreateBaselineUseCase) Execute(ctx context.Context, input CreateBaseli
 
 // UpdateBaselineUseCase is responsible for updating an existing baseline in the system
 type UpdateBaselineUseCase struct {
-	repository domain.EstimationRepository
+	txm db.TransactionManagerInterface
 }
 
 type UpdateBaselineInputDTO struct {
@@ -89,56 +107,273 @@ type UpdateBaselineOutputDTO struct {
 	mapper.BaselineOutput
 }
 
-func NewUpdateBaselineUseCase(repo domain.EstimationRepository) *UpdateBaselineUseCase {
-	return &UpdateBaselineUseCase{repo}
+func NewUpdateBaselineUseCase(txm db.TransactionManagerInterface) *UpdateBaselineUseCase {
+	return &UpdateBaselineUseCase{txm}
 }
 
 func (uc *UpdateBaselineUseCase) Execute(ctx context.Context, input UpdateBaselineInputDTO) (*UpdateBaselineOutputDTO, error) {
-	baseline, err := uc.repository.GetBaseline(ctx, input.BaselineID)
+	var updated *domain.Baseline
+
+	err := uc.txm.Do(ctx, func(ctx context.Context, tx db.TransactionInterface) error {
+		repository, err := db.GetAs[domain.EstimationRepository](tx, "EstimationRepository")
+		if err != nil {
+			return err
+		}
+
+		baseline, err := repository.GetBaseline(ctx, input.BaselineID)
+		if err != nil {
+			return err
+		}
+		before := mapper.BaselineOutputFromDomain(*baseline)
+
+		baseline.ChangeCode(input.Code)
+		baseline.ChangeReview(input.Review)
+		baseline.ChangeTitle(input.Title)
+		baseline.ChangeDescription(input.Description)
+		baseline.ChangeStartDate(input.StartYear, input.StartMonth)
+		baseline.ChangeDuration(input.Duration)
+		baseline.ChangeManagerID(input.ManagerID)
+		baseline.ChangeEstimatorID(input.EstimatorID)
+
+		err = baseline.Validate()
+		if err != nil {
+			return err
+		}
+
+		if err := baseline.ValidateEditable(); err != nil {
+			return err
+		}
+
+		if input.StartYear != nil || input.StartMonth != nil || input.Duration != nil {
+			if err := validateBaselineAllocations(ctx, repository, baseline); err != nil {
+				return err
+			}
+		}
+
+		err = repository.UpdateBaseline(ctx, baseline)
+		if err != nil {
+			return err
+		}
+
+		updated, err = repository.GetBaseline(ctx, baseline.BaselineID)
+		if err != nil {
+			return err
+		}
+
+		return recordAudit(ctx, repository, domain.UpdateAction, domain.BaselineEntity, baseline.BaselineID, before, mapper.BaselineOutputFromDomain(*updated))
+	})
+
 	if err != nil {
 		return nil, err
 	}
 
-	baseline.ChangeCode(input.Code)
-	baseline.ChangeReview(input.Review)
-	baseline.ChangeTitle(input.Title)
-	baseline.ChangeDescription(input.Description)
-	baseline.ChangeStartDate(input.StartYear, input.StartMonth)
-	baseline.ChangeDuration(input.Duration)
-	baseline.ChangeManagerID(input.ManagerID)
-	baseline.ChangeEstimatorID(input.EstimatorID)
+	output := mapper.BaselineOutputFromDomain(*updated)
+
+	return &UpdateBaselineOutputDTO{output}, nil
+}
+
+// validateBaselineAllocations checks that the costs and efforts still fit in the changed baseline period
+func validateBaselineAllocations(ctx context.Context, repository domain.EstimationRepository, baseline *domain.Baseline) error {
+	costs, err := repository.GetCostManyByBaselineID(ctx, baseline.BaselineID)
+	if err != nil {
+		return err
+	}
+	for _, cost := range costs {
+		if err := baseline.ValidateCostAllocations(cost); err != nil {
+			return err
+		}
+	}
+
+	efforts, err := repository.GetEffortManyByBaselineID(ctx, baseline.BaselineID)
+	if err != nil {
+		return err
+	}
+	for _, effort := range efforts {
+		if err := baseline.ValidateEffortAllocations(effort); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CreateBaselineReviewUseCase is responsible for creating the next review of a baseline with copies of its costs and efforts
+type CreateBaselineReviewUseCase struct {
+	txm db.TransactionManagerInterface
+}
+
+type CreateBaselineReviewInputDTO struct {
+	BaselineID string  `json:"baseline_id" validate:"required,uuid4"`
+	Title      *string `json:"title" validate:"omitempty,required"`
+	StartMonth *int    `json:"start_month" validate:"required_with=StartYear,omitempty,gte=1,lte=12"`
+	StartYear  *int    `json:"start_year" validate:"required_with=StartMonth"`
+}
+
+type CreateBaselineReviewOutputDTO struct {
+	mapper.BaselineOutput
+}
+
+func NewCreateBaselineReviewUseCase(txm db.TransactionManagerInterface) *CreateBaselineReviewUseCase {
+	return &CreateBaselineReviewUseCase{txm}
+}
+
+func (uc *CreateBaselineReviewUseCase) Execute(ctx context.Context, input CreateBaselineReviewInputDTO) (*CreateBaselineReviewOutputDTO, error) {
+	var created *domain.Baseline
+
+	err := uc.txm.Do(ctx, func(ctx context.Context, tx db.TransactionInterface) error {
+		repository, err := db.GetAs[domain.EstimationRepository](tx, "EstimationRepository")
+		if err != nil {
+			return err
+		}
+
+		baseline, err := repository.GetBaseline(ctx, input.BaselineID)
+		if err != nil {
+			return err
+		}
+
+		review := baseline.NewReview()
+		review.ChangeTitle(input.Title)
+		review.ChangeStartDate(input.StartYear, input.StartMonth)
+		if err := review.Validate(); err != nil {
+			return err
+		}
+
+		// costs and efforts keep their months relative to the start of the baseline
+		shiftMonths := domain.ShiftMonthsTo(baseline, review.StartDate.Year(), review.StartDate.Month())
+
+		costs, err := repository.GetCostManyByBaselineID(ctx, baseline.BaselineID)
+		if err != nil {
+			return err
+		}
+
+		efforts, err := repository.GetEffortManyByBaselineID(ctx, baseline.BaselineID)
+		if err != nil {
+			return err
+		}
+
+		if err := repository.CreateBaseline(ctx, review); err != nil {
+			return err
+		}
+
+		created, err = repository.GetBaseline(ctx, review.BaselineID)
+		if err != nil {
+			return err
+		}
+
+		if err := recordAudit(ctx, repository, domain.CreateAction, domain.BaselineEntity, created.BaselineID, nil, mapper.BaselineOutputFromDomain(*created)); err != nil {
+			return err
+		}
+
+		if len(costs) > 0 {
+			copies := make([]*domain.Cost, len(costs))
+			for i, cost := range costs {
+				copies[i] = cost.Copy(review.BaselineID, shiftMonths)
+			}
+			if err := repository.CreateCostMany(ctx, copies); err != nil {
+				return err
+			}
+			for _, cost := range copies {
+				if err := recordAudit(ctx, repository, domain.CreateAction, domain.CostEntity, cost.CostID, nil, mapper.CostOutputFromDomain(*cost)); err != nil {
+					return err
+				}
+			}
+		}
+
+		if len(efforts) > 0 {
+			copies := make([]*domain.Effort, len(efforts))
+			for i, effort := range efforts {
+				copies[i] = effort.Copy(review.BaselineID, shiftMonths)
+			}
+			if err := repository.CreateEffortMany(ctx, copies); err != nil {
+				return err
+			}
+			for _, effort := range copies {
+				if err := recordAudit(ctx, repository, domain.CreateAction, domain.EffortEntity, effort.EffortID, nil, mapper.EffortOutputFromDomain(*effort)); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	})
 
-	err = baseline.Validate()
 	if err != nil {
 		return nil, err
 	}
 
-	count, err := uc.repository.CountPortfoliosByBaselineId(ctx, baseline.BaselineID)
+	output := mapper.BaselineOutputFromDomain(*created)
+
+	return &CreateBaselineReviewOutputDTO{output}, nil
+}
+
+// GetBaselineDiffUseCase is responsible for comparing the costs and efforts of a baseline with the ones of another baseline
+type GetBaselineDiffUseCase struct {
+	repository domain.EstimationRepository
+}
+
+type GetBaselineDiffInputDTO struct {
+	BaselineID string `json:"baseline_id" validate:"required,uuid4"`
+	AgainstID  string `json:"against" validate:"required,uuid4,nefield=BaselineID"`
+}
+
+type GetBaselineDiffOutputDTO struct {
+	mapper.BaselineDiffOutput
+}
+
+func NewGetBaselineDiffUseCase(repo domain.EstimationRepository) *GetBaselineDiffUseCase {
+	return &GetBaselineDiffUseCase{repo}
+}
+
+func (uc *GetBaselineDiffUseCase) Execute(ctx context.Context, input GetBaselineDiffInputDTO) (*GetBaselineDiffOutputDTO, error) {
+	baseline, err := uc.repository.GetBaseline(ctx, input.BaselineID)
 	if err != nil {
 		return nil, err
 	}
-	if count > 0 {
-		return nil, common.NewConflictError(fmt.Errorf("baseline %s has %d portfolio(s)", baseline.BaselineID, count))
+
+	against, err := uc.repository.GetBaseline(ctx, input.AgainstID)
+	if err != nil {
+		return nil, err
 	}
 
-	err = uc.repository.UpdateBaseline(ctx, baseline)
+	costs, err := uc.repository.GetCostManyByBaselineID(ctx, baseline.BaselineID)
 	if err != nil {
 		return nil, err
 	}
 
-	updated, err := uc.repository.GetBaseline(ctx, baseline.BaselineID)
+	againstCosts, err := uc.repository.GetCostManyByBaselineID(ctx, against.BaselineID)
 	if err != nil {
 		return nil, err
 	}
 
-	output := mapper.BaselineOutputFromDomain(*updated)
+	efforts, err := uc.repository.GetEffortManyByBaselineID(ctx, baseline.BaselineID)
+	if err != nil {
+		return nil, err
+	}
 
-	return &UpdateBaselineOutputDTO{output}, nil
+	againstEfforts, err := uc.repository.GetEffortManyByBaselineID(ctx, against.BaselineID)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := domain.DiffBaselines(againstCosts, costs, againstEfforts, efforts)
+
+	competences := make(map[string]*domain.Competence)
+	for _, e := range diff.Efforts {
+		competence, err := uc.repository.GetCompetence(ctx, e.CompetenceID)
+		if err != nil {
+			return nil, err
+		}
+		competences[e.CompetenceID] = competence
+	}
+
+	output := mapper.BaselineDiffOutputFromDomain(baseline.BaselineID, against.BaselineID, *diff, competences)
+
+	return &GetBaselineDiffOutputDTO{output}, nil
 }
 
 // DeleteBaselineUseCase is responsible for deleting an existing baseline in the system
 type DeleteBaselineUseCase struct {
-	repository domain.EstimationRepository
+	txm db.TransactionManagerInterface
 }
 
 type DeleteBaselineInputDTO struct {
@@ -147,14 +382,143 @@ type DeleteBaselineInputDTO struct {
 
 type DeleteBaselineOutputDTO struct{}
 
-func NewDeleteBaselineUseCase(repo domain.EstimationRepository) *DeleteBaselineUseCase {
-	return &DeleteBaselineUseCase{repo}
+func NewDeleteBaselineUseCase(txm db.TransactionManagerInterface) *DeleteBaselineUseCase {
+	return &DeleteBaselineUseCase{txm}
 }
 
 func (uc *DeleteBaselineUseCase) Execute(ctx context.Context, input DeleteBaselineInputDTO) (*DeleteBaselineOutputDTO, error) {
-	err := uc.repository.DeleteBaseline(ctx, input.BaselineID)
+	err := uc.txm.Do(ctx, func(ctx context.Context, tx db.TransactionInterface) error {
+		repository, err := db.GetAs[domain.EstimationRepository](tx, "EstimationRepository")
+		if err != nil {
+			return err
+		}
+
+		baseline, err := repository.GetBaseline(ctx, input.BaselineID)
+		if err != nil {
+			return err
+		}
+
+		err = repository.DeleteBaseline(ctx, input.BaselineID)
+		if err != nil {
+			return err
+		}
+
+		return recordAudit(ctx, repository, domain.DeleteAction, domain.BaselineEntity, baseline.BaselineID, mapper.BaselineOutputFromDomain(*baseline), nil)
+	})
+
 	if err != nil {
 		return nil, err
 	}
 	return &DeleteBaselineOutputDTO{}, nil
 }
+
+// TransitionBaselineUseCase is responsible for moving a baseline through its approval workflow.
+// The actor of the request must be the estimator or the manager of the baseline, as the transition requires.
+// Approving a review supersedes the reviews of the same baseline code approved before it.
+type TransitionBaselineUseCase struct {
+	txm db.TransactionManagerInterface
+}
+
+type TransitionBaselineInputDTO struct {
+	BaselineID string `json:"baseline_id" validate:"required,uuid4"`
+	Status     string `json:"status" validate:"required,oneof=draft submitted approved rejected superseded"`
+	Comment    string `json:"comment" validate:"required,max=255"`
+}
+
+type TransitionBaselineOutputDTO struct {
+	mapper.BaselineOutput
+}
+
+func NewTransitionBaselineUseCase(txm db.TransactionManagerInterface) *TransitionBaselineUseCase {
+	return &TransitionBaselineUseCase{txm}
+}
+
+func (uc *TransitionBaselineUseCase) Execute(ctx context.Context, input TransitionBaselineInputDTO) (*TransitionBaselineOutputDTO, error) {
+	var transitioned *domain.Baseline
+
+	err := uc.txm.Do(ctx, func(ctx context.Context, tx db.TransactionInterface) error {
+		repository, err := db.GetAs[domain.EstimationRepository](tx, "EstimationRepository")
+		if err != nil {
+			return err
+		}
+
+		baseline, err := repository.GetBaseline(ctx, input.BaselineID)
+		if err != nil {
+			return err
+		}
+
+		actor := common.Actor(ctx)
+		if actor == "" {
+			return common.NewDomainValidationError(errors.New("the user moving the baseline is required"))
+		}
+
+		user, err := repository.GetUser(ctx, actor)
+		if err != nil {
+			var notFoundErr *common.NotFoundError
+			if errors.As(err, &notFoundErr) {
+				return common.NewConflictError(fmt.Errorf("user id %s does not exist", actor))
+			}
+			return err
+		}
+
+		to := domain.BaselineStatus(input.Status)
+		if err := transitionBaseline(ctx, repository, baseline, func() (*domain.BaselineTransition, error) {
+			return baseline.Transition(to, user, input.Comment)
+		}); err != nil {
+			return err
+		}
+
+		if baseline.Status == domain.ApprovedBaseline {
+			reviews, err := repository.GetBaselineManyByCode(ctx, baseline.Code)
+			if err != nil {
+				return err
+			}
+			for _, review := range reviews {
+				if review.BaselineID == baseline.BaselineID || review.Status != domain.ApprovedBaseline {
+					continue
+				}
+				comment := fmt.Sprintf("superseded by review %d", baseline.Review)
+				if err := transitionBaseline(ctx, repository, review, func() (*domain.BaselineTransition, error) {
+					return review.Supersede(user, comment)
+				}); err != nil {
+					return err
+				}
+			}
+		}
+
+		transitioned, err = repository.GetBaseline(ctx, baseline.BaselineID)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	output := mapper.BaselineOutputFromDomain(*transitioned)
+
+	return &TransitionBaselineOutputDTO{output}, nil
+}
+
+// transitionBaseline moves the baseline with the given move and records the transition
+func transitionBaseline(ctx context.Context, repository domain.EstimationRepository, baseline *domain.Baseline, move func() (*domain.BaselineTransition, error)) error {
+	before := mapper.BaselineOutputFromDomain(*baseline)
+
+	transition, err := move()
+	if err != nil {
+		return err
+	}
+
+	if err := repository.UpdateBaselineStatus(ctx, baseline); err != nil {
+		return err
+	}
+
+	if err := repository.CreateBaselineTransition(ctx, transition); err != nil {
+		return err
+	}
+
+	return recordAudit(ctx, repository, domain.UpdateAction, domain.BaselineEntity, baseline.BaselineID, before, mapper.BaselineOutputFromDomain(*baseline))
+}
@@ -0,0 +1,163 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/celsopires1999/estimation/internal/domain"
+	"github.com/celsopires1999/estimation/internal/mapper"
+)
+
+var ErrCapacityPlanMismatch = errors.New("capacity plan mismatch")
+
+type CreateCapacityUseCase struct {
+	repository domain.EstimationRepository
+}
+
+type CreateCapacityInputDTO struct {
+	PlanID       string `json:"plan_id" validate:"required,uuid4"`
+	CompetenceID string `json:"competence_id" validate:"required,uuid4"`
+	Year         int    `json:"year" validate:"required"`
+	Month        int    `json:"month" validate:"required,gte=1,lte=12"`
+	Hours        int    `json:"hours" validate:"gte=0"`
+}
+
+type CreateCapacityOutputDTO struct {
+	mapper.CapacityOutput
+}
+
+func NewCreateCapacityUseCase(repo domain.EstimationRepository) *CreateCapacityUseCase {
+	return &CreateCapacityUseCase{repo}
+}
+
+func (uc *CreateCapacityUseCase) Execute(ctx context.Context, input CreateCapacityInputDTO) (*CreateCapacityOutputDTO, error) {
+	plan, err := uc.repository.GetPlan(ctx, input.PlanID)
+	if err != nil {
+		return nil, err
+	}
+
+	capacity := domain.NewCapacity(domain.NewCapacityProps{
+		PlanID:       input.PlanID,
+		CompetenceID: input.CompetenceID,
+		Year:         input.Year,
+		Month:        time.Month(input.Month),
+		Hours:        input.Hours,
+	})
+
+	if err := validateCapacity(capacity, plan); err != nil {
+		return nil, err
+	}
+
+	if err := uc.repository.CreateCapacity(ctx, capacity); err != nil {
+		return nil, err
+	}
+
+	created, err := uc.repository.GetCapacity(ctx, capacity.CapacityID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := mapper.CapacityOutputFromDomain(*created)
+
+	return &CreateCapacityOutputDTO{output}, nil
+}
+
+type UpdateCapacityUseCase struct {
+	repository domain.EstimationRepository
+}
+
+type UpdateCapacityInputDTO struct {
+	CapacityID string `json:"capacity_id" validate:"required,uuid4"`
+	PlanID     string `json:"plan_id" validate:"required,uuid4"`
+	Year       *int   `json:"year" validate:"omitempty"`
+	Month      *int   `json:"month" validate:"omitempty,gte=1,lte=12"`
+	Hours      *int   `json:"hours" validate:"omitempty,gte=0"`
+}
+
+type UpdateCapacityOutputDTO struct {
+	mapper.CapacityOutput
+}
+
+func NewUpdateCapacityUseCase(repo domain.EstimationRepository) *UpdateCapacityUseCase {
+	return &UpdateCapacityUseCase{repo}
+}
+
+func (uc *UpdateCapacityUseCase) Execute(ctx context.Context, input UpdateCapacityInputDTO) (*UpdateCapacityOutputDTO, error) {
+	capacity, err := uc.repository.GetCapacity(ctx, input.CapacityID)
+	if err != nil {
+		return nil, err
+	}
+
+	if capacity.PlanID != input.PlanID {
+		return nil, ErrCapacityPlanMismatch
+	}
+
+	plan, err := uc.repository.GetPlan(ctx, input.PlanID)
+	if err != nil {
+		return nil, err
+	}
+
+	capacity.ChangeYear(input.Year)
+	capacity.ChangeMonth(input.Month)
+	capacity.ChangeHours(input.Hours)
+
+	if err := validateCapacity(capacity, plan); err != nil {
+		return nil, err
+	}
+
+	err = uc.repository.UpdateCapacity(ctx, capacity)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := uc.repository.GetCapacity(ctx, capacity.CapacityID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := mapper.CapacityOutputFromDomain(*updated)
+
+	return &UpdateCapacityOutputDTO{output}, nil
+}
+
+type DeleteCapacityUseCase struct {
+	repository domain.EstimationRepository
+}
+
+type DeleteCapacityInputDTO struct {
+	CapacityID string `json:"capacity_id" validate:"required"`
+	PlanID     string `json:"plan_id" validate:"required"`
+}
+
+type DeleteCapacityOutputDTO struct{}
+
+func NewDeleteCapacityUseCase(repo domain.EstimationRepository) *DeleteCapacityUseCase {
+	return &DeleteCapacityUseCase{repo}
+}
+
+func (uc *DeleteCapacityUseCase) Execute(ctx context.Context, input DeleteCapacityInputDTO) (*DeleteCapacityOutputDTO, error) {
+	capacity, err := uc.repository.GetCapacity(ctx, input.CapacityID)
+	if err != nil {
+		return nil, err
+	}
+
+	if capacity.PlanID != input.PlanID {
+		return nil, ErrCapacityPlanMismatch
+	}
+
+	err = uc.repository.DeleteCapacity(ctx, input.CapacityID)
+	if err != nil {
+		return nil, err
+	}
+	return &DeleteCapacityOutputDTO{}, nil
+}
+
+// validateCapacity checks the capacity against the plan years
+func validateCapacity(capacity *domain.Capacity, plan *domain.Plan) error {
+	if err := capacity.Validate(); err != nil {
+		return err
+	}
+
+	return capacity.ValidateYear(plan)
+}
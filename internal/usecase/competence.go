@@ -4,11 +4,12 @@ import (
 	"context"
 
 	"github.com/celsopires1999/estimation/internal/domain"
+	"github.com/celsopires1999/estimation/internal/infra/db"
 	"github.com/celsopires1999/estimation/internal/mapper"
 )
 
 type CreateCompetenceUseCase struct {
-	repository domain.EstimationRepository
+	txm db.TransactionManagerInterface
 }
 
 type CreateCompetenceInputDTO struct {
@@ -20,18 +21,32 @@ type CreateCompetenceOutputDTO struct {
 	mapper.CompetenceOutput
 }
 
-func NewCreateCompetenceUseCase(repo domain.EstimationRepository) *CreateCompetenceUseCase {
-	return &CreateCompetenceUseCase{repo}
+func NewCreateCompetenceUseCase(txm db.TransactionManagerInterface) *CreateCompetenceUseCase {
+	return &CreateCompetenceUseCase{txm}
 }
 
 func (uc *CreateCompetenceUseCase) Execute(ctx context.Context, input CreateCompetenceInputDTO) (*CreateCompetenceOutputDTO, error) {
+	var createdCompetence *domain.Competence
 
-	competence := domain.NewCompetence(input.Code, input.Name)
-	if err := uc.repository.CreateCompetence(ctx, competence); err != nil {
-		return nil, err
-	}
+	err := uc.txm.Do(ctx, func(ctx context.Context, tx db.TransactionInterface) error {
+		repository, err := db.GetAs[domain.EstimationRepository](tx, "EstimationRepository")
+		if err != nil {
+			return err
+		}
+
+		competence := domain.NewCompetence(input.Code, input.Name)
+		if err := repository.CreateCompetence(ctx, competence); err != nil {
+			return err
+		}
+
+		createdCompetence, err = repository.GetCompetence(ctx, competence.CompetenceID)
+		if err != nil {
+			return err
+		}
+
+		return recordAudit(ctx, repository, domain.CreateAction, domain.CompetenceEntity, competence.CompetenceID, nil, mapper.CompetenceOutputFromDomain(*createdCompetence))
+	})
 
-	createdCompetence, err := uc.repository.GetCompetence(ctx, competence.CompetenceID)
 	if err != nil {
 		return nil, err
 	}
@@ -42,7 +57,7 @@ func (uc *CreateCompetenceUseCase) Execute(ctx context.Context, input CreateComp
 }
 
 type UpdateCompetenceUseCase struct {
-	repository domain.EstimationRepository
+	txm db.TransactionManagerInterface
 }
 
 type UpdateCompetenceInputDTO struct {
@@ -55,30 +70,46 @@ type UpdateCompetenceOutputDTO struct {
 	mapper.CompetenceOutput
 }
 
-func NewUpdateCompetenceUseCase(repo domain.EstimationRepository) *UpdateCompetenceUseCase {
-	return &UpdateCompetenceUseCase{repo}
+func NewUpdateCompetenceUseCase(txm db.TransactionManagerInterface) *UpdateCompetenceUseCase {
+	return &UpdateCompetenceUseCase{txm}
 }
 
 func (uc *UpdateCompetenceUseCase) Execute(ctx context.Context, input UpdateCompetenceInputDTO) (*UpdateCompetenceOutputDTO, error) {
-	competence, err := uc.repository.GetCompetence(ctx, input.CompetenceID)
-	if err != nil {
-		return nil, err
-	}
+	var updated *domain.Competence
 
-	competence.ChangeCode(input.Code)
-	competence.ChangeName(input.Name)
+	err := uc.txm.Do(ctx, func(ctx context.Context, tx db.TransactionInterface) error {
+		repository, err := db.GetAs[domain.EstimationRepository](tx, "EstimationRepository")
+		if err != nil {
+			return err
+		}
 
-	err = competence.Validate()
-	if err != nil {
-		return nil, err
-	}
+		competence, err := repository.GetCompetence(ctx, input.CompetenceID)
+		if err != nil {
+			return err
+		}
+		before := mapper.CompetenceOutputFromDomain(*competence)
 
-	err = uc.repository.UpdateCompetence(ctx, competence)
-	if err != nil {
-		return nil, err
-	}
+		competence.ChangeCode(input.Code)
+		competence.ChangeName(input.Name)
+
+		err = competence.Validate()
+		if err != nil {
+			return err
+		}
+
+		err = repository.UpdateCompetence(ctx, competence)
+		if err != nil {
+			return err
+		}
+
+		updated, err = repository.GetCompetence(ctx, competence.CompetenceID)
+		if err != nil {
+			return err
+		}
+
+		return recordAudit(ctx, repository, domain.UpdateAction, domain.CompetenceEntity, competence.CompetenceID, before, mapper.CompetenceOutputFromDomain(*updated))
+	})
 
-	updated, err := uc.repository.GetCompetence(ctx, competence.CompetenceID)
 	if err != nil {
 		return nil, err
 	}
@@ -89,7 +120,7 @@ func (uc *UpdateCompetenceUseCase) Execute(ctx context.Context, input UpdateComp
 }
 
 type DeleteCompetenceUseCase struct {
-	repository domain.EstimationRepository
+	txm db.TransactionManagerInterface
 }
 
 type DeleteCompetenceInputDTO struct {
@@ -98,12 +129,30 @@ type DeleteCompetenceInputDTO struct {
 
 type DeleteCompetenceOutputDTO struct{}
 
-func NewDeleteCompetenceUseCase(repo domain.EstimationRepository) *DeleteCompetenceUseCase {
-	return &DeleteCompetenceUseCase{repo}
+func NewDeleteCompetenceUseCase(txm db.TransactionManagerInterface) *DeleteCompetenceUseCase {
+	return &DeleteCompetenceUseCase{txm}
 }
 
 func (uc *DeleteCompetenceUseCase) Execute(ctx context.Context, input DeleteCompetenceInputDTO) (*DeleteCompetenceOutputDTO, error) {
-	err := uc.repository.DeleteCompetence(ctx, input.CompetenceID)
+	err := uc.txm.Do(ctx, func(ctx context.Context, tx db.TransactionInterface) error {
+		repository, err := db.GetAs[domain.EstimationRepository](tx, "EstimationRepository")
+		if err != nil {
+			return err
+		}
+
+		competence, err := repository.GetCompetence(ctx, input.CompetenceID)
+		if err != nil {
+			return err
+		}
+
+		err = repository.DeleteCompetence(ctx, input.CompetenceID)
+		if err != nil {
+			return err
+		}
+
+		return recordAudit(ctx, repository, domain.DeleteAction, domain.CompetenceEntity, competence.CompetenceID, mapper.CompetenceOutputFromDomain(*competence), nil)
+	})
+
 	if err != nil {
 		return nil, err
 	}
@@ -3,7 +3,6 @@ package usecase
 import (
 	"context"
 	"errors"
-	"fmt"
 	"time"
 
 	"github.com/celsopires1999/estimation/internal/common"
@@ -13,8 +12,7 @@ import (
 )
 
 var (
-	ErrCostAllocationDateIsInvalid = errors.New("cost allocation date is invalid")
-	ErrCostBaselineMismatch        = errors.New("cost baseline mismatch")
+	ErrCostBaselineMismatch = errors.New("cost baseline mismatch")
 )
 
 type CreateCostUseCase struct {
@@ -26,11 +24,14 @@ type CreateCostInputDTO struct {
 	CostType        string                `json:"cost_type" validate:"required,oneof=one_time running investment" errmsg:"Cost type must be one of: one_time, running, investment"`
 	Description     string                `json:"description" validate:"required"`
 	Comment         string                `json:"comment" validate:"-"`
-	Amount          float64               `json:"amount" validate:"required,twodecimals"`
-	Currency        string                `json:"currency" validate:"required,oneof=BRL USD EUR"`
+	Amount          common.Money          `json:"amount" validate:"required_without=Recurrence,twodecimals"`
+	Currency        string                `json:"currency" validate:"required,len=3,alpha,uppercase"`
 	Tax             float64               `json:"tax" validate:"gte=0,twodecimals"`
+	TaxProfileID    string                `json:"tax_profile_id" validate:"omitempty,uuid4"`
 	ApplyInflation  bool                  `json:"apply_inflation" validate:"-"`
-	CostAllocations []CostAllocationInput `json:"cost_allocations" validate:"required,dive"`
+	CostAllocations []CostAllocationInput `json:"cost_allocations" validate:"required_without_all=Distribution Recurrence,excluded_with=Distribution Recurrence,dive"`
+	Distribution    *DistributionInput    `json:"distribution" validate:"omitempty,excluded_with=Recurrence"`
+	Recurrence      *RecurrenceInput      `json:"recurrence" validate:"omitempty"`
 }
 
 type CreateCostOutputDTO struct {
@@ -38,9 +39,30 @@ type CreateCostOutputDTO struct {
 }
 
 type CostAllocationInput struct {
-	Year   int     `json:"year" validate:"required"`
-	Month  int     `json:"month" validate:"gte=1,lte=12"`
-	Amount float64 `json:"amount" validate:"required"`
+	Year   int          `json:"year" validate:"required"`
+	Month  int          `json:"month" validate:"gte=1,lte=12"`
+	Amount common.Money `json:"amount" validate:"required,twodecimals"`
+}
+
+// DistributionInput generates the allocations from the amount instead of sending them one by one
+type DistributionInput struct {
+	StartYear  int       `json:"start_year" validate:"required"`
+	StartMonth int       `json:"start_month" validate:"gte=1,lte=12"`
+	EndYear    int       `json:"end_year" validate:"required"`
+	EndMonth   int       `json:"end_month" validate:"gte=1,lte=12"`
+	Strategy   string    `json:"strategy" validate:"required,oneof=even front_loaded back_loaded s_curve custom" errmsg:"Strategy must be one of: even, front_loaded, back_loaded, s_curve, custom"`
+	Weights    []float64 `json:"weights" validate:"omitempty,dive,gte=0"`
+}
+
+// RecurrenceInput generates the allocations of a running cost from a rule, and the cost amount is the sum of the occurrences
+type RecurrenceInput struct {
+	Frequency   string       `json:"frequency" validate:"required,oneof=monthly quarterly yearly" errmsg:"Frequency must be one of: monthly, quarterly, yearly"`
+	Amount      common.Money `json:"amount" validate:"required,twodecimals"`
+	StartYear   int          `json:"start_year" validate:"required"`
+	StartMonth  int          `json:"start_month" validate:"gte=1,lte=12"`
+	EndYear     int          `json:"end_year" validate:"required_with=EndMonth"`
+	EndMonth    int          `json:"end_month" validate:"required_with=EndYear,omitempty,gte=1,lte=12"`
+	Occurrences int          `json:"occurrences" validate:"omitempty,gte=1,lte=60"`
 }
 
 func NewCreateCostUseCase(txm db.TransactionManagerInterface) *CreateCostUseCase {
@@ -61,20 +83,26 @@ func (uc *CreateCostUseCase) Execute(ctx context.Context, input CreateCostInputD
 			return err
 		}
 
-		count, err := repository.CountPortfoliosByBaselineId(ctx, input.BaselineID)
-		if err != nil {
+		if err := baseline.ValidateEditable(); err != nil {
 			return err
 		}
-		if count > 0 {
-			return common.NewConflictError(fmt.Errorf("baseline %s has %d portfolio(s)", baseline.BaselineID, count))
-		}
 
-		costAllocations := make([]domain.CostAllocationProps, len(input.CostAllocations))
-		for i, allocation := range input.CostAllocations {
-			costAllocations[i] = domain.CostAllocationProps{
-				Year:   allocation.Year,
-				Month:  time.Month(allocation.Month),
-				Amount: allocation.Amount,
+		var costAllocations []domain.CostAllocationProps
+		if input.Recurrence != nil {
+			costAllocations = []domain.CostAllocationProps{}
+		} else if input.Distribution != nil {
+			costAllocations, err = distributeCost(input.Distribution, input.Amount)
+			if err != nil {
+				return err
+			}
+		} else {
+			costAllocations = make([]domain.CostAllocationProps, len(input.CostAllocations))
+			for i, allocation := range input.CostAllocations {
+				costAllocations[i] = domain.CostAllocationProps{
+					Year:   allocation.Year,
+					Month:  time.Month(allocation.Month),
+					Amount: allocation.Amount,
+				}
 			}
 		}
 		cost := domain.NewCost(domain.NewCostProps{
@@ -85,18 +113,33 @@ func (uc *CreateCostUseCase) Execute(ctx context.Context, input CreateCostInputD
 			Amount:          input.Amount,
 			Currency:        domain.Currency(input.Currency),
 			Tax:             input.Tax,
+			TaxProfileID:    input.TaxProfileID,
 			ApplyInflation:  input.ApplyInflation,
 			CostAllocations: costAllocations,
 		})
 
+		if input.Recurrence != nil {
+			recurrence, err := recurrenceFrom(input.Recurrence)
+			if err != nil {
+				return err
+			}
+			cost.ChangeRecurrence(recurrence)
+		}
+
 		if err := cost.Validate(); err != nil {
 			return err
 		}
 
-		for _, a := range cost.CostAllocations {
-			if baseline.StartDate.After(a.AllocationDate) {
-				return ErrCostAllocationDateIsInvalid
-			}
+		if err := repository.ValidateCurrency(ctx, cost.Currency); err != nil {
+			return err
+		}
+
+		if err := validateTaxProfile(ctx, repository, cost.TaxProfileID); err != nil {
+			return err
+		}
+
+		if err := baseline.ValidateCostAllocations(cost); err != nil {
+			return err
 		}
 
 		err = repository.CreateCost(ctx, cost)
@@ -109,7 +152,7 @@ func (uc *CreateCostUseCase) Execute(ctx context.Context, input CreateCostInputD
 			return err
 		}
 
-		return nil
+		return recordAudit(ctx, repository, domain.CreateAction, domain.CostEntity, cost.CostID, nil, mapper.CostOutputFromDomain(*createdCost))
 	})
 
 	if err != nil {
@@ -121,6 +164,39 @@ func (uc *CreateCostUseCase) Execute(ctx context.Context, input CreateCostInputD
 	return &CreateCostOutputDTO{output}, nil
 }
 
+func distributeCost(input *DistributionInput, amount common.Money) ([]domain.CostAllocationProps, error) {
+	distribution := domain.NewDistribution(domain.NewDistributionProps{
+		Strategy:   domain.DistributionStrategy(input.Strategy),
+		StartYear:  input.StartYear,
+		StartMonth: time.Month(input.StartMonth),
+		EndYear:    input.EndYear,
+		EndMonth:   time.Month(input.EndMonth),
+		Weights:    input.Weights,
+	})
+	if err := distribution.Validate(); err != nil {
+		return nil, err
+	}
+
+	return distribution.DistributeCost(amount), nil
+}
+
+func recurrenceFrom(input *RecurrenceInput) (*domain.Recurrence, error) {
+	recurrence := &domain.Recurrence{
+		Frequency:   domain.RecurrenceFrequency(input.Frequency),
+		Amount:      input.Amount,
+		StartYear:   input.StartYear,
+		StartMonth:  time.Month(input.StartMonth),
+		EndYear:     input.EndYear,
+		EndMonth:    time.Month(input.EndMonth),
+		Occurrences: input.Occurrences,
+	}
+	if err := recurrence.Validate(); err != nil {
+		return nil, err
+	}
+
+	return recurrence, nil
+}
+
 // UpdateCostUseCase represents the use case for updating a cost
 type UpdateCostUseCase struct {
 	txm db.TransactionManagerInterface
@@ -132,11 +208,14 @@ type UpdateCostInputDTO struct {
 	CostType        *string                `json:"cost_type" validate:"omitempty,required,oneof=one_time running investment" errmsg:"Cost type must be one of: one_time, running, investment"`
 	Description     *string                `json:"description" validate:"omitempty,required"`
 	Comment         *string                `json:"comment" validate:"omitempty"`
-	Amount          *float64               `json:"amount" validate:"omitempty,required,twodecimals"`
-	Currency        *string                `json:"currency" validate:"omitempty,required,oneof=BRL USD EUR"`
+	Amount          *common.Money          `json:"amount" validate:"omitempty,required,twodecimals"`
+	Currency        *string                `json:"currency" validate:"omitempty,required,len=3,alpha,uppercase"`
 	Tax             *float64               `json:"tax" validate:"omitempty,gte=0,twodecimals"`
+	TaxProfileID    *string                `json:"tax_profile_id" validate:"omitempty"`
 	ApplyInflation  *bool                  `json:"apply_inflation" validate:"omitempty"`
-	CostAllocations []*CostAllocationInput `json:"cost_allocations" validate:"omitempty,required,dive"`
+	CostAllocations []*CostAllocationInput `json:"cost_allocations" validate:"omitempty,excluded_with=Distribution Recurrence,required,dive"`
+	Distribution    *DistributionInput     `json:"distribution" validate:"omitempty,excluded_with=Recurrence"`
+	Recurrence      *RecurrenceInput       `json:"recurrence" validate:"omitempty"`
 }
 
 type UpdateCostOutputDTO struct {
@@ -167,13 +246,15 @@ func (uc *UpdateCostUseCase) Execute(ctx context.Context, input UpdateCostInputD
 			return ErrCostBaselineMismatch
 		}
 
-		count, err := repository.CountPortfoliosByBaselineId(ctx, input.BaselineID)
+		baseline, err := repository.GetBaseline(ctx, input.BaselineID)
 		if err != nil {
 			return err
 		}
-		if count > 0 {
-			return common.NewConflictError(fmt.Errorf("baseline %s has %d portfolio(s)", input.BaselineID, count))
+
+		if err := baseline.ValidateEditable(); err != nil {
+			return err
 		}
+		before := mapper.CostOutputFromDomain(*cost)
 
 		cost.ChangeCostType(input.CostType)
 		cost.ChangeDescription(input.Description)
@@ -181,6 +262,7 @@ func (uc *UpdateCostUseCase) Execute(ctx context.Context, input UpdateCostInputD
 		cost.ChangeAmount(input.Amount)
 		cost.ChangeCurrency(input.Currency)
 		cost.ChangeTax(input.Tax)
+		cost.ChangeTaxProfileID(input.TaxProfileID)
 		cost.ChangeApplyInflation(input.ApplyInflation)
 
 		if input.CostAllocations != nil {
@@ -195,10 +277,42 @@ func (uc *UpdateCostUseCase) Execute(ctx context.Context, input UpdateCostInputD
 			cost.ChangeCostAllocations(costAllocations)
 		}
 
+		if input.Distribution != nil {
+			costAllocations, err := distributeCost(input.Distribution, cost.Amount)
+			if err != nil {
+				return err
+			}
+			cost.ChangeCostAllocations(costAllocations)
+		}
+
+		if input.Recurrence != nil {
+			recurrence, err := recurrenceFrom(input.Recurrence)
+			if err != nil {
+				return err
+			}
+			cost.ChangeRecurrence(recurrence)
+		}
+
 		if err := cost.Validate(); err != nil {
 			return err
 		}
 
+		if input.Currency != nil {
+			if err := repository.ValidateCurrency(ctx, cost.Currency); err != nil {
+				return err
+			}
+		}
+
+		if input.TaxProfileID != nil {
+			if err := validateTaxProfile(ctx, repository, cost.TaxProfileID); err != nil {
+				return err
+			}
+		}
+
+		if err := baseline.ValidateCostAllocations(cost); err != nil {
+			return err
+		}
+
 		err = repository.UpdateCost(ctx, cost)
 		if err != nil {
 			return err
@@ -209,7 +323,7 @@ func (uc *UpdateCostUseCase) Execute(ctx context.Context, input UpdateCostInputD
 			return err
 		}
 
-		return nil
+		return recordAudit(ctx, repository, domain.UpdateAction, domain.CostEntity, cost.CostID, before, mapper.CostOutputFromDomain(*updatedCost))
 	})
 
 	if err != nil {
@@ -255,12 +369,13 @@ func (uc *DeleteCostUseCase) Execute(ctx context.Context, input DeleteCostInputD
 			return ErrCostBaselineMismatch
 		}
 
-		count, err := repository.CountPortfoliosByBaselineId(ctx, input.BaselineID)
+		baseline, err := repository.GetBaseline(ctx, input.BaselineID)
 		if err != nil {
 			return err
 		}
-		if count > 0 {
-			return common.NewConflictError(fmt.Errorf("baseline %s has %d portfolio(s)", input.BaselineID, count))
+
+		if err := baseline.ValidateEditable(); err != nil {
+			return err
 		}
 
 		err = repository.DeleteCost(ctx, input.CostID)
@@ -268,7 +383,7 @@ func (uc *DeleteCostUseCase) Execute(ctx context.Context, input DeleteCostInputD
 			return err
 		}
 
-		return nil
+		return recordAudit(ctx, repository, domain.DeleteAction, domain.CostEntity, cost.CostID, mapper.CostOutputFromDomain(*cost), nil)
 	})
 
 	if err != nil {
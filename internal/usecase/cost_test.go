@@ -2,10 +2,12 @@ package usecase_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
 
+	"github.com/celsopires1999/estimation/internal/common"
 	"github.com/celsopires1999/estimation/internal/domain"
 	"github.com/celsopires1999/estimation/internal/infra/db"
 	"github.com/celsopires1999/estimation/internal/infra/repository"
@@ -54,6 +56,7 @@ func (s *CreateCostUsecaseTestSuite) SetupSubTest() {
 
 	s.baseline = testutils.NewBaselineFakeBuilder().
 		WithStartDate(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)).
+		WithDuration(12).
 		WithManagerID(user.UserID).
 		WithEstimatorID(user.UserID).
 		Build()
@@ -74,7 +77,7 @@ func (s *CreateCostUsecaseTestSuite) TestIntegrationCreateCost() {
 		costType        domain.CostType
 		description     string
 		comment         string
-		amount          float64
+		amount          common.Money
 		currency        domain.Currency
 		tax             float64
 		applyInflation  bool
@@ -87,13 +90,13 @@ func (s *CreateCostUsecaseTestSuite) TestIntegrationCreateCost() {
 			costType:       domain.OneTimeCost,
 			description:    "Mão de obra do PMO",
 			comment:        "estimativa do Ferraz",
-			amount:         100.0,
+			amount:         common.NewMoney(100.0),
 			currency:       domain.EUR,
 			tax:            0.0,
 			applyInflation: false,
 			costAllocations: []usecase.CostAllocationInput{
-				{Year: 2020, Month: 1, Amount: 60.},
-				{Year: 2020, Month: 8, Amount: 40.},
+				{Year: 2020, Month: 1, Amount: common.NewMoney(60.)},
+				{Year: 2020, Month: 8, Amount: common.NewMoney(40.)},
 			},
 		},
 		{
@@ -101,13 +104,13 @@ func (s *CreateCostUsecaseTestSuite) TestIntegrationCreateCost() {
 			costType:       domain.RunningCost,
 			description:    "Mão de obra do PMO",
 			comment:        "estimativa do Ferraz",
-			amount:         100.0,
+			amount:         common.NewMoney(100.0),
 			currency:       domain.EUR,
 			tax:            10.0,
 			applyInflation: false,
 			costAllocations: []usecase.CostAllocationInput{
-				{Year: 2020, Month: 1, Amount: 60.},
-				{Year: 2020, Month: 8, Amount: 40.},
+				{Year: 2020, Month: 1, Amount: common.NewMoney(60.)},
+				{Year: 2020, Month: 8, Amount: common.NewMoney(40.)},
 			},
 		},
 	}
@@ -140,7 +143,7 @@ func (s *CreateCostUsecaseTestSuite) TestIntegrationCreateCost() {
 			s.Equal(tc.costType.String(), cost.CostType)
 			s.Equal(tc.description, cost.Description)
 			s.Equal(tc.comment, cost.Comment)
-			s.Equal(tc.amount, cost.Amount)
+			s.Equal(tc.amount.String(), cost.Amount.String())
 			s.Equal(tc.currency.String(), cost.Currency)
 			s.Equal(tc.tax, cost.Tax)
 			s.Equal(tc.applyInflation, cost.ApplyInflation)
@@ -148,7 +151,7 @@ func (s *CreateCostUsecaseTestSuite) TestIntegrationCreateCost() {
 			for i := range tc.costAllocations {
 				s.Equal(tc.costAllocations[i].Year, cost.CostAllocations[i].Year)
 				s.Equal(tc.costAllocations[i].Month, cost.CostAllocations[i].Month)
-				s.Equal(tc.costAllocations[i].Amount, cost.CostAllocations[i].Amount)
+				s.Equal(tc.costAllocations[i].Amount.String(), cost.CostAllocations[i].Amount.String())
 			}
 		})
 	}
@@ -166,17 +169,55 @@ func (s *CreateCostUsecaseTestSuite) TestIntegrationCreateCost() {
 			CostType:       faker.CostType.String(),
 			Description:    faker.Description,
 			Comment:        faker.Comment,
-			Amount:         1_550.00,
+			Amount:         common.NewMoney(1_550.00),
 			Currency:       faker.Currency.String(),
 			Tax:            0.0,
 			ApplyInflation: faker.ApplyInflation,
 			CostAllocations: []usecase.CostAllocationInput{
-				{Year: 2020, Month: 1, Amount: 1_000.00},
-				{Year: 2019, Month: 12, Amount: 550.00},
+				{Year: 2020, Month: 1, Amount: common.NewMoney(1_000.00)},
+				{Year: 2019, Month: 12, Amount: common.NewMoney(550.00)},
 			},
 		}
 		uc := usecase.NewCreateCostUseCase(txm)
 		_, err := uc.Execute(ctx, input)
-		s.Equal(usecase.ErrCostAllocationDateIsInvalid, err)
+		var errDomainValidation *common.DomainValidationError
+		s.True(errors.As(err, &errDomainValidation))
+		s.ErrorContains(err, domain.ErrAllocationOutsideBaseline.Error())
+		s.ErrorContains(err, "2020-01 to 2020-12: 2019-12")
+	})
+
+	s.Run("should create cost allocations from a distribution", func() {
+		ctx := context.Background()
+		txm := db.NewTransactionManager(s.dbpool)
+		txm.Register("EstimationRepository", func(q *db.Queries) any {
+			return repository.NewEstimationRepositoryTxmPostgres(q)
+		})
+
+		faker := testutils.NewCostFakeBuilder()
+		input := usecase.CreateCostInputDTO{
+			BaselineID:     s.baseline.BaselineID,
+			CostType:       domain.RunningCost.String(),
+			Description:    faker.Description,
+			Comment:        faker.Comment,
+			Amount:         common.NewMoney(1_000.00),
+			Currency:       domain.BRL.String(),
+			Tax:            0.0,
+			ApplyInflation: faker.ApplyInflation,
+			Distribution: &usecase.DistributionInput{
+				StartYear:  2020,
+				StartMonth: 1,
+				EndYear:    2020,
+				EndMonth:   3,
+				Strategy:   domain.EvenDistribution.String(),
+			},
+		}
+		uc := usecase.NewCreateCostUseCase(txm)
+		cost, err := uc.Execute(ctx, input)
+		s.Nil(err)
+
+		s.Equal(3, len(cost.CostAllocations))
+		s.Equal("333.34", cost.CostAllocations[0].Amount.String())
+		s.Equal("333.33", cost.CostAllocations[1].Amount.String())
+		s.Equal("333.33", cost.CostAllocations[2].Amount.String())
 	})
 }
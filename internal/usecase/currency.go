@@ -0,0 +1,157 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/celsopires1999/estimation/internal/domain"
+	"github.com/celsopires1999/estimation/internal/mapper"
+)
+
+type CreateCurrencyUseCase struct {
+	repository domain.EstimationRepository
+}
+
+type CreateCurrencyInputDTO struct {
+	Code string `json:"code" validate:"required,len=3,alpha,uppercase"`
+	Name string `json:"name" validate:"required,max=50"`
+}
+
+type CreateCurrencyOutputDTO struct {
+	mapper.CurrencyOutput
+}
+
+func NewCreateCurrencyUseCase(repo domain.EstimationRepository) *CreateCurrencyUseCase {
+	return &CreateCurrencyUseCase{repo}
+}
+
+func (uc *CreateCurrencyUseCase) Execute(ctx context.Context, input CreateCurrencyInputDTO) (*CreateCurrencyOutputDTO, error) {
+	currency := domain.NewCurrencyEntry(domain.Currency(input.Code), input.Name)
+	if err := currency.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := uc.repository.CreateCurrency(ctx, currency); err != nil {
+		return nil, err
+	}
+
+	createdCurrency, err := uc.repository.GetCurrency(ctx, currency.CurrencyID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := mapper.CurrencyOutputFromDomain(*createdCurrency)
+
+	return &CreateCurrencyOutputDTO{output}, nil
+}
+
+type UpdateCurrencyUseCase struct {
+	repository domain.EstimationRepository
+}
+
+type UpdateCurrencyInputDTO struct {
+	CurrencyID string  `json:"currency_id" validate:"required,uuid4"`
+	Name       *string `json:"name" validate:"omitempty,max=50"`
+}
+
+type UpdateCurrencyOutputDTO struct {
+	mapper.CurrencyOutput
+}
+
+func NewUpdateCurrencyUseCase(repo domain.EstimationRepository) *UpdateCurrencyUseCase {
+	return &UpdateCurrencyUseCase{repo}
+}
+
+func (uc *UpdateCurrencyUseCase) Execute(ctx context.Context, input UpdateCurrencyInputDTO) (*UpdateCurrencyOutputDTO, error) {
+	currency, err := uc.repository.GetCurrency(ctx, input.CurrencyID)
+	if err != nil {
+		return nil, err
+	}
+
+	currency.ChangeName(input.Name)
+
+	err = currency.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.repository.UpdateCurrency(ctx, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := uc.repository.GetCurrency(ctx, currency.CurrencyID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := mapper.CurrencyOutputFromDomain(*updated)
+
+	return &UpdateCurrencyOutputDTO{output}, nil
+}
+
+type DeleteCurrencyUseCase struct {
+	repository domain.EstimationRepository
+}
+
+type DeleteCurrencyInputDTO struct {
+	CurrencyID string `json:"currency_id" validate:"required"`
+}
+
+type DeleteCurrencyOutputDTO struct{}
+
+func NewDeleteCurrencyUseCase(repo domain.EstimationRepository) *DeleteCurrencyUseCase {
+	return &DeleteCurrencyUseCase{repo}
+}
+
+func (uc *DeleteCurrencyUseCase) Execute(ctx context.Context, input DeleteCurrencyInputDTO) (*DeleteCurrencyOutputDTO, error) {
+	currency, err := uc.repository.GetCurrency(ctx, input.CurrencyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if currency.Code.IsBRL() {
+		return nil, common.NewDomainValidationError(domain.ErrBaseCurrencyDeletion)
+	}
+
+	err = uc.repository.DeleteCurrency(ctx, input.CurrencyID)
+	if err != nil {
+		return nil, err
+	}
+	return &DeleteCurrencyOutputDTO{}, nil
+}
+
+type GetCurrencyUseCase struct {
+	repository domain.EstimationRepository
+}
+
+type GetCurrencyInputDTO struct {
+	CurrencyID string `json:"currency_id" validate:"required"`
+}
+
+type GetCurrencyOutputDTO struct {
+	mapper.CurrencyOutput
+}
+
+func NewGetCurrencyUseCase(repo domain.EstimationRepository) *GetCurrencyUseCase {
+	return &GetCurrencyUseCase{repo}
+}
+
+func (uc *GetCurrencyUseCase) Execute(ctx context.Context, input GetCurrencyInputDTO) (*GetCurrencyOutputDTO, error) {
+	currency, err := uc.repository.GetCurrency(ctx, input.CurrencyID)
+	if err != nil {
+		return nil, err
+	}
+	output := mapper.CurrencyOutputFromDomain(*currency)
+	return &GetCurrencyOutputDTO{output}, nil
+}
+
+// validateCurrencies checks that every currency is registered in the catalogue
+func validateCurrencies(ctx context.Context, repository domain.EstimationRepository, currencies []domain.Currency) error {
+	for _, currency := range currencies {
+		if err := repository.ValidateCurrency(ctx, currency); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,265 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/celsopires1999/estimation/internal/domain"
+	"github.com/celsopires1999/estimation/internal/mapper"
+)
+
+var ErrProgressPortfolioMismatch = errors.New("progress portfolio mismatch")
+
+// RecordProgressUseCase records the percent complete of a cost or an effort at the end of a month.
+// Recording the same cost or effort and month again replaces its percent complete.
+type RecordProgressUseCase struct {
+	repository domain.EstimationRepository
+}
+
+type RecordProgressInputDTO struct {
+	PortfolioID     string  `json:"portfolio_id" validate:"required,uuid4"`
+	CostID          string  `json:"cost_id" validate:"required_without=EffortID,excluded_with=EffortID,omitempty,uuid4"`
+	EffortID        string  `json:"effort_id" validate:"required_without=CostID,omitempty,uuid4"`
+	Year            int     `json:"year" validate:"required"`
+	Month           int     `json:"month" validate:"required,gte=1,lte=12"`
+	PercentComplete float64 `json:"percent_complete" validate:"gte=0,lte=100"`
+}
+
+type RecordProgressOutputDTO struct {
+	mapper.ProgressOutput
+}
+
+func NewRecordProgressUseCase(repository domain.EstimationRepository) *RecordProgressUseCase {
+	return &RecordProgressUseCase{repository}
+}
+
+func (uc *RecordProgressUseCase) Execute(ctx context.Context, input RecordProgressInputDTO) (*RecordProgressOutputDTO, error) {
+	if _, err := uc.repository.GetPortfolio(ctx, input.PortfolioID); err != nil {
+		return nil, err
+	}
+
+	progressMany, err := uc.repository.GetProgressManyByPortfolioID(ctx, input.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, progress := range progressMany {
+		if !progress.IsFor(input.CostID, input.EffortID, input.Year, time.Month(input.Month)) {
+			continue
+		}
+
+		progress.ChangePercentComplete(input.PercentComplete)
+		if err := progress.Validate(); err != nil {
+			return nil, err
+		}
+		if err := uc.repository.UpdateProgress(ctx, progress); err != nil {
+			return nil, err
+		}
+		return uc.output(ctx, progress.ProgressID)
+	}
+
+	budgets, err := uc.repository.GetBudgetManyByPortfolioID(ctx, input.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+
+	workloads, err := uc.repository.GetWorkloadManyByPortfolioID(ctx, input.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+
+	progress := domain.NewProgress(domain.NewProgressProps{
+		PortfolioID:     input.PortfolioID,
+		CostID:          input.CostID,
+		EffortID:        input.EffortID,
+		Year:            input.Year,
+		Month:           time.Month(input.Month),
+		PercentComplete: input.PercentComplete,
+	})
+
+	if err := progress.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := progress.ValidatePortfolio(budgets, workloads); err != nil {
+		return nil, err
+	}
+
+	if err := uc.repository.CreateProgress(ctx, progress); err != nil {
+		return nil, err
+	}
+
+	return uc.output(ctx, progress.ProgressID)
+}
+
+func (uc *RecordProgressUseCase) output(ctx context.Context, progressID string) (*RecordProgressOutputDTO, error) {
+	progress, err := uc.repository.GetProgress(ctx, progressID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := mapper.ProgressOutputFromDomain(*progress)
+
+	return &RecordProgressOutputDTO{output}, nil
+}
+
+type DeleteProgressUseCase struct {
+	repository domain.EstimationRepository
+}
+
+type DeleteProgressInputDTO struct {
+	ProgressID  string `json:"progress_id" validate:"required"`
+	PortfolioID string `json:"portfolio_id" validate:"required"`
+}
+
+type DeleteProgressOutputDTO struct{}
+
+func NewDeleteProgressUseCase(repository domain.EstimationRepository) *DeleteProgressUseCase {
+	return &DeleteProgressUseCase{repository}
+}
+
+func (uc *DeleteProgressUseCase) Execute(ctx context.Context, input DeleteProgressInputDTO) (*DeleteProgressOutputDTO, error) {
+	progress, err := uc.repository.GetProgress(ctx, input.ProgressID)
+	if err != nil {
+		return nil, err
+	}
+
+	if progress.PortfolioID != input.PortfolioID {
+		return nil, ErrProgressPortfolioMismatch
+	}
+
+	err = uc.repository.DeleteProgress(ctx, input.ProgressID)
+	if err != nil {
+		return nil, err
+	}
+	return &DeleteProgressOutputDTO{}, nil
+}
+
+// GetEarnedValueUseCase calculates the earned value of a portfolio by month
+type GetEarnedValueUseCase struct {
+	repository domain.EstimationRepository
+}
+
+type GetEarnedValueInputDTO struct {
+	PortfolioID string `json:"portfolio_id" validate:"required,uuid4"`
+}
+
+type GetEarnedValueOutputDTO struct {
+	mapper.EarnedValueOutput
+}
+
+func NewGetEarnedValueUseCase(repository domain.EstimationRepository) *GetEarnedValueUseCase {
+	return &GetEarnedValueUseCase{repository}
+}
+
+func (uc *GetEarnedValueUseCase) Execute(ctx context.Context, input GetEarnedValueInputDTO) (*GetEarnedValueOutputDTO, error) {
+	portfolio, err := uc.repository.GetPortfolio(ctx, input.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+
+	budgets, err := uc.repository.GetBudgetManyByPortfolioID(ctx, portfolio.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+
+	workloads, err := uc.repository.GetWorkloadManyByPortfolioID(ctx, portfolio.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+
+	actuals, err := uc.repository.GetActualManyByPortfolioID(ctx, portfolio.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+
+	progress, err := uc.repository.GetProgressManyByPortfolioID(ctx, portfolio.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+
+	costs, err := uc.repository.GetCostManyByBaselineID(ctx, portfolio.BaselineID)
+	if err != nil {
+		return nil, err
+	}
+
+	efforts, err := uc.repository.GetEffortManyByBaselineID(ctx, portfolio.BaselineID)
+	if err != nil {
+		return nil, err
+	}
+
+	earnedValue := domain.CalculateEarnedValue(budgets, workloads, actuals, progress)
+	output := mapper.EarnedValueOutputFromDomain(portfolio.PortfolioID, *earnedValue, costs, efforts)
+
+	return &GetEarnedValueOutputDTO{output}, nil
+}
+
+// GetForecastUseCase re-phases the budget of a portfolio that is left after the actual spend
+type GetForecastUseCase struct {
+	repository domain.EstimationRepository
+}
+
+// GetForecastInputDTO takes the year and month of the last actual spend to consider.
+// Without them the forecast is as of the last month with actual spend, or the month before the portfolio starts.
+type GetForecastInputDTO struct {
+	PortfolioID string `json:"portfolio_id" validate:"required,uuid4"`
+	Strategy    string `json:"strategy" validate:"omitempty,oneof=even front_loaded back_loaded s_curve ramp_up ramp_down"`
+	Year        int    `json:"year" validate:"required_with=Month"`
+	Month       int    `json:"month" validate:"required_with=Year,omitempty,gte=1,lte=12"`
+}
+
+type GetForecastOutputDTO struct {
+	mapper.ForecastOutput
+}
+
+func NewGetForecastUseCase(repository domain.EstimationRepository) *GetForecastUseCase {
+	return &GetForecastUseCase{repository}
+}
+
+func (uc *GetForecastUseCase) Execute(ctx context.Context, input GetForecastInputDTO) (*GetForecastOutputDTO, error) {
+	portfolio, err := uc.repository.GetPortfolio(ctx, input.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+
+	budgets, err := uc.repository.GetBudgetManyByPortfolioID(ctx, portfolio.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+
+	actuals, err := uc.repository.GetActualManyByPortfolioID(ctx, portfolio.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+
+	asOf := portfolio.StartDate.AddDate(0, -1, 0)
+	if input.Year != 0 {
+		asOf = time.Date(input.Year, time.Month(input.Month), 1, 0, 0, 0, 0, time.UTC)
+	} else {
+		for _, actual := range actuals {
+			if actual.SpendDate.After(asOf) {
+				asOf = actual.SpendDate
+			}
+		}
+	}
+
+	strategy := domain.EvenDistribution
+	if input.Strategy != "" {
+		strategy = domain.DistributionStrategy(input.Strategy)
+	}
+
+	forecast, err := domain.ForecastBudgets(budgets, actuals, asOf, strategy)
+	if err != nil {
+		return nil, err
+	}
+
+	costs, err := uc.repository.GetCostManyByBaselineID(ctx, portfolio.BaselineID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := mapper.ForecastOutputFromDomain(portfolio.PortfolioID, strategy.String(), *forecast, costs)
+
+	return &GetForecastOutputDTO{output}, nil
+}
@@ -3,18 +3,15 @@ package usecase
 import (
 	"context"
 	"errors"
-	"fmt"
 	"time"
 
-	"github.com/celsopires1999/estimation/internal/common"
 	"github.com/celsopires1999/estimation/internal/domain"
 	"github.com/celsopires1999/estimation/internal/infra/db"
 	"github.com/celsopires1999/estimation/internal/mapper"
 )
 
 var (
-	ErrEffortAllocationDateIsInvalid = errors.New("effort allocation date is invalid")
-	ErrEffortBaselineMismatch        = errors.New("effort baseline mismatch")
+	ErrEffortBaselineMismatch = errors.New("effort baseline mismatch")
 )
 
 type CreateEffortUseCase struct {
@@ -22,11 +19,12 @@ type CreateEffortUseCase struct {
 }
 
 type CreateEffortInputDTO struct {
-	BaselineID        string                  `json:"baseline_id" validate:"required,uuid4"`
-	CompetenceID      string                  `json:"competence_id" validate:"required,uuid4"`
-	Comment           string                  `json:"comment"`
-	Hours             int                     `json:"hours" validate:"required,gte=1,lte=160_000"`
-	EffortAllocations []EffortAllocationInput `json:"effort_allocations" validate:"required,dive"`
+	BaselineID        string                   `json:"baseline_id" validate:"required,uuid4"`
+	CompetenceID      string                   `json:"competence_id" validate:"required,uuid4"`
+	Comment           string                   `json:"comment"`
+	Hours             int                      `json:"hours" validate:"required,gte=1,lte=160_000"`
+	EffortAllocations []EffortAllocationInput  `json:"effort_allocations" validate:"required_without=Distribution,excluded_with=Distribution,dive"`
+	Distribution      *EffortDistributionInput `json:"distribution" validate:"omitempty"`
 }
 
 type EffortAllocationInput struct {
@@ -35,6 +33,16 @@ type EffortAllocationInput struct {
 	Hours int `json:"hours" validate:"required,gte=1,lte=8_000"`
 }
 
+// EffortDistributionInput generates the allocations from the hours instead of sending them one by one
+type EffortDistributionInput struct {
+	StartYear  int       `json:"start_year" validate:"required"`
+	StartMonth int       `json:"start_month" validate:"gte=1,lte=12"`
+	EndYear    int       `json:"end_year" validate:"required"`
+	EndMonth   int       `json:"end_month" validate:"gte=1,lte=12"`
+	Strategy   string    `json:"strategy" validate:"required,oneof=even ramp_up ramp_down custom" errmsg:"Strategy must be one of: even, ramp_up, ramp_down, custom"`
+	Weights    []float64 `json:"weights" validate:"omitempty,dive,gte=0"`
+}
+
 type CreateEffortOutputDTO struct {
 	mapper.EffortOutput
 }
@@ -57,20 +65,24 @@ func (uc *CreateEffortUseCase) Execute(ctx context.Context, input CreateEffortIn
 			return err
 		}
 
-		count, err := repository.CountPortfoliosByBaselineId(ctx, input.BaselineID)
-		if err != nil {
+		if err := baseline.ValidateEditable(); err != nil {
 			return err
 		}
-		if count > 0 {
-			return common.NewConflictError(fmt.Errorf("baseline %s has %d portfolio(s)", baseline.BaselineID, count))
-		}
 
-		effortAllocations := make([]domain.EffortAllocationProps, len(input.EffortAllocations))
-		for i, allocation := range input.EffortAllocations {
-			effortAllocations[i] = domain.EffortAllocationProps{
-				Year:  allocation.Year,
-				Month: time.Month(allocation.Month),
-				Hours: allocation.Hours,
+		var effortAllocations []domain.EffortAllocationProps
+		if input.Distribution != nil {
+			effortAllocations, err = distributeHours(input.Distribution, input.Hours)
+			if err != nil {
+				return err
+			}
+		} else {
+			effortAllocations = make([]domain.EffortAllocationProps, len(input.EffortAllocations))
+			for i, allocation := range input.EffortAllocations {
+				effortAllocations[i] = domain.EffortAllocationProps{
+					Year:  allocation.Year,
+					Month: time.Month(allocation.Month),
+					Hours: allocation.Hours,
+				}
 			}
 		}
 		effort := domain.NewEffort(domain.NewEffortProps{
@@ -85,10 +97,8 @@ func (uc *CreateEffortUseCase) Execute(ctx context.Context, input CreateEffortIn
 			return err
 		}
 
-		for _, a := range effort.EffortAllocations {
-			if baseline.StartDate.After(a.AllocationDate) {
-				return ErrEffortAllocationDateIsInvalid
-			}
+		if err := baseline.ValidateEffortAllocations(effort); err != nil {
+			return err
 		}
 
 		err = repository.CreateEffort(ctx, effort)
@@ -101,7 +111,7 @@ func (uc *CreateEffortUseCase) Execute(ctx context.Context, input CreateEffortIn
 			return err
 		}
 
-		return nil
+		return recordAudit(ctx, repository, domain.CreateAction, domain.EffortEntity, effort.EffortID, nil, mapper.EffortOutputFromDomain(*createdEffort))
 	})
 
 	if err != nil {
@@ -113,6 +123,22 @@ func (uc *CreateEffortUseCase) Execute(ctx context.Context, input CreateEffortIn
 	return &CreateEffortOutputDTO{output}, nil
 }
 
+func distributeHours(input *EffortDistributionInput, hours int) ([]domain.EffortAllocationProps, error) {
+	distribution := domain.NewDistribution(domain.NewDistributionProps{
+		Strategy:   domain.DistributionStrategy(input.Strategy),
+		StartYear:  input.StartYear,
+		StartMonth: time.Month(input.StartMonth),
+		EndYear:    input.EndYear,
+		EndMonth:   time.Month(input.EndMonth),
+		Weights:    input.Weights,
+	})
+	if err := distribution.Validate(); err != nil {
+		return nil, err
+	}
+
+	return distribution.DistributeHours(hours), nil
+}
+
 type UpdateEffortUseCase struct {
 	txm db.TransactionManagerInterface
 }
@@ -123,7 +149,8 @@ type UpdateEffortInputDTO struct {
 	CompetenceID      *string                  `json:"competence_id" validate:"omitempty,required,uuid4"`
 	Comment           *string                  `json:"comment"`
 	Hours             *int                     `json:"hours" validate:"omitempty,required,gte=1,lte=160_000"`
-	EffortAllocations []*EffortAllocationInput `json:"effort_allocations" validate:"omitempty,required,dive"`
+	EffortAllocations []*EffortAllocationInput `json:"effort_allocations" validate:"omitempty,excluded_with=Distribution,required,dive"`
+	Distribution      *EffortDistributionInput `json:"distribution" validate:"omitempty"`
 }
 
 type UpdateEffortOutputDTO struct {
@@ -152,13 +179,15 @@ func (uc *UpdateEffortUseCase) Execute(ctx context.Context, input UpdateEffortIn
 			return ErrEffortBaselineMismatch
 		}
 
-		count, err := repository.CountPortfoliosByBaselineId(ctx, input.BaselineID)
+		baseline, err := repository.GetBaseline(ctx, input.BaselineID)
 		if err != nil {
 			return err
 		}
-		if count > 0 {
-			return common.NewConflictError(fmt.Errorf("baseline %s has %d portfolio(s)", input.BaselineID, count))
+
+		if err := baseline.ValidateEditable(); err != nil {
+			return err
 		}
+		before := mapper.EffortOutputFromDomain(*effort)
 
 		effort.ChangeComment(input.Comment)
 		effort.ChangeHours(input.Hours)
@@ -175,10 +204,22 @@ func (uc *UpdateEffortUseCase) Execute(ctx context.Context, input UpdateEffortIn
 			effort.ChangeEffortAllocations(effortAllocations)
 		}
 
+		if input.Distribution != nil {
+			effortAllocations, err := distributeHours(input.Distribution, effort.Hours)
+			if err != nil {
+				return err
+			}
+			effort.ChangeEffortAllocations(effortAllocations)
+		}
+
 		if err := effort.Validate(); err != nil {
 			return err
 		}
 
+		if err := baseline.ValidateEffortAllocations(effort); err != nil {
+			return err
+		}
+
 		err = repository.UpdateEffort(ctx, effort)
 		if err != nil {
 			return err
@@ -189,7 +230,7 @@ func (uc *UpdateEffortUseCase) Execute(ctx context.Context, input UpdateEffortIn
 			return err
 		}
 
-		return nil
+		return recordAudit(ctx, repository, domain.UpdateAction, domain.EffortEntity, effort.EffortID, before, mapper.EffortOutputFromDomain(*updatedEffort))
 	})
 
 	if err != nil {
@@ -232,12 +273,13 @@ func (uc *DeleteEffortUseCase) Execute(ctx context.Context, input DeleteEffortIn
 			return ErrEffortBaselineMismatch
 		}
 
-		count, err := repository.CountPortfoliosByBaselineId(ctx, input.BaselineID)
+		baseline, err := repository.GetBaseline(ctx, input.BaselineID)
 		if err != nil {
 			return err
 		}
-		if count > 0 {
-			return common.NewConflictError(fmt.Errorf("baseline %s has %d portfolio(s)", input.BaselineID, count))
+
+		if err := baseline.ValidateEditable(); err != nil {
+			return err
 		}
 
 		err = repository.DeleteEffort(ctx, input.EffortID)
@@ -245,7 +287,7 @@ func (uc *DeleteEffortUseCase) Execute(ctx context.Context, input DeleteEffortIn
 			return err
 		}
 
-		return nil
+		return recordAudit(ctx, repository, domain.DeleteAction, domain.EffortEntity, effort.EffortID, mapper.EffortOutputFromDomain(*effort), nil)
 	})
 
 	if err != nil {
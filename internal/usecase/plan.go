@@ -7,48 +7,74 @@ import (
 
 	"github.com/celsopires1999/estimation/internal/common"
 	"github.com/celsopires1999/estimation/internal/domain"
+	"github.com/celsopires1999/estimation/internal/infra/db"
 	"github.com/celsopires1999/estimation/internal/mapper"
 	"github.com/jackc/pgx/v5/pgconn"
 )
 
 // CreatePlanUseCase is responsible for creating a new plan in the system
 type CreatePlanUseCase struct {
-	repository domain.EstimationRepository
+	txm db.TransactionManagerInterface
 }
 
 type CreatePlanInputDTO struct {
-	Code        string             `json:"code" validate:"required,max=10"`
-	Name        string             `json:"name" validate:"required,max=50"`
-	Assumptions domain.Assumptions `json:"assumptions" validate:"required,dive"`
+	Code          string             `json:"code" validate:"required,max=10"`
+	Name          string             `json:"name" validate:"required,max=50"`
+	Assumptions   domain.Assumptions `json:"assumptions" validate:"required,dive"`
+	InflationMode string             `json:"inflation_mode" validate:"omitempty,oneof=annual monthly_compound monthly_linear"`
 }
 
 type CreatePlanOutputDTO struct {
 	mapper.PlanOutput
 }
 
-func NewCreatePlanUseCase(repo domain.EstimationRepository) *CreatePlanUseCase {
-	return &CreatePlanUseCase{repo}
+func NewCreatePlanUseCase(txm db.TransactionManagerInterface) *CreatePlanUseCase {
+	return &CreatePlanUseCase{txm}
 }
 
 func (uc *CreatePlanUseCase) Execute(ctx context.Context, input CreatePlanInputDTO) (*CreatePlanOutputDTO, error) {
-	plan := domain.NewPlan(input.Code, input.Name, input.Assumptions)
+	inflationMode := domain.AnnualInflation
+	if input.InflationMode != "" {
+		inflationMode = domain.InflationMode(input.InflationMode)
+	}
+
+	plan := domain.NewPlan(input.Code, input.Name, input.Assumptions, inflationMode)
 	if err := plan.Validate(); err != nil {
 		return nil, err
 	}
 
-	err := uc.repository.CreatePlan(ctx, plan)
-	if err != nil {
-		var pgErr *pgconn.PgError
-		if errors.As(err, &pgErr) {
-			if pgErr.Code == "23505" {
-				return nil, common.NewConflictError(fmt.Errorf("plan with code %s already exists", input.Code))
+	var createdPlan *domain.Plan
+
+	err := uc.txm.Do(ctx, func(ctx context.Context, tx db.TransactionInterface) error {
+		repository, err := db.GetAs[domain.EstimationRepository](tx, "EstimationRepository")
+		if err != nil {
+			return err
+		}
+
+		if err := validateCurrencies(ctx, repository, plan.GetCurrencies()); err != nil {
+			return err
+		}
+
+		err = repository.CreatePlan(ctx, plan)
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) {
+				if pgErr.Code == "23505" {
+					return common.NewConflictError(fmt.Errorf("plan with code %s already exists", input.Code))
+				}
+				return common.NewConflictError(err)
 			}
-			return nil, common.NewConflictError(err)
+			return err
+		}
+
+		createdPlan, err = repository.GetPlan(ctx, plan.PlanID)
+		if err != nil {
+			return err
 		}
-		return nil, err
-	}
 
-	createdPlan, err := uc.repository.GetPlan(ctx, plan.PlanID)
+		return recordAudit(ctx, repository, domain.CreateAction, domain.PlanEntity, plan.PlanID, nil, mapper.PlanOutputFromDomain(*createdPlan))
+	})
+
 	if err != nil {
 		return nil, err
 	}
@@ -87,58 +113,316 @@ func (uc *GetPlanUseCase) Execute(ctx context.Context, input GetPlanInputDTO) (*
 
 }
 
-// UpdatePlanUseCase is a use case to update a plan
-type UpdatePlanUseCase struct {
-	repository domain.EstimationRepository
+// ClonePlanUseCase is a use case to create a plan from the assumptions and rate cards of another plan.
+// It can roll the years over, override assumptions and generate the portfolios of the source plan again in the new plan.
+type ClonePlanUseCase struct {
+	txm db.TransactionManagerInterface
 }
 
-type UpdatePlanInputDTO struct {
-	PlanID      string              `json:"plan_id" validate:"required,uuid4"`
-	Code        *string             `json:"code" validate:"omitempty,max=10"`
-	Name        *string             `json:"name" validate:"omitempty,max=50"`
-	Assumptions *domain.Assumptions `json:"assumptions" validate:"omitempty,required,dive"`
+type ClonePlanInputDTO struct {
+	PlanID               string             `json:"plan_id" validate:"required,uuid4"`
+	Code                 string             `json:"code" validate:"required,max=10"`
+	Name                 string             `json:"name" validate:"required,max=50"`
+	RolloverYears        int                `json:"rollover_years" validate:"gte=0,lte=10"`
+	Assumptions          domain.Assumptions `json:"assumptions" validate:"omitempty,dive"`
+	InflationMode        *string            `json:"inflation_mode" validate:"omitempty,oneof=annual monthly_compound monthly_linear"`
+	RegeneratePortfolios bool               `json:"regenerate_portfolios"`
 }
 
-type UpdatePlanOutputDTO struct {
+type ClonePlanOutputDTO struct {
 	mapper.PlanOutput
+	PortfolioIDs       []string `json:"portfolio_ids"`
+	SkippedBaselineIDs []string `json:"skipped_baseline_ids"`
 }
 
-func NewUpdatePlanUseCase(repository domain.EstimationRepository) *UpdatePlanUseCase {
-	return &UpdatePlanUseCase{repository}
+func NewClonePlanUseCase(txm db.TransactionManagerInterface) *ClonePlanUseCase {
+	return &ClonePlanUseCase{txm}
 }
 
-func (uc *UpdatePlanUseCase) Execute(ctx context.Context, input UpdatePlanInputDTO) (*UpdatePlanOutputDTO, error) {
+func (uc *ClonePlanUseCase) Execute(ctx context.Context, input ClonePlanInputDTO) (*ClonePlanOutputDTO, error) {
+	var output ClonePlanOutputDTO
+
+	err := uc.txm.Do(ctx, func(ctx context.Context, tx db.TransactionInterface) error {
+		repository, err := db.GetAs[domain.EstimationRepository](tx, "EstimationRepository")
+		if err != nil {
+			return err
+		}
+
+		source, err := repository.GetPlan(ctx, input.PlanID)
+		if err != nil {
+			return err
+		}
+
+		plan := source.Clone(input.Code, input.Name)
+		plan.RollOver(input.RolloverYears)
+		plan.OverrideAssumptions(input.Assumptions)
+		if input.InflationMode != nil {
+			plan.ChangeInflationMode(domain.InflationMode(*input.InflationMode))
+		}
+		if err := plan.Validate(); err != nil {
+			return err
+		}
+		if err := validateCurrencies(ctx, repository, plan.GetCurrencies()); err != nil {
+			return err
+		}
+
+		if err := repository.CreatePlan(ctx, plan); err != nil {
+			return err
+		}
+
+		rateCards, err := repository.GetRateCardManyByPlanID(ctx, source.PlanID)
+		if err != nil {
+			return err
+		}
+		for _, rateCard := range domain.CopyRateCards(rateCards, plan) {
+			if err := repository.CreateRateCard(ctx, rateCard); err != nil {
+				return err
+			}
+		}
+
+		output.PortfolioIDs = make([]string, 0)
+		output.SkippedBaselineIDs = make([]string, 0)
+		if input.RegeneratePortfolios {
+			portfolios, err := repository.GetPortfolioManyByPlanID(ctx, source.PlanID)
+			if err != nil {
+				return err
+			}
+			for _, p := range portfolios {
+				baseline, err := repository.GetBaseline(ctx, p.BaselineID)
+				if err != nil {
+					return err
+				}
+				// only approved baselines enter portfolios, the others are reported instead of regenerated
+				if baseline.ValidateApproved() != nil {
+					output.SkippedBaselineIDs = append(output.SkippedBaselineIDs, baseline.BaselineID)
+					continue
+				}
+
+				portfolioID, err := regeneratePortfolio(ctx, repository, p, baseline, plan)
+				if err != nil {
+					return err
+				}
+				output.PortfolioIDs = append(output.PortfolioIDs, portfolioID)
+			}
+		}
+
+		created, err := repository.GetPlan(ctx, plan.PlanID)
+		if err != nil {
+			return err
+		}
+		output.PlanOutput = mapper.PlanOutputFromDomain(*created)
+
+		return recordAudit(ctx, repository, domain.CreateAction, domain.PlanEntity, plan.PlanID, nil, output.PlanOutput)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
+
+// regeneratePortfolio creates a portfolio in the plan for the baseline of another portfolio, with the same start date
+func regeneratePortfolio(ctx context.Context, repository domain.EstimationRepository, source *domain.Portfolio, baseline *domain.Baseline, plan *domain.Plan) (string, error) {
+	portfolioService, err := newPortfolioService(ctx, repository, plan, baseline, source.ShiftMonths(baseline))
+	if err != nil {
+		return "", err
+	}
+
+	portfolio, budgets, workloads, err := portfolioService.GeneratePortfolio()
+	if err != nil {
+		return "", err
+	}
+
+	if err := portfolio.ValidateYears(plan, budgets, workloads); err != nil {
+		return "", err
+	}
+
+	if err := repository.CreatePortfolio(ctx, portfolio); err != nil {
+		return "", err
+	}
+
+	if err := repository.CreateBudgetMany(ctx, budgets); err != nil {
+		return "", err
+	}
+
+	if err := repository.CreateWorkloadMany(ctx, workloads); err != nil {
+		return "", err
+	}
+
+	if err := recordAudit(ctx, repository, domain.CreateAction, domain.PortfolioEntity, portfolio.PortfolioID, nil, mapper.PortfolioRecordOutputFromDomain(*portfolio)); err != nil {
+		return "", err
+	}
+
+	return portfolio.PortfolioID, nil
+}
+
+// GetPlanVarianceUseCase is a use case to compare the portfolios of a plan with the ones of another plan
+type GetPlanVarianceUseCase struct {
+	repository domain.EstimationRepository
+}
+
+type GetPlanVarianceInputDTO struct {
+	PlanID        string `json:"plan_id" validate:"required,uuid4"`
+	AgainstPlanID string `json:"against" validate:"required,uuid4,nefield=PlanID"`
+}
+
+type GetPlanVarianceOutputDTO struct {
+	mapper.PlanVarianceOutput
+}
+
+func NewGetPlanVarianceUseCase(repository domain.EstimationRepository) *GetPlanVarianceUseCase {
+	return &GetPlanVarianceUseCase{repository}
+}
+
+func (uc *GetPlanVarianceUseCase) Execute(ctx context.Context, input GetPlanVarianceInputDTO) (*GetPlanVarianceOutputDTO, error) {
 	plan, err := uc.repository.GetPlan(ctx, input.PlanID)
 	if err != nil {
 		return nil, err
 	}
 
-	if input.Code != nil {
-		plan.ChangeCode(*input.Code)
+	against, err := uc.repository.GetPlan(ctx, input.AgainstPlanID)
+	if err != nil {
+		return nil, err
 	}
-	if input.Name != nil {
-		plan.ChangeName(*input.Name)
+
+	portfolios, err := uc.planPortfolios(ctx, plan.PlanID)
+	if err != nil {
+		return nil, err
 	}
-	if input.Assumptions != nil {
-		plan.ChangeAssumptions(*input.Assumptions)
+
+	againstPortfolios, err := uc.planPortfolios(ctx, against.PlanID)
+	if err != nil {
+		return nil, err
 	}
-	if err := plan.Validate(); err != nil {
+
+	rateCards, err := uc.repository.GetRateCardManyByPlanID(ctx, against.PlanID)
+	if err != nil {
 		return nil, err
 	}
 
-	count, err := uc.repository.CountPortfoliosByPlanId(ctx, plan.PlanID)
+	taxProfiles, err := uc.repository.GetTaxProfileMany(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if count > 0 {
-		return nil, common.NewConflictError(fmt.Errorf("plan %s has %d portfolio(s)", plan.Code, count))
+
+	service := domain.NewPlanVarianceService(against, plan, domain.NewRateTable(rateCards), domain.NewTaxTable(taxProfiles))
+	variance, err := service.ComparePlans(againstPortfolios, portfolios)
+	if err != nil {
+		return nil, err
 	}
 
-	if err := uc.repository.UpdatePlan(ctx, plan); err != nil {
+	output := mapper.PlanVarianceOutputFromDomain(*plan, *against, *variance)
+
+	return &GetPlanVarianceOutputDTO{output}, nil
+}
+
+func (uc *GetPlanVarianceUseCase) planPortfolios(ctx context.Context, planID string) ([]*domain.PlanPortfolio, error) {
+	portfolios, err := uc.repository.GetPortfolioManyByPlanID(ctx, planID)
+	if err != nil {
 		return nil, err
 	}
 
-	updated, err := uc.repository.GetPlan(ctx, plan.PlanID)
+	planPortfolios := make([]*domain.PlanPortfolio, len(portfolios))
+	for i, portfolio := range portfolios {
+		p := &domain.PlanPortfolio{Portfolio: portfolio}
+
+		if p.Baseline, err = uc.repository.GetBaseline(ctx, portfolio.BaselineID); err != nil {
+			return nil, err
+		}
+		if p.Costs, err = uc.repository.GetCostManyByBaselineID(ctx, portfolio.BaselineID); err != nil {
+			return nil, err
+		}
+		if p.Efforts, err = uc.repository.GetEffortManyByBaselineID(ctx, portfolio.BaselineID); err != nil {
+			return nil, err
+		}
+		if p.Budgets, err = uc.repository.GetBudgetManyByPortfolioID(ctx, portfolio.PortfolioID); err != nil {
+			return nil, err
+		}
+		if p.Workloads, err = uc.repository.GetWorkloadManyByPortfolioID(ctx, portfolio.PortfolioID); err != nil {
+			return nil, err
+		}
+
+		planPortfolios[i] = p
+	}
+
+	return planPortfolios, nil
+}
+
+// UpdatePlanUseCase is a use case to update a plan.
+// The assumptions of a plan with portfolios can change as long as they still cover the years the portfolios are allocated;
+// recalculating a portfolio applies them.
+type UpdatePlanUseCase struct {
+	txm db.TransactionManagerInterface
+}
+
+type UpdatePlanInputDTO struct {
+	PlanID        string              `json:"plan_id" validate:"required,uuid4"`
+	Code          *string             `json:"code" validate:"omitempty,max=10"`
+	Name          *string             `json:"name" validate:"omitempty,max=50"`
+	Assumptions   *domain.Assumptions `json:"assumptions" validate:"omitempty,required,dive"`
+	InflationMode *string             `json:"inflation_mode" validate:"omitempty,oneof=annual monthly_compound monthly_linear"`
+}
+
+type UpdatePlanOutputDTO struct {
+	mapper.PlanOutput
+}
+
+func NewUpdatePlanUseCase(txm db.TransactionManagerInterface) *UpdatePlanUseCase {
+	return &UpdatePlanUseCase{txm}
+}
+
+func (uc *UpdatePlanUseCase) Execute(ctx context.Context, input UpdatePlanInputDTO) (*UpdatePlanOutputDTO, error) {
+	var updated *domain.Plan
+
+	err := uc.txm.Do(ctx, func(ctx context.Context, tx db.TransactionInterface) error {
+		repository, err := db.GetAs[domain.EstimationRepository](tx, "EstimationRepository")
+		if err != nil {
+			return err
+		}
+
+		plan, err := repository.GetPlan(ctx, input.PlanID)
+		if err != nil {
+			return err
+		}
+		before := mapper.PlanOutputFromDomain(*plan)
+
+		if input.Code != nil {
+			plan.ChangeCode(*input.Code)
+		}
+		if input.Name != nil {
+			plan.ChangeName(*input.Name)
+		}
+		if input.Assumptions != nil {
+			plan.ChangeAssumptions(*input.Assumptions)
+		}
+		if input.InflationMode != nil {
+			plan.ChangeInflationMode(domain.InflationMode(*input.InflationMode))
+		}
+		if err := plan.Validate(); err != nil {
+			return err
+		}
+		if input.Assumptions != nil {
+			if err := validateCurrencies(ctx, repository, plan.GetCurrencies()); err != nil {
+				return err
+			}
+			if err := validatePortfolioYears(ctx, repository, plan); err != nil {
+				return err
+			}
+		}
+
+		if err := repository.UpdatePlan(ctx, plan); err != nil {
+			return err
+		}
+
+		updated, err = repository.GetPlan(ctx, plan.PlanID)
+		if err != nil {
+			return err
+		}
+
+		return recordAudit(ctx, repository, domain.UpdateAction, domain.PlanEntity, plan.PlanID, before, mapper.PlanOutputFromDomain(*updated))
+	})
+
 	if err != nil {
 		return nil, err
 	}
@@ -148,9 +432,33 @@ func (uc *UpdatePlanUseCase) Execute(ctx context.Context, input UpdatePlanInputD
 	return &UpdatePlanOutputDTO{output}, nil
 }
 
+// validatePortfolioYears checks that the plan still has assumptions for every year its portfolios are allocated
+func validatePortfolioYears(ctx context.Context, repository domain.EstimationRepository, plan *domain.Plan) error {
+	portfolios, err := repository.GetPortfolioManyByPlanID(ctx, plan.PlanID)
+	if err != nil {
+		return err
+	}
+
+	for _, portfolio := range portfolios {
+		budgets, err := repository.GetBudgetManyByPortfolioID(ctx, portfolio.PortfolioID)
+		if err != nil {
+			return err
+		}
+		workloads, err := repository.GetWorkloadManyByPortfolioID(ctx, portfolio.PortfolioID)
+		if err != nil {
+			return err
+		}
+		if err := portfolio.ValidateYears(plan, budgets, workloads); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // DeletePlanUseCase is a use case to delete a plan
 type DeletePlanUseCase struct {
-	repository domain.EstimationRepository
+	txm db.TransactionManagerInterface
 }
 
 type DeletePlanInputDTO struct {
@@ -159,17 +467,40 @@ type DeletePlanInputDTO struct {
 
 type DeletePlanOutputDTO struct{}
 
-func NewDeletePlanUseCase(repository domain.EstimationRepository) *DeletePlanUseCase {
-	return &DeletePlanUseCase{repository}
+func NewDeletePlanUseCase(txm db.TransactionManagerInterface) *DeletePlanUseCase {
+	return &DeletePlanUseCase{txm}
 }
 
 func (uc *DeletePlanUseCase) Execute(ctx context.Context, planID string) (*DeletePlanOutputDTO, error) {
-	count, err := uc.repository.CountPortfoliosByPlanId(ctx, planID)
+	err := uc.txm.Do(ctx, func(ctx context.Context, tx db.TransactionInterface) error {
+		repository, err := db.GetAs[domain.EstimationRepository](tx, "EstimationRepository")
+		if err != nil {
+			return err
+		}
+
+		plan, err := repository.GetPlan(ctx, planID)
+		if err != nil {
+			return err
+		}
+
+		count, err := repository.CountPortfoliosByPlanId(ctx, planID)
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			return common.NewConflictError(fmt.Errorf("plan %s has %d portfolio(s)", plan.Code, count))
+		}
+
+		if err := repository.DeletePlan(ctx, planID); err != nil {
+			return err
+		}
+
+		return recordAudit(ctx, repository, domain.DeleteAction, domain.PlanEntity, plan.PlanID, mapper.PlanOutputFromDomain(*plan), nil)
+	})
+
 	if err != nil {
 		return nil, err
 	}
-	if count > 0 {
-		return nil, common.NewConflictError(fmt.Errorf("plan %s has %d portfolio(s)", planID, count))
-	}
-	return &DeletePlanOutputDTO{}, uc.repository.DeletePlan(ctx, planID)
+
+	return &DeletePlanOutputDTO{}, nil
 }
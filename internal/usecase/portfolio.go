@@ -4,10 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/celsopires1999/estimation/internal/common"
 	"github.com/celsopires1999/estimation/internal/domain"
 	"github.com/celsopires1999/estimation/internal/infra/db"
+	"github.com/celsopires1999/estimation/internal/mapper"
 )
 
 // CreatePortfolioUseCase is responsible for creating a new portfolio in the system
@@ -49,6 +51,10 @@ func (uc *CreatePortfolioUseCase) Execute(ctx context.Context, input CreatePortf
 			return err
 		}
 
+		if err := baseline.ValidateApproved(); err != nil {
+			return err
+		}
+
 		plan, err := repository.GetPlan(ctx, input.PlanID)
 		if err != nil {
 			var notFoundErr *common.NotFoundError
@@ -63,20 +69,11 @@ func (uc *CreatePortfolioUseCase) Execute(ctx context.Context, input CreatePortf
 			return err
 		}
 
-		costs, err := repository.GetCostManyByBaselineID(ctx, input.BaselineID)
+		portfolioService, err := newPortfolioService(ctx, repository, plan, baseline, input.ShiftMonths)
 		if err != nil {
 			return err
 		}
 
-		efforts, err := repository.GetEffortManyByBaselineID(ctx, input.BaselineID)
-		if err != nil {
-			return err
-		}
-
-		inflation := plan.GetInflation()
-		exchange := plan.GetExchange()
-
-		portfolioService := domain.NewPortfolioService(input.PlanID, baseline, costs, efforts, inflation, exchange, input.ShiftMonths)
 		portfolio, budgets, workloads, err := portfolioService.GeneratePortfolio()
 		if err != nil {
 			return err
@@ -99,6 +96,177 @@ func (uc *CreatePortfolioUseCase) Execute(ctx context.Context, input CreatePortf
 
 		output.PortfolioID = portfolio.PortfolioID
 
+		return recordAudit(ctx, repository, domain.CreateAction, domain.PortfolioEntity, portfolio.PortfolioID, nil, mapper.PortfolioRecordOutputFromDomain(*portfolio))
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
+
+// RecalculatePortfolioUseCase is responsible for generating the budgets and workloads of an existing portfolio again
+// with the current plan assumptions, costs and efforts, keeping the portfolio ID and shift
+type RecalculatePortfolioUseCase struct {
+	txm db.TransactionManagerInterface
+}
+
+type RecalculatePortfolioInputDTO struct {
+	PortfolioID string `json:"portfolio_id" validate:"required,uuid4"`
+}
+
+type RecalculatePortfolioOutputDTO struct {
+	mapper.PortfolioDeltaOutput
+}
+
+func NewRecalculatePortfolioUseCase(
+	txm db.TransactionManagerInterface,
+) *RecalculatePortfolioUseCase {
+	return &RecalculatePortfolioUseCase{txm}
+}
+
+func (uc *RecalculatePortfolioUseCase) Execute(ctx context.Context, input RecalculatePortfolioInputDTO) (*RecalculatePortfolioOutputDTO, error) {
+	var output mapper.PortfolioDeltaOutput
+
+	err := uc.txm.Do(ctx, func(ctx context.Context, tx db.TransactionInterface) error {
+		repository, err := db.GetAs[domain.EstimationRepository](tx, "EstimationRepository")
+		if err != nil {
+			return err
+		}
+
+		portfolio, err := repository.GetPortfolio(ctx, input.PortfolioID)
+		if err != nil {
+			return err
+		}
+
+		baseline, err := repository.GetBaseline(ctx, portfolio.BaselineID)
+		if err != nil {
+			return err
+		}
+
+		plan, err := repository.GetPlan(ctx, portfolio.PlanID)
+		if err != nil {
+			return err
+		}
+
+		output, err = recalculatePortfolio(ctx, repository, portfolio, plan, baseline, portfolio.ShiftMonths(baseline))
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &RecalculatePortfolioOutputDTO{output}, nil
+}
+
+// newPortfolioService loads the costs, efforts, rate cards and tax profiles the portfolio generation depends on
+func newPortfolioService(ctx context.Context, repository domain.EstimationRepository, plan *domain.Plan, baseline *domain.Baseline, shiftMonths int) (*domain.PortfolioService, error) {
+	costs, err := repository.GetCostManyByBaselineID(ctx, baseline.BaselineID)
+	if err != nil {
+		return nil, err
+	}
+
+	efforts, err := repository.GetEffortManyByBaselineID(ctx, baseline.BaselineID)
+	if err != nil {
+		return nil, err
+	}
+
+	rateCards, err := repository.GetRateCardManyByPlanID(ctx, plan.PlanID)
+	if err != nil {
+		return nil, err
+	}
+
+	taxProfiles, err := repository.GetTaxProfileMany(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.NewPortfolioService(
+		plan.PlanID,
+		baseline,
+		costs,
+		efforts,
+		plan.GetInflation(),
+		plan.GetExchange(),
+		domain.NewRateTable(rateCards),
+		domain.NewTaxTable(taxProfiles),
+		shiftMonths,
+	), nil
+}
+
+// UpdatePortfolioUseCase is responsible for moving a portfolio to another start month,
+// which generates its budgets and workloads again with the inflation and exchange rates of the new months
+type UpdatePortfolioUseCase struct {
+	txm db.TransactionManagerInterface
+}
+
+type UpdatePortfolioInputDTO struct {
+	PortfolioID string `json:"portfolio_id" validate:"required,uuid4"`
+	ShiftMonths *int   `json:"shift_months" validate:"omitempty,gte=0,lte=36,excluded_with=StartYear StartMonth"`
+	StartYear   *int   `json:"start_year" validate:"required_with=StartMonth,required_without=ShiftMonths"`
+	StartMonth  *int   `json:"start_month" validate:"required_with=StartYear,omitempty,gte=1,lte=12"`
+}
+
+type UpdatePortfolioOutputDTO struct {
+	mapper.PortfolioDeltaOutput
+	StartDate   string `json:"start_date"`
+	ShiftMonths int    `json:"shift_months"`
+}
+
+func NewUpdatePortfolioUseCase(
+	txm db.TransactionManagerInterface,
+) *UpdatePortfolioUseCase {
+	return &UpdatePortfolioUseCase{txm}
+}
+
+func (uc *UpdatePortfolioUseCase) Execute(ctx context.Context, input UpdatePortfolioInputDTO) (*UpdatePortfolioOutputDTO, error) {
+	var output UpdatePortfolioOutputDTO
+
+	err := uc.txm.Do(ctx, func(ctx context.Context, tx db.TransactionInterface) error {
+		repository, err := db.GetAs[domain.EstimationRepository](tx, "EstimationRepository")
+		if err != nil {
+			return err
+		}
+
+		portfolio, err := repository.GetPortfolio(ctx, input.PortfolioID)
+		if err != nil {
+			return err
+		}
+
+		baseline, err := repository.GetBaseline(ctx, portfolio.BaselineID)
+		if err != nil {
+			return err
+		}
+
+		plan, err := repository.GetPlan(ctx, portfolio.PlanID)
+		if err != nil {
+			return err
+		}
+
+		shiftMonths := portfolio.ShiftMonths(baseline)
+		if input.ShiftMonths != nil {
+			shiftMonths = *input.ShiftMonths
+		}
+		if input.StartYear != nil && input.StartMonth != nil {
+			shiftMonths = domain.ShiftMonthsTo(baseline, *input.StartYear, time.Month(*input.StartMonth))
+		}
+		if err := domain.ValidateShiftMonths(shiftMonths); err != nil {
+			return err
+		}
+
+		output.PortfolioDeltaOutput, err = recalculatePortfolio(ctx, repository, portfolio, plan, baseline, shiftMonths)
+		if err != nil {
+			return err
+		}
+		output.StartDate = portfolio.StartDate.Format(time.DateOnly)
+		output.ShiftMonths = shiftMonths
+
 		return nil
 	})
 
@@ -109,6 +277,90 @@ func (uc *CreatePortfolioUseCase) Execute(ctx context.Context, input CreatePortf
 	return &output, nil
 }
 
+// recalculatePortfolio generates the budgets and workloads of the portfolio again with the shift,
+// replaces them and returns the difference to the ones it had
+func recalculatePortfolio(ctx context.Context, repository domain.EstimationRepository, portfolio *domain.Portfolio, plan *domain.Plan, baseline *domain.Baseline, shiftMonths int) (mapper.PortfolioDeltaOutput, error) {
+	before := mapper.PortfolioRecordOutputFromDomain(*portfolio)
+
+	budgets, err := repository.GetBudgetManyByPortfolioID(ctx, portfolio.PortfolioID)
+	if err != nil {
+		return mapper.PortfolioDeltaOutput{}, err
+	}
+
+	workloads, err := repository.GetWorkloadManyByPortfolioID(ctx, portfolio.PortfolioID)
+	if err != nil {
+		return mapper.PortfolioDeltaOutput{}, err
+	}
+
+	portfolioService, err := newPortfolioService(ctx, repository, plan, baseline, shiftMonths)
+	if err != nil {
+		return mapper.PortfolioDeltaOutput{}, err
+	}
+
+	recalculation, err := portfolioService.RecalculatePortfolio(portfolio, budgets, workloads)
+	if err != nil {
+		return mapper.PortfolioDeltaOutput{}, err
+	}
+
+	newBudgets := append(recalculation.UpdatedBudgets, recalculation.CreatedBudgets...)
+	newWorkloads := append(recalculation.UpdatedWorkloads, recalculation.CreatedWorkloads...)
+
+	if err := portfolio.ValidateYears(plan, newBudgets, newWorkloads); err != nil {
+		return mapper.PortfolioDeltaOutput{}, err
+	}
+
+	if err := saveRecalculation(ctx, repository, recalculation); err != nil {
+		return mapper.PortfolioDeltaOutput{}, err
+	}
+
+	after := mapper.PortfolioRecordOutputFromDomain(*recalculation.Portfolio)
+	if err := recordAudit(ctx, repository, domain.UpdateAction, domain.PortfolioEntity, portfolio.PortfolioID, before, after); err != nil {
+		return mapper.PortfolioDeltaOutput{}, err
+	}
+
+	return mapper.PortfolioDeltaOutputFrom(portfolio.PortfolioID, budgets, newBudgets, workloads, newWorkloads), nil
+}
+
+func saveRecalculation(ctx context.Context, repository domain.EstimationRepository, recalculation *domain.PortfolioRecalculation) error {
+	if err := repository.UpdatePortfolio(ctx, recalculation.Portfolio); err != nil {
+		return err
+	}
+
+	for _, budget := range recalculation.DeletedBudgets {
+		if err := repository.DeleteBudget(ctx, budget.BudgetID); err != nil {
+			return err
+		}
+	}
+	for _, budget := range recalculation.UpdatedBudgets {
+		if err := repository.UpdateBudget(ctx, budget); err != nil {
+			return err
+		}
+	}
+	if len(recalculation.CreatedBudgets) > 0 {
+		if err := repository.CreateBudgetMany(ctx, recalculation.CreatedBudgets); err != nil {
+			return err
+		}
+	}
+
+	for _, workload := range recalculation.DeletedWorkloads {
+		if err := repository.DeleteWorkload(ctx, workload.WorkloadID); err != nil {
+			return err
+		}
+	}
+	for _, workload := range recalculation.UpdatedWorkloads {
+		if err := repository.UpdateWorkload(ctx, workload); err != nil {
+			return err
+		}
+	}
+	if len(recalculation.CreatedWorkloads) > 0 {
+		if err := repository.CreateWorkloadMany(ctx, recalculation.CreatedWorkloads); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // DeletePortfolioUseCase is responsible for deleting a portfolio in the system
 type DeletePortfolioUseCase struct {
 	txm db.TransactionManagerInterface
@@ -133,7 +385,7 @@ func (uc *DeletePortfolioUseCase) Execute(ctx context.Context, input DeletePortf
 			return err
 		}
 
-		_, err = repository.GetPortfolio(ctx, input.PortfolioID)
+		portfolio, err := repository.GetPortfolio(ctx, input.PortfolioID)
 		if err != nil {
 			return err
 		}
@@ -153,7 +405,7 @@ func (uc *DeletePortfolioUseCase) Execute(ctx context.Context, input DeletePortf
 			return err
 		}
 
-		return nil
+		return recordAudit(ctx, repository, domain.DeleteAction, domain.PortfolioEntity, portfolio.PortfolioID, mapper.PortfolioRecordOutputFromDomain(*portfolio), nil)
 	})
 
 	if err != nil {
@@ -3,6 +3,7 @@ package usecase_test
 import (
 	"context"
 	"fmt"
+	"slices"
 	"testing"
 	"time"
 
@@ -93,16 +94,16 @@ func (s *CreatePortfolioUseCaseTestSuite) TestIntegrationCreatePortfolio() {
 		for _, budget := range budgets {
 			for _, allocation := range budget.BudgetAllocations {
 				if allocation.AllocationDate.Month() == 9 && allocation.AllocationDate.Year() == 2022 {
-					s.Equal(610.15, allocation.Amount)
+					s.Equal("610.15", allocation.Amount.String())
 				}
 				if allocation.AllocationDate.Month() == 4 && allocation.AllocationDate.Year() == 2023 {
-					s.Equal(279.61, allocation.Amount)
+					s.Equal("279.61", allocation.Amount.String())
 				}
 				if allocation.AllocationDate.Month() == 10 && allocation.AllocationDate.Year() == 2023 {
-					s.Equal(821.68, allocation.Amount)
+					s.Equal("821.68", allocation.Amount.String())
 				}
 				if allocation.AllocationDate.Month() == 8 && allocation.AllocationDate.Year() == 2024 {
-					s.Equal(676.84, allocation.Amount)
+					s.Equal("676.84", allocation.Amount.String())
 				}
 			}
 		}
@@ -134,8 +135,8 @@ func (s *CreatePortfolioUseCaseTestSuite) TestIntegrationCreatePortfolio() {
 		budgets, err := s.repository.GetBudgetManyByPortfolioID(ctx, output.PortfolioID)
 		s.Nil(err)
 		s.Equal(1, len(budgets))
-		s.Equal(970.53, budgets[0].Amount)
-		s.Equal(970.53, budgets[0].BudgetAllocations[0].Amount)
+		s.Equal("970.53", budgets[0].Amount.String())
+		s.Equal("970.53", budgets[0].BudgetAllocations[0].Amount.String())
 		s.Equal(costs[0].CostAllocations[0].AllocationDate, budgets[0].BudgetAllocations[0].AllocationDate)
 	})
 	s.Run("should create portfolio with running cost", func() {
@@ -154,8 +155,8 @@ func (s *CreatePortfolioUseCaseTestSuite) TestIntegrationCreatePortfolio() {
 		s.Nil(err)
 		budgets, err := s.repository.GetBudgetManyByPortfolioID(ctx, output.PortfolioID)
 		s.Nil(err)
-		s.Equal(107_640.00, budgets[0].Amount)
-		s.Equal(107_640.00, budgets[0].BudgetAllocations[0].Amount)
+		s.Equal("107640.00", budgets[0].Amount.String())
+		s.Equal("107640.00", budgets[0].BudgetAllocations[0].Amount.String())
 		s.Equal(costs[0].CostAllocations[0].AllocationDate, budgets[0].BudgetAllocations[0].AllocationDate)
 	})
 
@@ -190,6 +191,7 @@ func (s *CreatePortfolioUseCaseTestSuite) TestIntegrationCreatePortfolio() {
 			baseline.ManagerID,
 			baseline.EstimatorID,
 		)
+		newBaseline.Status = domain.ApprovedBaseline
 
 		err = s.repository.CreateBaseline(ctx, newBaseline)
 		if err != nil {
@@ -213,6 +215,215 @@ func (s *CreatePortfolioUseCaseTestSuite) TestIntegrationCreatePortfolio() {
 
 }
 
+func (s *CreatePortfolioUseCaseTestSuite) TestIntegrationClonePlanPortfolios() {
+	s.Run("should skip the portfolios of baselines that are not approved", func() {
+		ctx := context.Background()
+		approved := s.createDependenciesBaseline(ctx)
+		s.createDependencies8Months(ctx, approved)
+		superseded := s.createDependenciesBaseline(ctx)
+		s.createDependencies8Months(ctx, superseded)
+		plan := s.createDependenciesPlan(ctx)
+
+		createPortfolio := usecase.NewCreatePortfolioUseCase(s.txm)
+		approvedPortfolio, err := createPortfolio.Execute(ctx, usecase.CreatePortfolioInputDTO{BaselineID: approved.BaselineID, PlanID: plan.PlanID})
+		if err != nil {
+			s.T().Fatal(err)
+		}
+		_, err = createPortfolio.Execute(ctx, usecase.CreatePortfolioInputDTO{BaselineID: superseded.BaselineID, PlanID: plan.PlanID})
+		if err != nil {
+			s.T().Fatal(err)
+		}
+
+		superseded.Status = domain.SupersededBaseline
+		if err := s.repository.UpdateBaselineStatus(ctx, superseded); err != nil {
+			s.T().Fatal(err)
+		}
+
+		uc := usecase.NewClonePlanUseCase(s.txm)
+		output, err := uc.Execute(ctx, usecase.ClonePlanInputDTO{
+			PlanID:               plan.PlanID,
+			Code:                 "CLONE",
+			Name:                 "Clone of the plan",
+			RegeneratePortfolios: true,
+		})
+
+		s.Nil(err)
+		s.Len(output.PortfolioIDs, 1)
+		s.NotEqual(approvedPortfolio.PortfolioID, output.PortfolioIDs[0])
+		s.Equal([]string{superseded.BaselineID}, output.SkippedBaselineIDs)
+
+		portfolios, err := s.repository.GetPortfolioManyByPlanID(ctx, output.PlanID)
+		s.Nil(err)
+		s.Len(portfolios, 1)
+		s.Equal(approved.BaselineID, portfolios[0].BaselineID)
+	})
+}
+
+func (s *CreatePortfolioUseCaseTestSuite) TestIntegrationUpdatePlanPortfolios() {
+	s.Run("should recalculate a portfolio with the new inflation of its plan", func() {
+		ctx := context.Background()
+		baseline := s.createDependenciesBaseline(ctx)
+		s.createDependencies8Months(ctx, baseline)
+		plan := s.createDependenciesPlan(ctx)
+
+		portfolio, err := usecase.NewCreatePortfolioUseCase(s.txm).Execute(ctx, usecase.CreatePortfolioInputDTO{
+			BaselineID:  baseline.BaselineID,
+			PlanID:      plan.PlanID,
+			ShiftMonths: 8,
+		})
+		if err != nil {
+			s.T().Fatal(err)
+		}
+
+		assumptions := slices.Clone(plan.Assumptions)
+		for i := range assumptions {
+			if assumptions[i].Year == 2023 {
+				assumptions[i].Inflation = 5.00
+			}
+		}
+		_, err = usecase.NewUpdatePlanUseCase(s.txm).Execute(ctx, usecase.UpdatePlanInputDTO{
+			PlanID:      plan.PlanID,
+			Assumptions: &assumptions,
+		})
+		s.Nil(err)
+
+		output, err := usecase.NewRecalculatePortfolioUseCase(s.txm).Execute(ctx, usecase.RecalculatePortfolioInputDTO{
+			PortfolioID: portfolio.PortfolioID,
+		})
+
+		s.Nil(err)
+		s.Equal(portfolio.PortfolioID, output.PortfolioID)
+		s.Equal("2388.28", output.Before.BudgetAmount.String())
+		s.Equal("2392.33", output.After.BudgetAmount.String())
+		s.Equal("4.05", output.Delta.BudgetAmount.String())
+	})
+
+	s.Run("should not drop a year the portfolios of the plan are allocated", func() {
+		ctx := context.Background()
+		baseline := s.createDependenciesBaseline(ctx)
+		s.createDependencies8Months(ctx, baseline)
+		plan := s.createDependenciesPlan(ctx)
+
+		_, err := usecase.NewCreatePortfolioUseCase(s.txm).Execute(ctx, usecase.CreatePortfolioInputDTO{
+			BaselineID:  baseline.BaselineID,
+			PlanID:      plan.PlanID,
+			ShiftMonths: 8,
+		})
+		if err != nil {
+			s.T().Fatal(err)
+		}
+
+		assumptions := slices.DeleteFunc(slices.Clone(plan.Assumptions), func(a domain.Assumption) bool {
+			return a.Year == 2024
+		})
+		_, err = usecase.NewUpdatePlanUseCase(s.txm).Execute(ctx, usecase.UpdatePlanInputDTO{
+			PlanID:      plan.PlanID,
+			Assumptions: &assumptions,
+		})
+
+		var errDomainValidation *common.DomainValidationError
+		s.ErrorAs(err, &errDomainValidation)
+		s.ErrorContains(err, domain.ErrPortfolioYearNotInPlan.Error())
+
+		found, err := s.repository.GetPlan(ctx, plan.PlanID)
+		s.Nil(err)
+		s.True(found.HasYear(2024))
+	})
+}
+
+func (s *CreatePortfolioUseCaseTestSuite) TestIntegrationTransitionBaseline() {
+	createUsers := func(ctx context.Context) (*domain.User, *domain.User) {
+		manager := testutils.NewUserFakeBuilder().WithManager().Build()
+		estimator := testutils.NewUserFakeBuilder().WithEstimator().Build()
+		for _, user := range []*domain.User{manager, estimator} {
+			if err := s.repository.CreateUser(ctx, user); err != nil {
+				s.T().Fatal(err)
+			}
+		}
+		return manager, estimator
+	}
+
+	createBaseline := func(ctx context.Context, manager, estimator *domain.User, review int32, status domain.BaselineStatus) *domain.Baseline {
+		baseline := testutils.NewBaselineFakeBuilder().
+			WithCode("TRANSITION").
+			WithReview(review).
+			WithManagerID(manager.UserID).
+			WithEstimatorID(estimator.UserID).
+			WithStatus(status).
+			Build()
+		if err := s.repository.CreateBaseline(ctx, baseline); err != nil {
+			s.T().Fatal(err)
+		}
+		return baseline
+	}
+
+	s.Run("should not let the estimator of another baseline submit it", func() {
+		ctx := context.Background()
+		manager, estimator := createUsers(ctx)
+		_, foreign := createUsers(ctx)
+		baseline := createBaseline(ctx, manager, estimator, 1, domain.DraftBaseline)
+
+		uc := usecase.NewTransitionBaselineUseCase(s.txm)
+		_, err := uc.Execute(common.WithActor(ctx, foreign.UserID), usecase.TransitionBaselineInputDTO{
+			BaselineID: baseline.BaselineID,
+			Status:     string(domain.SubmittedBaseline),
+			Comment:    "ready for review",
+		})
+
+		var errDomainValidation *common.DomainValidationError
+		s.ErrorAs(err, &errDomainValidation)
+		s.ErrorContains(err, domain.ErrBaselineTransitionUser.Error())
+
+		found, err := s.repository.GetBaseline(ctx, baseline.BaselineID)
+		s.Nil(err)
+		s.Equal(domain.DraftBaseline, found.Status)
+	})
+
+	s.Run("should not let the manager of another baseline approve it", func() {
+		ctx := context.Background()
+		manager, estimator := createUsers(ctx)
+		foreign, _ := createUsers(ctx)
+		baseline := createBaseline(ctx, manager, estimator, 1, domain.SubmittedBaseline)
+
+		uc := usecase.NewTransitionBaselineUseCase(s.txm)
+		_, err := uc.Execute(common.WithActor(ctx, foreign.UserID), usecase.TransitionBaselineInputDTO{
+			BaselineID: baseline.BaselineID,
+			Status:     string(domain.ApprovedBaseline),
+			Comment:    "approved for the plan",
+		})
+
+		var errDomainValidation *common.DomainValidationError
+		s.ErrorAs(err, &errDomainValidation)
+		s.ErrorContains(err, domain.ErrBaselineTransitionUser.Error())
+
+		found, err := s.repository.GetBaseline(ctx, baseline.BaselineID)
+		s.Nil(err)
+		s.Equal(domain.SubmittedBaseline, found.Status)
+	})
+
+	s.Run("should supersede the approved review of another manager", func() {
+		ctx := context.Background()
+		previousManager, estimator := createUsers(ctx)
+		manager, _ := createUsers(ctx)
+		previous := createBaseline(ctx, previousManager, estimator, 1, domain.ApprovedBaseline)
+		baseline := createBaseline(ctx, manager, estimator, 2, domain.SubmittedBaseline)
+
+		uc := usecase.NewTransitionBaselineUseCase(s.txm)
+		output, err := uc.Execute(common.WithActor(ctx, manager.UserID), usecase.TransitionBaselineInputDTO{
+			BaselineID: baseline.BaselineID,
+			Status:     string(domain.ApprovedBaseline),
+			Comment:    "approved for the plan",
+		})
+
+		s.Nil(err)
+		s.Equal(string(domain.ApprovedBaseline), output.Status)
+
+		found, err := s.repository.GetBaseline(ctx, previous.BaselineID)
+		s.Nil(err)
+		s.Equal(domain.SupersededBaseline, found.Status)
+	})
+}
+
 func (s *CreatePortfolioUseCaseTestSuite) createDependenciesBaseline(ctx context.Context) *domain.Baseline {
 	user := testutils.NewUserFakeBuilder().WithManager().Build()
 	err := s.repository.CreateUser(ctx, user)
@@ -223,6 +434,7 @@ func (s *CreatePortfolioUseCaseTestSuite) createDependenciesBaseline(ctx context
 		WithManagerID(user.UserID).
 		WithEstimatorID(user.UserID).
 		WithStartDate(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)).
+		WithStatus(domain.ApprovedBaseline).
 		Build()
 
 	err = s.repository.CreateBaseline(ctx, baseline)
@@ -238,24 +450,24 @@ func (s *CreatePortfolioUseCaseTestSuite) createDependencies8Months(ctx context.
 
 	costs = append(costs, testutils.NewCostFakeBuilder().
 		WithBaselineID(baseline.BaselineID).
-		WithAmount(880.30).
+		WithAmount(common.NewMoney(880.30)).
 		WithCurrency("BRL").
 		WithApplyInflation(true).
 		WithCostAllocationProps([]domain.CostAllocationProps{
-			{Year: 2022, Month: time.January, Amount: 610.15},
-			{Year: 2022, Month: time.August, Amount: 270.15},
+			{Year: 2022, Month: time.January, Amount: common.NewMoney(610.15)},
+			{Year: 2022, Month: time.August, Amount: common.NewMoney(270.15)},
 		}).
 		WithTax(0.00).
 		Build())
 
 	costs = append(costs, testutils.NewCostFakeBuilder().
 		WithBaselineID(baseline.BaselineID).
-		WithAmount(220.20).
+		WithAmount(common.NewMoney(220.20)).
 		WithCurrency("EUR").
 		WithApplyInflation(false).
 		WithCostAllocationProps([]domain.CostAllocationProps{
-			{Year: 2023, Month: time.February, Amount: 120.15},
-			{Year: 2023, Month: time.December, Amount: 100.05},
+			{Year: 2023, Month: time.February, Amount: common.NewMoney(120.15)},
+			{Year: 2023, Month: time.December, Amount: common.NewMoney(100.05)},
 		}).
 		WithTax(23.00).
 		Build())
@@ -273,12 +485,12 @@ func (s *CreatePortfolioUseCaseTestSuite) createDependenciesBRL(ctx context.Cont
 
 	costs = append(costs, testutils.NewCostFakeBuilder().
 		WithBaselineID(baseline.BaselineID).
-		WithAmount(880.30).
+		WithAmount(common.NewMoney(880.30)).
 		WithCurrency("BRL").
 		WithTax(10.25).
 		WithApplyInflation(true).
 		WithCostAllocationProps([]domain.CostAllocationProps{
-			{Year: 2022, Month: time.August, Amount: 880.30},
+			{Year: 2022, Month: time.August, Amount: common.NewMoney(880.30)},
 		}).
 		Build())
 
@@ -306,12 +518,12 @@ func (s *CreatePortfolioUseCaseTestSuite) createDependenciesRC(ctx context.Conte
 	costs = append(costs, testutils.NewCostFakeBuilder().
 		WithBaselineID(baseline.BaselineID).
 		WithCostType(domain.RunningCost).
-		WithAmount(100_000.00).
+		WithAmount(common.NewMoney(100_000.00)).
 		WithCurrency("BRL").
 		WithTax(0.00).
 		WithApplyInflation(true).
 		WithCostAllocationProps([]domain.CostAllocationProps{
-			{Year: 2024, Month: time.August, Amount: 100_000.00},
+			{Year: 2024, Month: time.August, Amount: common.NewMoney(100_000.00)},
 		}).
 		Build())
 
@@ -0,0 +1,167 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/celsopires1999/estimation/internal/domain"
+	"github.com/celsopires1999/estimation/internal/mapper"
+)
+
+var ErrRateCardPlanMismatch = errors.New("rate card plan mismatch")
+
+type CreateRateCardUseCase struct {
+	repository domain.EstimationRepository
+}
+
+type CreateRateCardInputDTO struct {
+	PlanID       string       `json:"plan_id" validate:"required,uuid4"`
+	CompetenceID string       `json:"competence_id" validate:"required,uuid4"`
+	Year         int          `json:"year" validate:"required"`
+	HourlyRate   common.Money `json:"hourly_rate" validate:"required,gt=0,twodecimals"`
+	Currency     string       `json:"currency" validate:"required,len=3,alpha,uppercase"`
+}
+
+type CreateRateCardOutputDTO struct {
+	mapper.RateCardOutput
+}
+
+func NewCreateRateCardUseCase(repo domain.EstimationRepository) *CreateRateCardUseCase {
+	return &CreateRateCardUseCase{repo}
+}
+
+func (uc *CreateRateCardUseCase) Execute(ctx context.Context, input CreateRateCardInputDTO) (*CreateRateCardOutputDTO, error) {
+	plan, err := uc.repository.GetPlan(ctx, input.PlanID)
+	if err != nil {
+		return nil, err
+	}
+
+	rateCard := domain.NewRateCard(domain.NewRateCardProps{
+		PlanID:       input.PlanID,
+		CompetenceID: input.CompetenceID,
+		Year:         input.Year,
+		HourlyRate:   input.HourlyRate,
+		Currency:     domain.Currency(input.Currency),
+	})
+
+	if err := validateRateCard(ctx, uc.repository, rateCard, plan); err != nil {
+		return nil, err
+	}
+
+	if err := uc.repository.CreateRateCard(ctx, rateCard); err != nil {
+		return nil, err
+	}
+
+	createdRateCard, err := uc.repository.GetRateCard(ctx, rateCard.RateCardID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := mapper.RateCardOutputFromDomain(*createdRateCard)
+
+	return &CreateRateCardOutputDTO{output}, nil
+}
+
+type UpdateRateCardUseCase struct {
+	repository domain.EstimationRepository
+}
+
+type UpdateRateCardInputDTO struct {
+	RateCardID string        `json:"rate_card_id" validate:"required,uuid4"`
+	PlanID     string        `json:"plan_id" validate:"required,uuid4"`
+	Year       *int          `json:"year" validate:"omitempty"`
+	HourlyRate *common.Money `json:"hourly_rate" validate:"omitempty,gt=0,twodecimals"`
+	Currency   *string       `json:"currency" validate:"omitempty,len=3,alpha,uppercase"`
+}
+
+type UpdateRateCardOutputDTO struct {
+	mapper.RateCardOutput
+}
+
+func NewUpdateRateCardUseCase(repo domain.EstimationRepository) *UpdateRateCardUseCase {
+	return &UpdateRateCardUseCase{repo}
+}
+
+func (uc *UpdateRateCardUseCase) Execute(ctx context.Context, input UpdateRateCardInputDTO) (*UpdateRateCardOutputDTO, error) {
+	rateCard, err := uc.repository.GetRateCard(ctx, input.RateCardID)
+	if err != nil {
+		return nil, err
+	}
+
+	if rateCard.PlanID != input.PlanID {
+		return nil, ErrRateCardPlanMismatch
+	}
+
+	plan, err := uc.repository.GetPlan(ctx, input.PlanID)
+	if err != nil {
+		return nil, err
+	}
+
+	rateCard.ChangeYear(input.Year)
+	rateCard.ChangeHourlyRate(input.HourlyRate)
+	rateCard.ChangeCurrency(input.Currency)
+
+	if err := validateRateCard(ctx, uc.repository, rateCard, plan); err != nil {
+		return nil, err
+	}
+
+	err = uc.repository.UpdateRateCard(ctx, rateCard)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := uc.repository.GetRateCard(ctx, rateCard.RateCardID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := mapper.RateCardOutputFromDomain(*updated)
+
+	return &UpdateRateCardOutputDTO{output}, nil
+}
+
+type DeleteRateCardUseCase struct {
+	repository domain.EstimationRepository
+}
+
+type DeleteRateCardInputDTO struct {
+	RateCardID string `json:"rate_card_id" validate:"required"`
+	PlanID     string `json:"plan_id" validate:"required"`
+}
+
+type DeleteRateCardOutputDTO struct{}
+
+func NewDeleteRateCardUseCase(repo domain.EstimationRepository) *DeleteRateCardUseCase {
+	return &DeleteRateCardUseCase{repo}
+}
+
+func (uc *DeleteRateCardUseCase) Execute(ctx context.Context, input DeleteRateCardInputDTO) (*DeleteRateCardOutputDTO, error) {
+	rateCard, err := uc.repository.GetRateCard(ctx, input.RateCardID)
+	if err != nil {
+		return nil, err
+	}
+
+	if rateCard.PlanID != input.PlanID {
+		return nil, ErrRateCardPlanMismatch
+	}
+
+	err = uc.repository.DeleteRateCard(ctx, input.RateCardID)
+	if err != nil {
+		return nil, err
+	}
+	return &DeleteRateCardOutputDTO{}, nil
+}
+
+// validateRateCard checks the rate card against the plan years and the currency catalogue
+func validateRateCard(ctx context.Context, repository domain.EstimationRepository, rateCard *domain.RateCard, plan *domain.Plan) error {
+	if err := rateCard.Validate(); err != nil {
+		return err
+	}
+
+	if err := rateCard.ValidateYear(plan); err != nil {
+		return err
+	}
+
+	return repository.ValidateCurrency(ctx, rateCard.Currency)
+}
@@ -0,0 +1,179 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/celsopires1999/estimation/internal/domain"
+	"github.com/celsopires1999/estimation/internal/mapper"
+)
+
+// SimulatePortfolioUseCase is responsible for generating a portfolio in memory to test its sensitivity
+// to the plan assumptions. Nothing is saved.
+type SimulatePortfolioUseCase struct {
+	repository domain.EstimationRepository
+}
+
+// SimulatePortfolioInputDTO takes either a plan, whose rate cards price the workloads,
+// or inline assumptions, which leave the workloads without price
+type SimulatePortfolioInputDTO struct {
+	BaselineID    string                      `json:"baseline_id" validate:"required,uuid4"`
+	PlanID        string                      `json:"plan_id" validate:"required_without=Assumptions,excluded_with=Assumptions,omitempty,uuid4"`
+	Assumptions   domain.Assumptions          `json:"assumptions" validate:"omitempty,dive"`
+	InflationMode *string                     `json:"inflation_mode" validate:"omitempty,oneof=annual monthly_compound monthly_linear"`
+	ShiftMonths   int                         `json:"shift_months" validate:"gte=0,lte=36"`
+	Adjustment    domain.AssumptionAdjustment `json:"adjustment"`
+}
+
+type SimulatePortfolioOutputDTO struct {
+	mapper.PortfolioOutput
+}
+
+func NewSimulatePortfolioUseCase(repository domain.EstimationRepository) *SimulatePortfolioUseCase {
+	return &SimulatePortfolioUseCase{repository}
+}
+
+func (uc *SimulatePortfolioUseCase) Execute(ctx context.Context, input SimulatePortfolioInputDTO) (*SimulatePortfolioOutputDTO, error) {
+	baseline, err := uc.repository.GetBaseline(ctx, input.BaselineID)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := uc.simulationPlan(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	costs, err := uc.repository.GetCostManyByBaselineID(ctx, baseline.BaselineID)
+	if err != nil {
+		return nil, err
+	}
+
+	efforts, err := uc.repository.GetEffortManyByBaselineID(ctx, baseline.BaselineID)
+	if err != nil {
+		return nil, err
+	}
+
+	rateCards, err := uc.repository.GetRateCardManyByPlanID(ctx, plan.PlanID)
+	if err != nil {
+		return nil, err
+	}
+
+	taxProfiles, err := uc.repository.GetTaxProfileMany(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	portfolioService := domain.NewPortfolioService(
+		plan.PlanID,
+		baseline,
+		costs,
+		efforts,
+		plan.GetInflation(),
+		plan.GetExchange(),
+		domain.NewRateTable(rateCards),
+		domain.NewTaxTable(taxProfiles),
+		input.ShiftMonths,
+	)
+
+	portfolio, budgets, workloads, err := portfolioService.GeneratePortfolio()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := portfolio.ValidateYears(plan, budgets, workloads); err != nil {
+		return nil, err
+	}
+
+	manager, err := uc.repository.GetUser(ctx, baseline.ManagerID)
+	if err != nil {
+		return nil, err
+	}
+
+	estimator, err := uc.repository.GetUser(ctx, baseline.EstimatorID)
+	if err != nil {
+		return nil, err
+	}
+
+	taxProfileNames := make(map[string]string, len(taxProfiles))
+	for _, t := range taxProfiles {
+		taxProfileNames[t.TaxProfileID] = t.Name
+	}
+
+	costsByID := make(map[string]*domain.Cost, len(costs))
+	for _, c := range costs {
+		costsByID[c.CostID] = c
+	}
+
+	budgetsOutput := make([]mapper.BudgetOutput, len(budgets))
+	for i, b := range budgets {
+		cost := costsByID[b.CostID]
+		budgetsOutput[i] = mapper.BudgetOutputFromDomain(*b, *cost, taxProfileNames[cost.TaxProfileID])
+	}
+
+	effortsByID := make(map[string]*domain.Effort, len(efforts))
+	for _, e := range efforts {
+		effortsByID[e.EffortID] = e
+	}
+
+	competences := make(map[string]*domain.Competence)
+	workloadsOutput := make([]mapper.WorkloadOutput, len(workloads))
+	for i, w := range workloads {
+		effort := effortsByID[w.EffortID]
+		competence, ok := competences[effort.CompetenceID]
+		if !ok {
+			competence, err = uc.repository.GetCompetence(ctx, effort.CompetenceID)
+			if err != nil {
+				return nil, err
+			}
+			competences[effort.CompetenceID] = competence
+		}
+		workloadsOutput[i] = mapper.WorkloadOutputFromDomain(*w, *effort, *competence)
+	}
+
+	output := mapper.PortfolioOutput{
+		PortfolioID: portfolio.PortfolioID,
+		Code:        baseline.Code,
+		Review:      baseline.Review,
+		PlanCode:    plan.Code,
+		Title:       baseline.Title,
+		Description: baseline.Description,
+		StartDate:   portfolio.StartDate,
+		Duration:    baseline.Duration,
+		Manager:     manager.Name,
+		Estimator:   estimator.Name,
+		Budgets:     budgetsOutput,
+		Workloads:   workloadsOutput,
+		Totals:      mapper.TotalsOutputFrom(budgetsOutput, workloadsOutput),
+	}
+
+	return &SimulatePortfolioOutputDTO{output}, nil
+}
+
+// simulationPlan returns the plan of the simulation with the adjustment of its assumptions
+func (uc *SimulatePortfolioUseCase) simulationPlan(ctx context.Context, input SimulatePortfolioInputDTO) (*domain.Plan, error) {
+	var plan *domain.Plan
+	if input.PlanID != "" {
+		var err error
+		plan, err = uc.repository.GetPlan(ctx, input.PlanID)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		plan = domain.NewPlan("SIMULATION", "Simulation", input.Assumptions, domain.AnnualInflation)
+		if err := validateCurrencies(ctx, uc.repository, plan.GetCurrencies()); err != nil {
+			return nil, err
+		}
+	}
+
+	if input.InflationMode != nil {
+		plan.ChangeInflationMode(domain.InflationMode(*input.InflationMode))
+	}
+
+	// the adjustment can take the inflation below zero, so the plan is validated after it
+	plan.Adjust(input.Adjustment)
+	if err := plan.Validate(); err != nil {
+		return nil, err
+	}
+
+	return plan, nil
+}
@@ -0,0 +1,182 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/celsopires1999/estimation/internal/common"
+	"github.com/celsopires1999/estimation/internal/domain"
+	"github.com/celsopires1999/estimation/internal/mapper"
+)
+
+type CreateTaxProfileUseCase struct {
+	repository domain.EstimationRepository
+}
+
+type CreateTaxProfileInputDTO struct {
+	Name        string              `json:"name" validate:"required,max=50"`
+	Description string              `json:"description" validate:"-"`
+	Components  []TaxComponentInput `json:"components" validate:"required,min=1,dive"`
+}
+
+type TaxComponentInput struct {
+	Name     string  `json:"name" validate:"required,max=50"`
+	Rate     float64 `json:"rate" validate:"gt=0,lte=100,twodecimals"`
+	Compound bool    `json:"compound" validate:"-"`
+}
+
+type CreateTaxProfileOutputDTO struct {
+	mapper.TaxProfileOutput
+}
+
+func NewCreateTaxProfileUseCase(repo domain.EstimationRepository) *CreateTaxProfileUseCase {
+	return &CreateTaxProfileUseCase{repo}
+}
+
+func (uc *CreateTaxProfileUseCase) Execute(ctx context.Context, input CreateTaxProfileInputDTO) (*CreateTaxProfileOutputDTO, error) {
+	taxProfile := domain.NewTaxProfile(input.Name, input.Description, taxComponents(input.Components))
+	if err := taxProfile.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := uc.repository.CreateTaxProfile(ctx, taxProfile); err != nil {
+		return nil, err
+	}
+
+	createdTaxProfile, err := uc.repository.GetTaxProfile(ctx, taxProfile.TaxProfileID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := mapper.TaxProfileOutputFromDomain(*createdTaxProfile)
+
+	return &CreateTaxProfileOutputDTO{output}, nil
+}
+
+type UpdateTaxProfileUseCase struct {
+	repository domain.EstimationRepository
+}
+
+type UpdateTaxProfileInputDTO struct {
+	TaxProfileID string              `json:"tax_profile_id" validate:"required,uuid4"`
+	Name         *string             `json:"name" validate:"omitempty,max=50"`
+	Description  *string             `json:"description" validate:"omitempty"`
+	Components   []TaxComponentInput `json:"components" validate:"omitempty,min=1,dive"`
+}
+
+type UpdateTaxProfileOutputDTO struct {
+	mapper.TaxProfileOutput
+}
+
+func NewUpdateTaxProfileUseCase(repo domain.EstimationRepository) *UpdateTaxProfileUseCase {
+	return &UpdateTaxProfileUseCase{repo}
+}
+
+func (uc *UpdateTaxProfileUseCase) Execute(ctx context.Context, input UpdateTaxProfileInputDTO) (*UpdateTaxProfileOutputDTO, error) {
+	taxProfile, err := uc.repository.GetTaxProfile(ctx, input.TaxProfileID)
+	if err != nil {
+		return nil, err
+	}
+
+	taxProfile.ChangeName(input.Name)
+	taxProfile.ChangeDescription(input.Description)
+	if input.Components != nil {
+		taxProfile.ChangeComponents(taxComponents(input.Components))
+	}
+
+	err = taxProfile.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.repository.UpdateTaxProfile(ctx, taxProfile)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := uc.repository.GetTaxProfile(ctx, taxProfile.TaxProfileID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := mapper.TaxProfileOutputFromDomain(*updated)
+
+	return &UpdateTaxProfileOutputDTO{output}, nil
+}
+
+type DeleteTaxProfileUseCase struct {
+	repository domain.EstimationRepository
+}
+
+type DeleteTaxProfileInputDTO struct {
+	TaxProfileID string `json:"tax_profile_id" validate:"required"`
+}
+
+type DeleteTaxProfileOutputDTO struct{}
+
+func NewDeleteTaxProfileUseCase(repo domain.EstimationRepository) *DeleteTaxProfileUseCase {
+	return &DeleteTaxProfileUseCase{repo}
+}
+
+func (uc *DeleteTaxProfileUseCase) Execute(ctx context.Context, input DeleteTaxProfileInputDTO) (*DeleteTaxProfileOutputDTO, error) {
+	err := uc.repository.DeleteTaxProfile(ctx, input.TaxProfileID)
+	if err != nil {
+		return nil, err
+	}
+	return &DeleteTaxProfileOutputDTO{}, nil
+}
+
+type GetTaxProfileUseCase struct {
+	repository domain.EstimationRepository
+}
+
+type GetTaxProfileInputDTO struct {
+	TaxProfileID string `json:"tax_profile_id" validate:"required"`
+}
+
+type GetTaxProfileOutputDTO struct {
+	mapper.TaxProfileOutput
+}
+
+func NewGetTaxProfileUseCase(repo domain.EstimationRepository) *GetTaxProfileUseCase {
+	return &GetTaxProfileUseCase{repo}
+}
+
+func (uc *GetTaxProfileUseCase) Execute(ctx context.Context, input GetTaxProfileInputDTO) (*GetTaxProfileOutputDTO, error) {
+	taxProfile, err := uc.repository.GetTaxProfile(ctx, input.TaxProfileID)
+	if err != nil {
+		return nil, err
+	}
+	output := mapper.TaxProfileOutputFromDomain(*taxProfile)
+	return &GetTaxProfileOutputDTO{output}, nil
+}
+
+func taxComponents(input []TaxComponentInput) domain.TaxComponents {
+	components := make(domain.TaxComponents, len(input))
+	for i, c := range input {
+		components[i] = domain.TaxComponent{
+			Name:     c.Name,
+			Rate:     c.Rate,
+			Compound: c.Compound,
+		}
+	}
+	return components
+}
+
+// validateTaxProfile checks that the tax profile referenced by a cost is registered
+func validateTaxProfile(ctx context.Context, repository domain.EstimationRepository, taxProfileID string) error {
+	if taxProfileID == "" {
+		return nil
+	}
+
+	_, err := repository.GetTaxProfile(ctx, taxProfileID)
+	if err != nil {
+		var notFoundErr *common.NotFoundError
+		if errors.As(err, &notFoundErr) {
+			return common.NewDomainValidationError(fmt.Errorf("tax profile %s is not registered", taxProfileID))
+		}
+		return err
+	}
+	return nil
+}
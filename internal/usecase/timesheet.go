@@ -0,0 +1,169 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/celsopires1999/estimation/internal/domain"
+	"github.com/celsopires1999/estimation/internal/infra/db"
+	"github.com/celsopires1999/estimation/internal/mapper"
+)
+
+// TimesheetInputDTO is the number of hours worked by a competence on a baseline of the plan in a month.
+// Importing the same baseline, competence and month again replaces its hours.
+type TimesheetInputDTO struct {
+	BaselineCode   string `json:"baseline_code" validate:"required"`
+	CompetenceCode string `json:"competence_code" validate:"required"`
+	Year           int    `json:"year" validate:"required"`
+	Month          int    `json:"month" validate:"required,gte=1,lte=12"`
+	Hours          int    `json:"hours" validate:"gte=0"`
+}
+
+// ImportTimesheetsUseCase records the hours worked on the portfolios of a plan, such as the lines of a CSV file.
+// Either every timesheet is recorded or none is.
+type ImportTimesheetsUseCase struct {
+	txm db.TransactionManagerInterface
+}
+
+type ImportTimesheetsInputDTO struct {
+	PlanID     string              `json:"plan_id" validate:"required,uuid4"`
+	Timesheets []TimesheetInputDTO `json:"timesheets" validate:"required,min=1,dive"`
+}
+
+type ImportTimesheetsOutputDTO struct {
+	PlanID     string                   `json:"plan_id"`
+	Timesheets []mapper.TimesheetOutput `json:"timesheets"`
+}
+
+func NewImportTimesheetsUseCase(txm db.TransactionManagerInterface) *ImportTimesheetsUseCase {
+	return &ImportTimesheetsUseCase{txm}
+}
+
+func (uc *ImportTimesheetsUseCase) Execute(ctx context.Context, input ImportTimesheetsInputDTO) (*ImportTimesheetsOutputDTO, error) {
+	output := &ImportTimesheetsOutputDTO{
+		PlanID:     input.PlanID,
+		Timesheets: make([]mapper.TimesheetOutput, 0, len(input.Timesheets)),
+	}
+
+	err := uc.txm.Do(ctx, func(ctx context.Context, tx db.TransactionInterface) error {
+		repository, err := db.GetAs[domain.EstimationRepository](tx, "EstimationRepository")
+		if err != nil {
+			return err
+		}
+
+		if _, err := repository.GetPlan(ctx, input.PlanID); err != nil {
+			return err
+		}
+
+		portfolios := make(map[string]*timesheetPortfolio)
+		competences := make(map[string]*domain.Competence)
+
+		for _, item := range input.Timesheets {
+			portfolio, ok := portfolios[item.BaselineCode]
+			if !ok {
+				portfolio, err = loadTimesheetPortfolio(ctx, repository, input.PlanID, item.BaselineCode)
+				if err != nil {
+					return err
+				}
+				portfolios[item.BaselineCode] = portfolio
+			}
+
+			competence, ok := competences[item.CompetenceCode]
+			if !ok {
+				competence, err = repository.GetCompetenceByCode(ctx, item.CompetenceCode)
+				if err != nil {
+					return err
+				}
+				competences[item.CompetenceCode] = competence
+			}
+
+			timesheet, err := recordTimesheet(ctx, repository, portfolio, competence.CompetenceID, item)
+			if err != nil {
+				return err
+			}
+			if !slices.ContainsFunc(portfolio.timesheets, func(t *domain.Timesheet) bool { return t.TimesheetID == timesheet.TimesheetID }) {
+				portfolio.timesheets = append(portfolio.timesheets, timesheet)
+			}
+
+			timesheetOutput := mapper.TimesheetOutputFromDomain(*timesheet)
+			timesheetOutput.BaselineCode = item.BaselineCode
+			timesheetOutput.CompetenceCode = item.CompetenceCode
+			output.Timesheets = append(output.Timesheets, timesheetOutput)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// timesheetPortfolio holds what is needed to record the timesheets of a portfolio
+type timesheetPortfolio struct {
+	portfolio  *domain.Portfolio
+	efforts    []*domain.Effort
+	timesheets []*domain.Timesheet
+}
+
+func loadTimesheetPortfolio(ctx context.Context, repository domain.EstimationRepository, planID, baselineCode string) (*timesheetPortfolio, error) {
+	portfolio, err := repository.GetPortfolioByPlanIDAndBaselineCode(ctx, planID, baselineCode)
+	if err != nil {
+		return nil, err
+	}
+
+	efforts, err := repository.GetEffortManyByBaselineID(ctx, portfolio.BaselineID)
+	if err != nil {
+		return nil, err
+	}
+
+	timesheets, err := repository.GetTimesheetManyByPortfolioID(ctx, portfolio.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &timesheetPortfolio{portfolio, efforts, timesheets}, nil
+}
+
+// recordTimesheet creates the timesheet of the competence in the month, or changes the one already recorded
+func recordTimesheet(ctx context.Context, repository domain.EstimationRepository, portfolio *timesheetPortfolio, competenceID string, input TimesheetInputDTO) (*domain.Timesheet, error) {
+	for _, timesheet := range portfolio.timesheets {
+		if !timesheet.IsFor(competenceID, input.Year, time.Month(input.Month)) {
+			continue
+		}
+
+		timesheet.ChangeHours(input.Hours)
+		if err := timesheet.Validate(); err != nil {
+			return nil, err
+		}
+		if err := repository.UpdateTimesheet(ctx, timesheet); err != nil {
+			return nil, err
+		}
+		return repository.GetTimesheet(ctx, timesheet.TimesheetID)
+	}
+
+	timesheet := domain.NewTimesheet(domain.NewTimesheetProps{
+		PortfolioID:  portfolio.portfolio.PortfolioID,
+		CompetenceID: competenceID,
+		Year:         input.Year,
+		Month:        time.Month(input.Month),
+		Hours:        input.Hours,
+	})
+
+	if err := timesheet.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := timesheet.ValidateEffort(portfolio.efforts); err != nil {
+		return nil, err
+	}
+
+	if err := repository.CreateTimesheet(ctx, timesheet); err != nil {
+		return nil, err
+	}
+
+	return repository.GetTimesheet(ctx, timesheet.TimesheetID)
+}
@@ -4,11 +4,12 @@ import (
 	"context"
 
 	"github.com/celsopires1999/estimation/internal/domain"
+	"github.com/celsopires1999/estimation/internal/infra/db"
 	"github.com/celsopires1999/estimation/internal/mapper"
 )
 
 type CreateUserUseCase struct {
-	repository domain.EstimationRepository
+	txm db.TransactionManagerInterface
 }
 
 type CreateUserInputDTO struct {
@@ -22,8 +23,8 @@ type CreateUserOutputDTO struct {
 	mapper.UserOutput
 }
 
-func NewCreateUserUseCase(repo domain.EstimationRepository) *CreateUserUseCase {
-	return &CreateUserUseCase{repo}
+func NewCreateUserUseCase(txm db.TransactionManagerInterface) *CreateUserUseCase {
+	return &CreateUserUseCase{txm}
 }
 
 func (uc *CreateUserUseCase) Execute(ctx context.Context, input CreateUserInputDTO) (*CreateUserOutputDTO, error) {
@@ -33,11 +34,26 @@ func (uc *CreateUserUseCase) Execute(ctx context.Context, input CreateUserInputD
 		return nil, err
 	}
 
-	if err := uc.repository.CreateUser(ctx, user); err != nil {
-		return nil, err
-	}
+	var createdUser *domain.User
+
+	err = uc.txm.Do(ctx, func(ctx context.Context, tx db.TransactionInterface) error {
+		repository, err := db.GetAs[domain.EstimationRepository](tx, "EstimationRepository")
+		if err != nil {
+			return err
+		}
+
+		if err := repository.CreateUser(ctx, user); err != nil {
+			return err
+		}
+
+		createdUser, err = repository.GetUser(ctx, user.UserID)
+		if err != nil {
+			return err
+		}
+
+		return recordAudit(ctx, repository, domain.CreateAction, domain.UserEntity, user.UserID, nil, mapper.UserOutputFromDomain(*createdUser))
+	})
 
-	createdUser, err := uc.repository.GetUser(ctx, user.UserID)
 	if err != nil {
 		return nil, err
 	}
@@ -48,7 +64,7 @@ func (uc *CreateUserUseCase) Execute(ctx context.Context, input CreateUserInputD
 }
 
 type UpdateUserUseCase struct {
-	repository domain.EstimationRepository
+	txm db.TransactionManagerInterface
 }
 
 type UpdateUserInputDTO struct {
@@ -63,32 +79,48 @@ type UpdateUserOutputDTO struct {
 	mapper.UserOutput
 }
 
-func NewUpdateUserUseCase(repo domain.EstimationRepository) *UpdateUserUseCase {
-	return &UpdateUserUseCase{repo}
+func NewUpdateUserUseCase(txm db.TransactionManagerInterface) *UpdateUserUseCase {
+	return &UpdateUserUseCase{txm}
 }
 
 func (uc *UpdateUserUseCase) Execute(ctx context.Context, input UpdateUserInputDTO) (*UpdateUserOutputDTO, error) {
-	user, err := uc.repository.GetUser(ctx, input.UserID)
-	if err != nil {
-		return nil, err
-	}
-
-	user.ChangeEmail(input.Email)
-	user.ChangeUserName(input.UserName)
-	user.ChangeName(input.Name)
-	user.ChangeUserType(input.UserType)
+	var updated *domain.User
+
+	err := uc.txm.Do(ctx, func(ctx context.Context, tx db.TransactionInterface) error {
+		repository, err := db.GetAs[domain.EstimationRepository](tx, "EstimationRepository")
+		if err != nil {
+			return err
+		}
+
+		user, err := repository.GetUser(ctx, input.UserID)
+		if err != nil {
+			return err
+		}
+		before := mapper.UserOutputFromDomain(*user)
+
+		user.ChangeEmail(input.Email)
+		user.ChangeUserName(input.UserName)
+		user.ChangeName(input.Name)
+		user.ChangeUserType(input.UserType)
+
+		err = user.Validate()
+		if err != nil {
+			return err
+		}
+
+		err = repository.UpdateUser(ctx, user)
+		if err != nil {
+			return err
+		}
+
+		updated, err = repository.GetUser(ctx, user.UserID)
+		if err != nil {
+			return err
+		}
+
+		return recordAudit(ctx, repository, domain.UpdateAction, domain.UserEntity, user.UserID, before, mapper.UserOutputFromDomain(*updated))
+	})
 
-	err = user.Validate()
-	if err != nil {
-		return nil, err
-	}
-
-	err = uc.repository.UpdateUser(ctx, user)
-	if err != nil {
-		return nil, err
-	}
-
-	updated, err := uc.repository.GetUser(ctx, user.UserID)
 	if err != nil {
 		return nil, err
 	}
@@ -99,7 +131,7 @@ func (uc *UpdateUserUseCase) Execute(ctx context.Context, input UpdateUserInputD
 }
 
 type DeleteUserUseCase struct {
-	repository domain.EstimationRepository
+	txm db.TransactionManagerInterface
 }
 
 type DeleteUserInputDTO struct {
@@ -108,12 +140,30 @@ type DeleteUserInputDTO struct {
 
 type DeleteUserOutputDTO struct{}
 
-func NewDeleteUserUseCase(repo domain.EstimationRepository) *DeleteUserUseCase {
-	return &DeleteUserUseCase{repo}
+func NewDeleteUserUseCase(txm db.TransactionManagerInterface) *DeleteUserUseCase {
+	return &DeleteUserUseCase{txm}
 }
 
 func (uc *DeleteUserUseCase) Execute(ctx context.Context, input DeleteUserInputDTO) (*DeleteUserOutputDTO, error) {
-	err := uc.repository.DeleteCompetence(ctx, input.UserID)
+	err := uc.txm.Do(ctx, func(ctx context.Context, tx db.TransactionInterface) error {
+		repository, err := db.GetAs[domain.EstimationRepository](tx, "EstimationRepository")
+		if err != nil {
+			return err
+		}
+
+		user, err := repository.GetUser(ctx, input.UserID)
+		if err != nil {
+			return err
+		}
+
+		err = repository.DeleteUser(ctx, input.UserID)
+		if err != nil {
+			return err
+		}
+
+		return recordAudit(ctx, repository, domain.DeleteAction, domain.UserEntity, user.UserID, mapper.UserOutputFromDomain(*user), nil)
+	})
+
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,5 @@
+START TRANSACTION;
+
+ALTER TABLE plans DROP COLUMN IF EXISTS inflation_mode;
+
+COMMIT;
@@ -0,0 +1,6 @@
+START TRANSACTION;
+
+ALTER TABLE plans
+ADD COLUMN IF NOT EXISTS inflation_mode VARCHAR(20) NOT NULL DEFAULT 'annual';
+
+COMMIT;
@@ -0,0 +1,7 @@
+START TRANSACTION;
+
+ALTER TABLE costs DROP CONSTRAINT IF EXISTS costs_currency_fkey;
+
+DROP TABLE IF EXISTS currencies;
+
+COMMIT;
@@ -0,0 +1,41 @@
+START TRANSACTION;
+
+CREATE TABLE IF NOT EXISTS currencies (
+    currency_id VARCHAR(36) PRIMARY KEY,
+    code VARCHAR(3) NOT NULL UNIQUE,
+    name VARCHAR(50) NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP
+);
+
+INSERT INTO
+    currencies (
+        currency_id,
+        code,
+        name,
+        created_at
+    )
+VALUES (
+        gen_random_uuid ()::VARCHAR,
+        'BRL',
+        'Brazilian Real',
+        NOW()
+    ),
+    (
+        gen_random_uuid ()::VARCHAR,
+        'USD',
+        'US Dollar',
+        NOW()
+    ),
+    (
+        gen_random_uuid ()::VARCHAR,
+        'EUR',
+        'Euro',
+        NOW()
+    )
+ON CONFLICT (code) DO NOTHING;
+
+ALTER TABLE costs
+ADD CONSTRAINT costs_currency_fkey FOREIGN KEY (currency) REFERENCES currencies (code);
+
+COMMIT;
@@ -0,0 +1,11 @@
+START TRANSACTION;
+
+ALTER TABLE budget_allocations ALTER COLUMN amount TYPE FLOAT8;
+
+ALTER TABLE budgets ALTER COLUMN amount TYPE FLOAT8;
+
+ALTER TABLE cost_allocations ALTER COLUMN amount TYPE FLOAT8;
+
+ALTER TABLE costs ALTER COLUMN amount TYPE FLOAT8;
+
+COMMIT;
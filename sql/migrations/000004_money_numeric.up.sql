@@ -0,0 +1,15 @@
+START TRANSACTION;
+
+ALTER TABLE costs
+ALTER COLUMN amount TYPE NUMERIC(15, 2) USING ROUND(amount::NUMERIC, 2);
+
+ALTER TABLE cost_allocations
+ALTER COLUMN amount TYPE NUMERIC(15, 2) USING ROUND(amount::NUMERIC, 2);
+
+ALTER TABLE budgets
+ALTER COLUMN amount TYPE NUMERIC(15, 2) USING ROUND(amount::NUMERIC, 2);
+
+ALTER TABLE budget_allocations
+ALTER COLUMN amount TYPE NUMERIC(15, 2) USING ROUND(amount::NUMERIC, 2);
+
+COMMIT;
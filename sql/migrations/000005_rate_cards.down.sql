@@ -0,0 +1,9 @@
+START TRANSACTION;
+
+ALTER TABLE workload_allocations DROP COLUMN IF EXISTS amount;
+
+ALTER TABLE workloads DROP COLUMN IF EXISTS amount;
+
+DROP TABLE IF EXISTS rate_cards;
+
+COMMIT;
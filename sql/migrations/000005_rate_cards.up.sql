@@ -0,0 +1,21 @@
+START TRANSACTION;
+
+CREATE TABLE IF NOT EXISTS rate_cards (
+    rate_card_id VARCHAR(36) PRIMARY KEY,
+    plan_id VARCHAR(36) NOT NULL REFERENCES plans (plan_id) ON DELETE CASCADE,
+    competence_id VARCHAR(36) NOT NULL REFERENCES competences (competence_id) ON DELETE CASCADE,
+    year INT NOT NULL,
+    hourly_rate NUMERIC(15, 2) NOT NULL,
+    currency VARCHAR(3) NOT NULL REFERENCES currencies (code),
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP,
+    UNIQUE (plan_id, competence_id, year)
+);
+
+ALTER TABLE workloads
+ADD COLUMN amount NUMERIC(15, 2) NOT NULL DEFAULT 0;
+
+ALTER TABLE workload_allocations
+ADD COLUMN amount NUMERIC(15, 2) NOT NULL DEFAULT 0;
+
+COMMIT;
@@ -0,0 +1,9 @@
+START TRANSACTION;
+
+ALTER TABLE budgets DROP COLUMN IF EXISTS taxes;
+
+ALTER TABLE costs DROP COLUMN IF EXISTS tax_profile_id;
+
+DROP TABLE IF EXISTS tax_profiles;
+
+COMMIT;
@@ -0,0 +1,18 @@
+START TRANSACTION;
+
+CREATE TABLE IF NOT EXISTS tax_profiles (
+    tax_profile_id VARCHAR(36) PRIMARY KEY,
+    name VARCHAR(50) NOT NULL UNIQUE,
+    description TEXT NULL,
+    components JSONB NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP
+);
+
+ALTER TABLE costs
+ADD COLUMN tax_profile_id VARCHAR(36) NULL REFERENCES tax_profiles (tax_profile_id);
+
+ALTER TABLE budgets
+ADD COLUMN taxes JSONB NOT NULL DEFAULT '[]';
+
+COMMIT;
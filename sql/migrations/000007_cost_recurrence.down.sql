@@ -0,0 +1,5 @@
+START TRANSACTION;
+
+ALTER TABLE costs DROP COLUMN IF EXISTS recurrence;
+
+COMMIT;
@@ -0,0 +1,5 @@
+START TRANSACTION;
+
+ALTER TABLE costs ADD COLUMN recurrence JSONB NULL;
+
+COMMIT;
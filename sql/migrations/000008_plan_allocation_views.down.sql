@@ -0,0 +1,7 @@
+START TRANSACTION;
+
+DROP VIEW IF EXISTS plan_workload_allocations;
+
+DROP VIEW IF EXISTS plan_budget_allocations;
+
+COMMIT;
@@ -0,0 +1,40 @@
+START TRANSACTION;
+
+CREATE VIEW plan_budget_allocations AS
+SELECT
+    pf.plan_id AS plan_id,
+    pf.portfolio_id AS portfolio_id,
+    EXTRACT(YEAR FROM ba.allocation_date)::int AS year,
+    EXTRACT(MONTH FROM ba.allocation_date)::int AS month,
+    co.cost_type AS cost_type,
+    co.currency AS currency,
+    bl.manager_id AS manager_id,
+    ma.name AS manager,
+    ba.amount AS amount
+FROM
+    portfolios AS pf
+    INNER JOIN budgets AS bu ON bu.portfolio_id = pf.portfolio_id
+    INNER JOIN budget_allocations AS ba ON ba.budget_id = bu.budget_id
+    INNER JOIN costs AS co ON co.cost_id = bu.cost_id
+    INNER JOIN baselines AS bl ON bl.baseline_id = pf.baseline_id
+    INNER JOIN users AS ma ON ma.user_id = bl.manager_id;
+
+CREATE VIEW plan_workload_allocations AS
+SELECT
+    pf.plan_id AS plan_id,
+    pf.portfolio_id AS portfolio_id,
+    EXTRACT(YEAR FROM wa.allocation_date)::int AS year,
+    EXTRACT(MONTH FROM wa.allocation_date)::int AS month,
+    cp.competence_id AS competence_id,
+    cp.code AS competence_code,
+    cp.name AS competence_name,
+    wa.hours AS hours,
+    wa.amount AS amount
+FROM
+    portfolios AS pf
+    INNER JOIN workloads AS wo ON wo.portfolio_id = pf.portfolio_id
+    INNER JOIN workload_allocations AS wa ON wa.workload_id = wo.workload_id
+    INNER JOIN efforts AS ef ON ef.effort_id = wo.effort_id
+    INNER JOIN competences AS cp ON cp.competence_id = ef.competence_id;
+
+COMMIT;
@@ -0,0 +1,15 @@
+START TRANSACTION;
+
+CREATE TABLE IF NOT EXISTS capacities (
+    capacity_id VARCHAR(36) PRIMARY KEY,
+    plan_id VARCHAR(36) NOT NULL REFERENCES plans (plan_id) ON DELETE CASCADE,
+    competence_id VARCHAR(36) NOT NULL REFERENCES competences (competence_id) ON DELETE CASCADE,
+    year INT NOT NULL,
+    month INT NOT NULL CHECK (month BETWEEN 1 AND 12),
+    hours INT NOT NULL CHECK (hours >= 0),
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP,
+    UNIQUE (plan_id, competence_id, year, month)
+);
+
+COMMIT;
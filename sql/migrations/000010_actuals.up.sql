@@ -0,0 +1,19 @@
+START TRANSACTION;
+
+CREATE TABLE IF NOT EXISTS actuals (
+    actual_id VARCHAR(36) PRIMARY KEY,
+    portfolio_id VARCHAR(36) NOT NULL REFERENCES portfolios (portfolio_id) ON DELETE CASCADE,
+    cost_id VARCHAR(36) NOT NULL REFERENCES costs (cost_id),
+    spend_date DATE NOT NULL,
+    amount NUMERIC(15, 2) NOT NULL,
+    comment VARCHAR(255),
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP,
+    UNIQUE (
+        portfolio_id,
+        cost_id,
+        spend_date
+    )
+);
+
+COMMIT;
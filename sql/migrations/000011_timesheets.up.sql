@@ -0,0 +1,18 @@
+START TRANSACTION;
+
+CREATE TABLE IF NOT EXISTS timesheets (
+    timesheet_id VARCHAR(36) PRIMARY KEY,
+    portfolio_id VARCHAR(36) NOT NULL REFERENCES portfolios (portfolio_id) ON DELETE CASCADE,
+    competence_id VARCHAR(36) NOT NULL REFERENCES competences (competence_id),
+    work_date DATE NOT NULL,
+    hours INT NOT NULL CHECK (hours >= 0),
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP,
+    UNIQUE (
+        portfolio_id,
+        competence_id,
+        work_date
+    )
+);
+
+COMMIT;
@@ -0,0 +1,21 @@
+START TRANSACTION;
+
+CREATE TABLE IF NOT EXISTS progress (
+    progress_id VARCHAR(36) PRIMARY KEY,
+    portfolio_id VARCHAR(36) NOT NULL REFERENCES portfolios (portfolio_id) ON DELETE CASCADE,
+    cost_id VARCHAR(36) NOT NULL REFERENCES costs (cost_id),
+    progress_date DATE NOT NULL,
+    percent_complete DOUBLE PRECISION NOT NULL CHECK (
+        percent_complete >= 0
+        AND percent_complete <= 100
+    ),
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP,
+    UNIQUE (
+        portfolio_id,
+        cost_id,
+        progress_date
+    )
+);
+
+COMMIT;
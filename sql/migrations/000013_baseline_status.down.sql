@@ -0,0 +1,7 @@
+START TRANSACTION;
+
+DROP TABLE IF EXISTS baseline_transitions;
+
+ALTER TABLE baselines DROP COLUMN IF EXISTS status;
+
+COMMIT;
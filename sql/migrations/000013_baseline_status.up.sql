@@ -0,0 +1,34 @@
+START TRANSACTION;
+
+ALTER TABLE baselines
+ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'draft' CHECK (
+    status IN (
+        'draft',
+        'submitted',
+        'approved',
+        'rejected',
+        'superseded'
+    )
+);
+
+-- baselines already in a portfolio were implicitly approved
+UPDATE baselines
+SET
+    status = 'approved'
+WHERE
+    baseline_id IN (
+        SELECT baseline_id
+        FROM portfolios
+    );
+
+CREATE TABLE IF NOT EXISTS baseline_transitions (
+    transition_id VARCHAR(36) PRIMARY KEY,
+    baseline_id VARCHAR(36) NOT NULL REFERENCES baselines (baseline_id) ON DELETE CASCADE,
+    from_status VARCHAR(20) NOT NULL,
+    to_status VARCHAR(20) NOT NULL,
+    user_id VARCHAR(36) NOT NULL REFERENCES users (user_id),
+    comment VARCHAR(255),
+    created_at TIMESTAMP NOT NULL
+);
+
+COMMIT;
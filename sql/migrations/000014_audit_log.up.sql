@@ -0,0 +1,18 @@
+START TRANSACTION;
+
+CREATE TABLE IF NOT EXISTS audit_log (
+    audit_id VARCHAR(36) PRIMARY KEY,
+    actor VARCHAR(36),
+    action VARCHAR(10) NOT NULL CHECK (
+        action IN ('create', 'update', 'delete')
+    ),
+    entity VARCHAR(20) NOT NULL,
+    entity_id VARCHAR(36) NOT NULL,
+    before JSONB,
+    after JSONB,
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS audit_log_entity_idx ON audit_log (entity, entity_id, created_at);
+
+COMMIT;
@@ -0,0 +1,14 @@
+START TRANSACTION;
+
+DELETE FROM progress WHERE effort_id IS NOT NULL;
+
+ALTER TABLE progress
+DROP CONSTRAINT IF EXISTS progress_portfolio_id_effort_id_progress_date_key;
+
+ALTER TABLE progress DROP CONSTRAINT IF EXISTS progress_cost_or_effort;
+
+ALTER TABLE progress ALTER COLUMN cost_id SET NOT NULL;
+
+ALTER TABLE progress DROP COLUMN IF EXISTS effort_id;
+
+COMMIT;
@@ -0,0 +1,21 @@
+START TRANSACTION;
+
+ALTER TABLE progress
+ADD COLUMN effort_id VARCHAR(36) REFERENCES efforts (effort_id);
+
+ALTER TABLE progress ALTER COLUMN cost_id DROP NOT NULL;
+
+-- progress is recorded either for a cost or for an effort of the portfolio
+ALTER TABLE progress
+ADD CONSTRAINT progress_cost_or_effort CHECK (
+    num_nonnulls (cost_id, effort_id) = 1
+);
+
+ALTER TABLE progress
+ADD CONSTRAINT progress_portfolio_id_effort_id_progress_date_key UNIQUE (
+    portfolio_id,
+    effort_id,
+    progress_date
+);
+
+COMMIT;
@@ -0,0 +1,58 @@
+-- name: InsertActual :exec
+INSERT INTO
+    actuals (
+        actual_id,
+        portfolio_id,
+        cost_id,
+        spend_date,
+        amount,
+        comment,
+        created_at
+    )
+VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: UpdateActual :exec
+UPDATE actuals
+SET
+    amount = $2,
+    comment = $3,
+    updated_at = $4
+WHERE
+    actual_id = $1;
+
+-- name: DeleteActual :execrows
+DELETE FROM actuals WHERE actual_id = $1;
+
+-- name: FindActualById :one
+SELECT * FROM actuals WHERE actual_id = $1;
+
+-- name: FindActualsByPortfolioId :many
+SELECT *
+FROM actuals
+WHERE
+    portfolio_id = $1
+ORDER BY spend_date, cost_id ASC;
+
+-- name: FindActualsByPortfolioIdWithRelations :many
+SELECT
+    ac.actual_id AS actual_id,
+    ac.portfolio_id AS portfolio_id,
+    ac.cost_id AS cost_id,
+    co.cost_type AS cost_type,
+    co.description AS cost_description,
+    ac.spend_date AS spend_date,
+    ac.amount AS amount,
+    ac.comment AS comment,
+    ac.created_at AS created_at,
+    ac.updated_at AS updated_at
+FROM actuals AS ac
+    INNER JOIN costs AS co ON ac.cost_id = co.cost_id
+WHERE
+    ac.portfolio_id = $1
+ORDER BY ac.spend_date, co.cost_type, co.description;
+
+-- name: SumActualsByPortfolioId :one
+SELECT COALESCE(SUM(amount), 0)::numeric AS amount
+FROM actuals
+WHERE
+    portfolio_id = $1;
@@ -0,0 +1,32 @@
+-- name: InsertAuditEntry :exec
+INSERT INTO
+    audit_log (
+        audit_id,
+        actor,
+        action,
+        entity,
+        entity_id,
+        before,
+        after,
+        created_at
+    )
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: FindAuditEntries :many
+SELECT *
+FROM audit_log
+WHERE (
+        sqlc.narg(entity)::varchar IS NULL
+        OR entity = sqlc.narg(entity)
+    )
+    AND (
+        sqlc.narg(entity_id)::varchar IS NULL
+        OR entity_id = sqlc.narg(entity_id)
+    )
+    AND (
+        sqlc.narg(since)::timestamp IS NULL
+        OR created_at >= sqlc.narg(since)
+    )
+ORDER BY created_at, audit_id ASC
+LIMIT sqlc.arg(page_limit)
+OFFSET sqlc.arg(page_offset);
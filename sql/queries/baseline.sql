@@ -10,6 +10,7 @@ INSERT INTO
         duration,
         manager_id,
         estimator_id,
+        status,
         created_at
     )
 VALUES (
@@ -22,7 +23,8 @@ VALUES (
         $7,
         $8,
         $9,
-        $10
+        $10,
+        $11
     );
 
 -- name: FindBaselineById :one
@@ -52,6 +54,14 @@ SET
 WHERE
     baseline_id = $1;
 
+-- name: UpdateBaselineStatus :exec
+UPDATE baselines
+SET
+    status = $2,
+    updated_at = $3
+WHERE
+    baseline_id = $1;
+
 -- name: DeleteBaseline :one
 DELETE FROM baselines WHERE baseline_id = $1 RETURNING *;
 
@@ -61,4 +71,37 @@ FROM
     baselines
     INNER JOIN users AS managers ON managers.user_id = baselines.manager_id
     INNER JOIN users AS estimators ON estimators.user_id = baselines.estimator_id
-ORDER BY code ASC, review DESC;
\ No newline at end of file
+ORDER BY code ASC, review DESC;
+
+-- name: FindBaselinesByCode :many
+SELECT * FROM baselines WHERE code = $1 ORDER BY review ASC;
+
+-- name: InsertBaselineTransition :exec
+INSERT INTO
+    baseline_transitions (
+        transition_id,
+        baseline_id,
+        from_status,
+        to_status,
+        user_id,
+        comment,
+        created_at
+    )
+VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: FindBaselineTransitionsByBaselineId :many
+SELECT
+    bt.transition_id AS transition_id,
+    bt.baseline_id AS baseline_id,
+    bt.from_status AS from_status,
+    bt.to_status AS to_status,
+    bt.user_id AS user_id,
+    us.name AS user_name,
+    us.user_type AS user_type,
+    bt.comment AS comment,
+    bt.created_at AS created_at
+FROM baseline_transitions AS bt
+    INNER JOIN users AS us ON us.user_id = bt.user_id
+WHERE
+    bt.baseline_id = $1
+ORDER BY bt.created_at ASC;
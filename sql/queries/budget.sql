@@ -5,9 +5,10 @@ INSERT INTO
         portfolio_id,
         cost_id,
         amount,
-        created_at
+        created_at,
+        taxes
     )
-VALUES ($1, $2, $3, $4, $5);
+VALUES ($1, $2, $3, $4, $5, $6);
 
 -- name: BulkInsertBudget :exec
 INSERT INTO
@@ -16,14 +17,16 @@ INSERT INTO
         portfolio_id,
         cost_id,
         amount,
-        created_at
+        created_at,
+        taxes
     )
 VALUES (
         unnest($1::text []),
         unnest($2::text []),
         unnest($3::text []),
-        unnest($4::float8[]),
-        unnest($5::timestamp[])
+        unnest($4::numeric[]),
+        unnest($5::timestamp[]),
+        unnest($6::jsonb[])
     );
 
 -- name: FindBudgetById :one
@@ -35,7 +38,8 @@ SET
     portfolio_id = $2,
     cost_id = $3,
     amount = $4,
-    updated_at = $5
+    updated_at = $5,
+    taxes = $6
 WHERE
     budget_id = $1;
 
@@ -69,7 +73,7 @@ VALUES (
         unnest($1::text []),
         unnest($2::text []),
         unnest($3::date[]),
-        unnest($4::float8[]),
+        unnest($4::numeric[]),
         unnest($5::timestamp[])
     );
 
@@ -84,7 +88,7 @@ ORDER BY allocation_date ASC;
 SELECT EXTRACT(
         YEAR
         FROM budget_allocations.allocation_date
-    )::int AS year, SUM(budget_allocations.amount)::float8 AS amount
+    )::int AS year, SUM(budget_allocations.amount)::numeric AS amount
 FROM budget_allocations
 WHERE
     budget_id = $1
@@ -111,9 +115,12 @@ SELECT
     co.apply_inflation AS cost_apply_inflation,
     bu.amount AS amount,
     bu.created_at AS created_at,
-    bu.updated_at AS updated_at
+    bu.updated_at AS updated_at,
+    tp.name AS cost_tax_profile,
+    bu.taxes AS taxes
 FROM budgets AS bu
     INNER JOIN costs AS co ON bu.cost_id = co.cost_id
+    LEFT JOIN tax_profiles AS tp ON co.tax_profile_id = tp.tax_profile_id
 WHERE
     bu.portfolio_id = $1
 ORDER BY co.cost_type, co.description;
\ No newline at end of file
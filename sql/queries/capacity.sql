@@ -0,0 +1,131 @@
+-- name: InsertCapacity :exec
+INSERT INTO
+    capacities (
+        capacity_id,
+        plan_id,
+        competence_id,
+        year,
+        month,
+        hours,
+        created_at
+    )
+VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: UpdateCapacity :exec
+UPDATE capacities
+SET
+    year = $2,
+    month = $3,
+    hours = $4,
+    updated_at = $5
+WHERE
+    capacity_id = $1;
+
+-- name: DeleteCapacity :execrows
+DELETE FROM capacities WHERE capacity_id = $1;
+
+-- name: FindCapacityById :one
+SELECT * FROM capacities WHERE capacity_id = $1;
+
+-- name: FindCapacitiesByPlanIdWithRelations :many
+SELECT
+    ca.capacity_id AS capacity_id,
+    ca.plan_id AS plan_id,
+    ca.competence_id AS competence_id,
+    c.code AS competence_code,
+    c.name AS competence_name,
+    ca.year AS year,
+    ca.month AS month,
+    ca.hours AS hours,
+    ca.created_at AS created_at,
+    ca.updated_at AS updated_at
+FROM
+    capacities AS ca
+    INNER JOIN competences AS c ON ca.competence_id = c.competence_id
+WHERE
+    ca.plan_id = $1
+ORDER BY c.code, ca.year, ca.month ASC;
+
+-- name: FindCapacityAllocationsByPlanId :many
+WITH
+    allocated AS (
+        SELECT pw.competence_id, pw.year, pw.month, SUM(pw.hours) AS hours
+        FROM plan_workload_allocations AS pw
+        WHERE
+            pw.plan_id = $1
+        GROUP BY
+            pw.competence_id,
+            pw.year,
+            pw.month
+    ),
+    available AS (
+        SELECT ca.competence_id, ca.year, ca.month, ca.hours
+        FROM capacities AS ca
+        WHERE
+            ca.plan_id = $1
+    )
+SELECT
+    c.competence_id AS competence_id,
+    c.code AS competence_code,
+    c.name AS competence_name,
+    COALESCE(al.year, av.year)::int AS year,
+    COALESCE(al.month, av.month)::int AS month,
+    av.hours::int AS capacity_hours,
+    COALESCE(al.hours, 0)::int AS allocated_hours,
+    (av.hours - COALESCE(al.hours, 0))::int AS available_hours,
+    (av.hours IS NULL)::boolean AS missing_capacity,
+    (av.hours IS NOT NULL AND COALESCE(al.hours, 0) > av.hours)::boolean AS over_allocated
+FROM
+    allocated AS al
+    FULL OUTER JOIN available AS av ON av.competence_id = al.competence_id
+    AND av.year = al.year
+    AND av.month = al.month
+    INNER JOIN competences AS c ON c.competence_id = COALESCE(al.competence_id, av.competence_id)
+ORDER BY year, month, c.code;
+
+-- name: FindOverAllocatedBaselinesByPlanId :many
+WITH
+    allocated AS (
+        SELECT pw.competence_id, pw.year, pw.month, SUM(pw.hours) AS hours
+        FROM plan_workload_allocations AS pw
+        WHERE
+            pw.plan_id = $1
+        GROUP BY
+            pw.competence_id,
+            pw.year,
+            pw.month
+    )
+SELECT
+    pw.competence_id AS competence_id,
+    pw.year AS year,
+    pw.month AS month,
+    pw.portfolio_id AS portfolio_id,
+    b.baseline_id AS baseline_id,
+    b.code AS baseline_code,
+    b.review AS baseline_review,
+    b.title AS baseline_title,
+    SUM(pw.hours)::int AS hours
+FROM
+    plan_workload_allocations AS pw
+    INNER JOIN allocated AS al ON al.competence_id = pw.competence_id
+    AND al.year = pw.year
+    AND al.month = pw.month
+    INNER JOIN capacities AS ca ON ca.plan_id = pw.plan_id
+    AND ca.competence_id = pw.competence_id
+    AND ca.year = pw.year
+    AND ca.month = pw.month
+    INNER JOIN portfolios AS p ON p.portfolio_id = pw.portfolio_id
+    INNER JOIN baselines AS b ON b.baseline_id = p.baseline_id
+WHERE
+    pw.plan_id = $1
+    AND al.hours > ca.hours
+GROUP BY
+    pw.competence_id,
+    pw.year,
+    pw.month,
+    pw.portfolio_id,
+    b.baseline_id,
+    b.code,
+    b.review,
+    b.title
+ORDER BY pw.year, pw.month, pw.competence_id, hours DESC, b.code;
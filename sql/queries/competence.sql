@@ -25,5 +25,8 @@ DELETE FROM competences WHERE competence_id = $1;
 -- name: FindCompetenceById :one
 SELECT * FROM competences WHERE competence_id = $1;
 
+-- name: FindCompetenceByCode :one
+SELECT * FROM competences WHERE code = $1;
+
 -- name: FindAllCompetences :many
 SELECT * FROM competences ORDER BY code ASC;
\ No newline at end of file
@@ -10,7 +10,9 @@ INSERT INTO
         currency,
         tax,
         apply_inflation,
-        created_at
+        created_at,
+        tax_profile_id,
+        recurrence
     )
 VALUES (
         $1,
@@ -22,7 +24,9 @@ VALUES (
         $7,
         $8,
         $9,
-        $10
+        $10,
+        $11,
+        $12
     );
 
 -- name: BulkInsertCost :exec
@@ -37,7 +41,9 @@ INSERT INTO
         currency,
         tax,
         apply_inflation,
-        created_at
+        created_at,
+        tax_profile_id,
+        recurrence
     )
 VALUES (
         unnest($1::text []),
@@ -45,11 +51,13 @@ VALUES (
         unnest($3::text []),
         unnest($4::text []),
         unnest($5::text []),
-        unnest($6::float8[]),
+        unnest($6::numeric[]),
         unnest($7::text []),
         unnest($8::float8[]),
         unnest($9::boolean[]),
-        unnest($10::timestamp[])
+        unnest($10::timestamp[]),
+        NULLIF(unnest($11::text []), ''),
+        unnest($12::jsonb[])
     );
 
 -- name: FindCostById :one
@@ -66,7 +74,9 @@ SET
     currency = $7,
     tax = $8,
     apply_inflation = $9,
-    updated_at = $10
+    updated_at = $10,
+    tax_profile_id = $11,
+    recurrence = $12
 WHERE
     cost_id = $1;
 
@@ -97,7 +107,7 @@ VALUES (
         unnest($1::text []),
         unnest($2::text []),
         unnest($3::date[]),
-        unnest($4::float8[]),
+        unnest($4::numeric[]),
         unnest($5::timestamp[])
     );
 -- name: DeleteCostAllocations :execrows
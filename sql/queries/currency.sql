@@ -0,0 +1,29 @@
+-- name: InsertCurrency :exec
+INSERT INTO
+    currencies (
+        currency_id,
+        code,
+        name,
+        created_at
+    )
+VALUES ($1, $2, $3, $4);
+
+-- name: UpdateCurrency :execrows
+UPDATE currencies
+SET
+    name = $2,
+    updated_at = $3
+WHERE
+    currency_id = $1;
+
+-- name: DeleteCurrency :execrows
+DELETE FROM currencies WHERE currency_id = $1;
+
+-- name: FindCurrencyById :one
+SELECT * FROM currencies WHERE currency_id = $1;
+
+-- name: FindCurrencyByCode :one
+SELECT * FROM currencies WHERE code = $1;
+
+-- name: FindAllCurrencies :many
+SELECT * FROM currencies ORDER BY code ASC;
\ No newline at end of file
@@ -5,9 +5,10 @@ INSERT INTO
         code,
         name,
         assumptions,
+        inflation_mode,
         created_at
     )
-VALUES ($1, $2, $3, $4, $5);
+VALUES ($1, $2, $3, $4, $5, $6);
 
 -- name: FindPlanById :one
 SELECT * FROM plans WHERE plan_id = $1;
@@ -24,11 +25,103 @@ SET
     code = $2,
     name = $3,
     assumptions = $4,
-    updated_at = $5
+    inflation_mode = $5,
+    updated_at = $6
 WHERE
     plan_id = $1
 RETURNING
     *;
 
 -- name: DeletePlan :one
-DELETE FROM plans WHERE plan_id = $1 RETURNING *;
\ No newline at end of file
+DELETE FROM plans WHERE plan_id = $1 RETURNING *;
+
+-- name: FindPlanAllocationsGroupedByMonth :many
+SELECT
+    al.year AS year,
+    COALESCE(al.month, 0)::int AS month,
+    SUM(al.budget_amount)::numeric AS budget_amount,
+    SUM(al.labor_amount)::numeric AS labor_amount,
+    SUM(al.budget_amount + al.labor_amount)::numeric AS total_amount,
+    SUM(al.hours)::int AS hours
+FROM (
+        SELECT pb.year, pb.month, pb.amount AS budget_amount, 0 AS labor_amount, 0 AS hours
+        FROM plan_budget_allocations AS pb
+        WHERE
+            pb.plan_id = $1
+        UNION ALL
+        SELECT pw.year, pw.month, 0, pw.amount, pw.hours
+        FROM plan_workload_allocations AS pw
+        WHERE
+            pw.plan_id = $1
+    ) AS al
+GROUP BY
+    GROUPING SETS ((al.year, al.month), (al.year))
+ORDER BY year, month;
+
+-- name: FindPlanBudgetsGroupedByCostType :many
+SELECT
+    pb.year AS year,
+    COALESCE(pb.month, 0)::int AS month,
+    pb.cost_type AS cost_type,
+    SUM(pb.amount)::numeric AS amount
+FROM plan_budget_allocations AS pb
+WHERE
+    pb.plan_id = $1
+GROUP BY
+    GROUPING SETS (
+        (pb.year, pb.month, pb.cost_type),
+        (pb.year, pb.cost_type)
+    )
+ORDER BY year, month, cost_type;
+
+-- name: FindPlanBudgetsGroupedByCurrency :many
+SELECT
+    pb.year AS year,
+    COALESCE(pb.month, 0)::int AS month,
+    pb.currency AS currency,
+    SUM(pb.amount)::numeric AS amount
+FROM plan_budget_allocations AS pb
+WHERE
+    pb.plan_id = $1
+GROUP BY
+    GROUPING SETS (
+        (pb.year, pb.month, pb.currency),
+        (pb.year, pb.currency)
+    )
+ORDER BY year, month, currency;
+
+-- name: FindPlanBudgetsGroupedByManager :many
+SELECT
+    pb.year AS year,
+    COALESCE(pb.month, 0)::int AS month,
+    pb.manager_id AS manager_id,
+    pb.manager AS manager,
+    SUM(pb.amount)::numeric AS amount
+FROM plan_budget_allocations AS pb
+WHERE
+    pb.plan_id = $1
+GROUP BY
+    GROUPING SETS (
+        (pb.year, pb.month, pb.manager_id, pb.manager),
+        (pb.year, pb.manager_id, pb.manager)
+    )
+ORDER BY year, month, manager;
+
+-- name: FindPlanWorkloadsGroupedByCompetence :many
+SELECT
+    pw.year AS year,
+    COALESCE(pw.month, 0)::int AS month,
+    pw.competence_id AS competence_id,
+    pw.competence_code AS competence_code,
+    pw.competence_name AS competence_name,
+    SUM(pw.hours)::int AS hours,
+    SUM(pw.amount)::numeric AS amount
+FROM plan_workload_allocations AS pw
+WHERE
+    pw.plan_id = $1
+GROUP BY
+    GROUPING SETS (
+        (pw.year, pw.month, pw.competence_id, pw.competence_code, pw.competence_name),
+        (pw.year, pw.competence_id, pw.competence_code, pw.competence_name)
+    )
+ORDER BY year, month, competence_code;
@@ -31,6 +31,9 @@ SELECT count(*) FROM portfolios WHERE baseline_id = $1;
 -- name: FindPortfolioById :one
 SELECT * FROM portfolios WHERE portfolio_id = $1;
 
+-- name: FindPortfoliosByPlanId :many
+SELECT * FROM portfolios WHERE plan_id = $1 ORDER BY start_date;
+
 -- name: FindPortfolioByPlanIdAndBaselineCode :one
 SELECT portfolios.*
 FROM portfolios
@@ -0,0 +1,55 @@
+-- name: InsertProgress :exec
+INSERT INTO
+    progress (
+        progress_id,
+        portfolio_id,
+        cost_id,
+        effort_id,
+        progress_date,
+        percent_complete,
+        created_at
+    )
+VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: UpdateProgress :exec
+UPDATE progress
+SET
+    percent_complete = $2,
+    updated_at = $3
+WHERE
+    progress_id = $1;
+
+-- name: DeleteProgress :execrows
+DELETE FROM progress WHERE progress_id = $1;
+
+-- name: FindProgressById :one
+SELECT * FROM progress WHERE progress_id = $1;
+
+-- name: FindProgressByPortfolioId :many
+SELECT *
+FROM progress
+WHERE
+    portfolio_id = $1
+ORDER BY progress_date, cost_id, effort_id ASC;
+
+-- name: FindProgressByPortfolioIdWithRelations :many
+SELECT
+    pr.progress_id AS progress_id,
+    pr.portfolio_id AS portfolio_id,
+    pr.cost_id AS cost_id,
+    co.cost_type AS cost_type,
+    co.description AS cost_description,
+    pr.effort_id AS effort_id,
+    cm.code AS competence_code,
+    cm.name AS competence_name,
+    pr.progress_date AS progress_date,
+    pr.percent_complete AS percent_complete,
+    pr.created_at AS created_at,
+    pr.updated_at AS updated_at
+FROM progress AS pr
+    LEFT JOIN costs AS co ON pr.cost_id = co.cost_id
+    LEFT JOIN efforts AS ef ON pr.effort_id = ef.effort_id
+    LEFT JOIN competences AS cm ON ef.competence_id = cm.competence_id
+WHERE
+    pr.portfolio_id = $1
+ORDER BY pr.progress_date, co.cost_type, co.description, cm.code;
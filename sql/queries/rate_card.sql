@@ -0,0 +1,54 @@
+-- name: InsertRateCard :exec
+INSERT INTO
+    rate_cards (
+        rate_card_id,
+        plan_id,
+        competence_id,
+        year,
+        hourly_rate,
+        currency,
+        created_at
+    )
+VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: UpdateRateCard :exec
+UPDATE rate_cards
+SET
+    year = $2,
+    hourly_rate = $3,
+    currency = $4,
+    updated_at = $5
+WHERE
+    rate_card_id = $1;
+
+-- name: DeleteRateCard :execrows
+DELETE FROM rate_cards WHERE rate_card_id = $1;
+
+-- name: FindRateCardById :one
+SELECT * FROM rate_cards WHERE rate_card_id = $1;
+
+-- name: FindRateCardsByPlanId :many
+SELECT *
+FROM rate_cards
+WHERE
+    plan_id = $1
+ORDER BY competence_id, year ASC;
+
+-- name: FindRateCardsByPlanIdWithRelations :many
+SELECT
+    r.rate_card_id AS rate_card_id,
+    r.plan_id AS plan_id,
+    r.competence_id AS competence_id,
+    c.code AS competence_code,
+    c.name AS competence_name,
+    r.year AS year,
+    r.hourly_rate AS hourly_rate,
+    r.currency AS currency,
+    r.created_at AS created_at,
+    r.updated_at AS updated_at
+FROM
+    rate_cards AS r
+    INNER JOIN competences AS c ON r.competence_id = c.competence_id
+WHERE
+    r.plan_id = $1
+ORDER BY c.code, r.year ASC;
@@ -0,0 +1,29 @@
+-- name: InsertTaxProfile :exec
+INSERT INTO
+    tax_profiles (
+        tax_profile_id,
+        name,
+        description,
+        components,
+        created_at
+    )
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: UpdateTaxProfile :exec
+UPDATE tax_profiles
+SET
+    name = $2,
+    description = $3,
+    components = $4,
+    updated_at = $5
+WHERE
+    tax_profile_id = $1;
+
+-- name: DeleteTaxProfile :execrows
+DELETE FROM tax_profiles WHERE tax_profile_id = $1;
+
+-- name: FindTaxProfileById :one
+SELECT * FROM tax_profiles WHERE tax_profile_id = $1;
+
+-- name: FindAllTaxProfiles :many
+SELECT * FROM tax_profiles ORDER BY name ASC;
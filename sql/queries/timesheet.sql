@@ -0,0 +1,117 @@
+-- name: InsertTimesheet :exec
+INSERT INTO
+    timesheets (
+        timesheet_id,
+        portfolio_id,
+        competence_id,
+        work_date,
+        hours,
+        created_at
+    )
+VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: UpdateTimesheet :exec
+UPDATE timesheets
+SET
+    hours = $2,
+    updated_at = $3
+WHERE
+    timesheet_id = $1;
+
+-- name: FindTimesheetById :one
+SELECT * FROM timesheets WHERE timesheet_id = $1;
+
+-- name: FindTimesheetsByPortfolioId :many
+SELECT *
+FROM timesheets
+WHERE
+    portfolio_id = $1
+ORDER BY work_date, competence_id ASC;
+
+-- name: FindTimesheetComparisonByPlanId :many
+WITH
+    planned AS (
+        SELECT pw.portfolio_id, pw.competence_id, pw.year, pw.month, SUM(pw.hours) AS hours
+        FROM plan_workload_allocations AS pw
+        WHERE
+            pw.plan_id = sqlc.arg(plan_id)
+        GROUP BY
+            pw.portfolio_id,
+            pw.competence_id,
+            pw.year,
+            pw.month
+    ),
+    worked AS (
+        SELECT
+            ts.portfolio_id,
+            ts.competence_id,
+            EXTRACT(YEAR FROM ts.work_date)::int AS year,
+            EXTRACT(MONTH FROM ts.work_date)::int AS month,
+            ts.hours
+        FROM timesheets AS ts
+            INNER JOIN portfolios AS p ON p.portfolio_id = ts.portfolio_id
+        WHERE
+            p.plan_id = sqlc.arg(plan_id)
+    ),
+    compared AS (
+        SELECT
+            COALESCE(pl.portfolio_id, wo.portfolio_id) AS portfolio_id,
+            COALESCE(pl.competence_id, wo.competence_id) AS competence_id,
+            COALESCE(pl.year, wo.year) AS year,
+            COALESCE(pl.month, wo.month) AS month,
+            COALESCE(pl.hours, 0) AS planned_hours,
+            COALESCE(wo.hours, 0) AS actual_hours
+        FROM planned AS pl
+            FULL OUTER JOIN worked AS wo ON wo.portfolio_id = pl.portfolio_id
+            AND wo.competence_id = pl.competence_id
+            AND wo.year = pl.year
+            AND wo.month = pl.month
+    )
+SELECT
+    co.portfolio_id AS portfolio_id,
+    b.code AS baseline_code,
+    co.competence_id AS competence_id,
+    c.code AS competence_code,
+    c.name AS competence_name,
+    COALESCE(co.year, 0)::int AS year,
+    COALESCE(co.month, 0)::int AS month,
+    SUM(co.planned_hours)::int AS planned_hours,
+    SUM(co.actual_hours)::int AS actual_hours,
+    (SUM(co.actual_hours) - SUM(co.planned_hours))::int AS delta_hours,
+    (
+        CASE
+            WHEN SUM(co.planned_hours) = 0 THEN NULL
+            ELSE ROUND(
+                (SUM(co.actual_hours) - SUM(co.planned_hours)) * 100.0 / SUM(co.planned_hours),
+                2
+            )
+        END
+    )::float8 AS over_percent,
+    (
+        (SUM(co.actual_hours) - SUM(co.planned_hours)) * 100.0 > SUM(co.planned_hours) * sqlc.arg(threshold)::float8
+    )::boolean AS over_threshold
+FROM
+    compared AS co
+    INNER JOIN portfolios AS p ON p.portfolio_id = co.portfolio_id
+    INNER JOIN baselines AS b ON b.baseline_id = p.baseline_id
+    INNER JOIN competences AS c ON c.competence_id = co.competence_id
+GROUP BY
+    GROUPING SETS (
+        (
+            co.portfolio_id,
+            b.code,
+            co.competence_id,
+            c.code,
+            c.name,
+            co.year,
+            co.month
+        ),
+        (
+            co.portfolio_id,
+            b.code,
+            co.competence_id,
+            c.code,
+            c.name
+        )
+    )
+ORDER BY baseline_code, competence_code, year, month;
@@ -5,9 +5,10 @@ INSERT INTO
         portfolio_id,
         effort_id,
         hours,
+        amount,
         created_at
     )
-VALUES ($1, $2, $3, $4, $5);
+VALUES ($1, $2, $3, $4, $5, $6);
 
 -- name: BulkInsertWorkload :exec
 INSERT INTO
@@ -16,6 +17,7 @@ INSERT INTO
         portfolio_id,
         effort_id,
         hours,
+        amount,
         created_at
     )
 VALUES (
@@ -23,7 +25,8 @@ VALUES (
         unnest($2::text []),
         unnest($3::text []),
         unnest($4::int[]),
-        unnest($5::timestamp[])
+        unnest($5::numeric[]),
+        unnest($6::timestamp[])
     );
 
 -- name: FindWorkloadById :one
@@ -35,7 +38,8 @@ SET
     portfolio_id = $2,
     effort_id = $3,
     hours = $4,
-    updated_at = $5
+    amount = $5,
+    updated_at = $6
 WHERE
     workload_id = $1;
 
@@ -51,10 +55,11 @@ INSERT INTO
         workload_allocation_id,
         workload_id,
         hours,
+        amount,
         allocation_date,
         created_at
     )
-VALUES ($1, $2, $3, $4, $5);
+VALUES ($1, $2, $3, $4, $5, $6);
 
 -- name: BulkInsertWorkloadAllocation :exec
 INSERT INTO
@@ -63,6 +68,7 @@ INSERT INTO
         workload_id,
         allocation_date,
         hours,
+        amount,
         created_at
     )
 VALUES (
@@ -70,7 +76,8 @@ VALUES (
         unnest($2::text []),
         unnest($3::date[]),
         unnest($4::int[]),
-        unnest($5::timestamp[])
+        unnest($5::numeric[]),
+        unnest($6::timestamp[])
     );
 
 -- name: FindWorkloadAllocations :many
@@ -106,6 +113,7 @@ SELECT
     c.name AS competence_name,
     e.comment AS comment,
     w.hours AS hours,
+    w.amount AS amount,
     w.created_at AS created_at,
     w.updated_at AS updated_at
 FROM
@@ -0,0 +1,25 @@
+package e2e_test
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// actorID is the user the e2e requests change data on behalf of, before the users they create exist
+var actorID = uuid.NewString()
+
+// actorTransport sends the X-User-ID header the API requires to change data
+type actorTransport struct {
+	actor string
+}
+
+func (t actorTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	r = r.Clone(r.Context())
+	r.Header.Set("X-User-ID", t.actor)
+	return http.DefaultTransport.RoundTrip(r)
+}
+
+func newClient(actor string) http.Client {
+	return http.Client{Transport: actorTransport{actor}}
+}
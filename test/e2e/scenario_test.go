@@ -60,6 +60,8 @@ func (s *E2EScenarioSuite) TestE2ECreate() {
 	s.postCostsPO()
 	s.postCostsConsulting()
 	s.postEffort()
+	s.postBaselineTransition("submitted", s.estimator.UserID)
+	s.postBaselineTransition("approved", s.manager.UserID)
 	s.postPlanBP()
 	s.postPlanFC03()
 	s.postPortfolioBP()
@@ -69,7 +71,7 @@ func (s *E2EScenarioSuite) TestE2ECreate() {
 }
 
 func (s *E2EScenarioSuite) postUsersManager() {
-	c := http.Client{}
+	c := newClient(actorID)
 
 	input := userInput{
 		Email:    "john.doe@userland.com",
@@ -113,7 +115,7 @@ func (s *E2EScenarioSuite) postUsersManager() {
 }
 
 func (s *E2EScenarioSuite) postUsersEstimator() {
-	c := http.Client{}
+	c := newClient(actorID)
 
 	input := userInput{
 		Email:    "marie.doe1110@userland.com",
@@ -157,7 +159,7 @@ func (s *E2EScenarioSuite) postUsersEstimator() {
 }
 
 func (s *E2EScenarioSuite) postCompetence() {
-	c := http.Client{}
+	c := newClient(actorID)
 
 	input := competenceInput{
 		Code: "Tech Doc",
@@ -198,7 +200,7 @@ func (s *E2EScenarioSuite) postCompetence() {
 }
 
 func (s *E2EScenarioSuite) postBaselines() {
-	c := http.Client{}
+	c := newClient(actorID)
 
 	input := baselineInput{
 		Code:        "RIT123456789",
@@ -239,6 +241,7 @@ func (s *E2EScenarioSuite) postBaselines() {
 	s.Equal(input.Duration, output.Duration)
 	s.Equal(input.ManagerID, output.ManagerID)
 	s.Equal(input.EstimatorID, output.EstimatorID)
+	s.Equal("draft", output.Status)
 	parsedTime, err := time.Parse(time.RFC3339, output.CreatedAt.Format(time.RFC3339))
 	s.Nil(err)
 	s.True(parsedTime.After(time.Date(1900, time.January, 1, 0, 0, 0, 0, time.UTC)))
@@ -251,7 +254,7 @@ func (s *E2EScenarioSuite) postBaselines() {
 }
 
 func (s *E2EScenarioSuite) postCostsPO() {
-	c := http.Client{}
+	c := newClient(actorID)
 
 	input := costInput{
 		CostType:       "one_time",
@@ -331,7 +334,7 @@ func (s *E2EScenarioSuite) postCostsPO() {
 }
 
 func (s *E2EScenarioSuite) postCostsConsulting() {
-	c := http.Client{}
+	c := newClient(actorID)
 
 	input := costInput{
 		CostType:       "one_time",
@@ -391,7 +394,7 @@ func (s *E2EScenarioSuite) postCostsConsulting() {
 }
 
 func (s *E2EScenarioSuite) postEffort() {
-	c := http.Client{}
+	c := newClient(actorID)
 
 	input := effortInput{
 		CompetenceID: s.competence.CompetenceID,
@@ -476,8 +479,41 @@ func (s *E2EScenarioSuite) postEffort() {
 	s.mu.Unlock()
 }
 
+func (s *E2EScenarioSuite) postBaselineTransition(status, userID string) {
+	c := newClient(userID)
+
+	input := baselineTransitionInput{
+		Status:  status,
+		Comment: "baseline " + status,
+	}
+
+	message, err := json.Marshal(input)
+	s.Nil(err)
+
+	payload := bytes.NewReader(message)
+
+	r, err := c.Post("http://localhost:9000/api/v1/baselines/"+s.baseline.BaselineID+"/transitions", "application/json", payload)
+	s.Nil(err)
+	defer r.Body.Close()
+	body, err := io.ReadAll(r.Body)
+	s.Nil(err)
+
+	s.Equal(http.StatusOK, r.StatusCode)
+
+	var output baselineOutput
+	err = json.Unmarshal(body, &output)
+	s.Nil(err)
+
+	s.Equal(s.baseline.BaselineID, output.BaselineID)
+	s.Equal(status, output.Status)
+
+	s.mu.Lock()
+	s.baseline.Status = output.Status
+	s.mu.Unlock()
+}
+
 func (s *E2EScenarioSuite) postPlanBP() {
-	c := http.Client{}
+	c := newClient(actorID)
 
 	input := planInput{
 		Code: "BP 2025",
@@ -556,7 +592,7 @@ func (s *E2EScenarioSuite) postPlanBP() {
 }
 
 func (s *E2EScenarioSuite) postPlanFC03() {
-	c := http.Client{}
+	c := newClient(actorID)
 
 	input := planInput{
 		Code: "FC 03 2025",
@@ -635,7 +671,7 @@ func (s *E2EScenarioSuite) postPlanFC03() {
 }
 
 func (s *E2EScenarioSuite) postPortfolioBP() {
-	c := http.Client{}
+	c := newClient(actorID)
 
 	input := portfolioInput{
 		BaselineID:  s.baseline.BaselineID,
@@ -669,7 +705,7 @@ func (s *E2EScenarioSuite) postPortfolioBP() {
 }
 
 func (s *E2EScenarioSuite) postPortfolioFC03() {
-	c := http.Client{}
+	c := newClient(actorID)
 
 	input := portfolioInput{
 		BaselineID:  s.baseline.BaselineID,
@@ -703,7 +739,7 @@ func (s *E2EScenarioSuite) postPortfolioFC03() {
 }
 
 func (s *E2EScenarioSuite) getPortfolioBP() {
-	c := http.Client{}
+	c := newClient(actorID)
 
 	r, err := c.Get("http://localhost:9000/api/v1/portfolios/" + s.portfolioIDBP.PortfolioID)
 	s.Nil(err)
@@ -759,7 +795,7 @@ func expectedPortfolio(filename string) portfolioOutput {
 }
 
 func (s *E2EScenarioSuite) getPortfolioFC03() {
-	c := http.Client{}
+	c := newClient(actorID)
 
 	r, err := c.Get("http://localhost:9000/api/v1/portfolios/" + s.portfolioIDFC03.PortfolioID)
 	s.Nil(err)
@@ -60,10 +60,16 @@ type baselineOutput struct {
 	Mananger    string    `json:"manager"`
 	EstimatorID string    `json:"estimator_id"`
 	Estimator   string    `json:"estimator"`
+	Status      string    `json:"status"`
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
 }
 
+type baselineTransitionInput struct {
+	Status  string `json:"status"`
+	Comment string `json:"comment"`
+}
+
 type costInput struct {
 	BaselineID      string                `json:"baseline_id"`
 	CostType        string                `json:"cost_type"`
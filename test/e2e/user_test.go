@@ -50,7 +50,7 @@ func TestE2EUser(t *testing.T) {
 func (s *UserE2ETestSuite) TestE2EUser() {
 	s.Run("POST /api/v1/users", func() {
 		ctx := context.Background()
-		c := http.Client{}
+		c := newClient(actorID)
 
 		input := userInput{
 			Email:    "john.doe@userland.com",
@@ -97,7 +97,7 @@ func (s *UserE2ETestSuite) TestE2EUser() {
 		ctx := context.Background()
 		manager := s.arrangeManager(ctx)
 
-		c := http.Client{}
+		c := newClient(actorID)
 		input := userInput{
 			Email:    "john.doe@userland.com",
 			UserName: "john1234",
@@ -146,7 +146,7 @@ func (s *UserE2ETestSuite) TestE2EUser() {
 		ctx := context.Background()
 		manager := s.arrangeManager(ctx)
 
-		c := http.Client{}
+		c := newClient(actorID)
 
 		request, err := http.NewRequestWithContext(ctx, "GET", "http://localhost:9000/api/v1/users/"+manager.UserID, nil)
 		if err != nil {
@@ -179,7 +179,7 @@ func (s *UserE2ETestSuite) TestE2EUser() {
 		ctx := context.Background()
 		manager := s.arrangeManager(ctx)
 
-		c := http.Client{}
+		c := newClient(actorID)
 
 		request, err := http.NewRequestWithContext(ctx, "DELETE", "http://localhost:9000/api/v1/users/"+manager.UserID, nil)
 
@@ -191,6 +191,25 @@ func (s *UserE2ETestSuite) TestE2EUser() {
 		s.Equal(http.StatusNoContent, response.StatusCode)
 
 	})
+
+	s.Run("DELETE /api/v1/users/:id without X-User-ID", func() {
+		ctx := context.Background()
+		manager := s.arrangeManager(ctx)
+
+		c := http.Client{}
+
+		request, err := http.NewRequestWithContext(ctx, "DELETE", "http://localhost:9000/api/v1/users/"+manager.UserID, nil)
+
+		if err != nil {
+			s.T().Fatal(err)
+		}
+		response, err := c.Do(request)
+		s.Nil(err)
+		s.Equal(http.StatusBadRequest, response.StatusCode)
+
+		_, err = s.repo.GetUser(ctx, manager.UserID)
+		s.Nil(err)
+	})
 }
 
 func (s *UserE2ETestSuite) arrangeManager(ctx context.Context) *domain.User {